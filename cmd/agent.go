@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"time"
 
 	"github.com/BurntSushi/toml"
@@ -13,7 +16,9 @@ import (
 	"github.com/guilhermearpassos/database-monitoring/internal/common/telemetry"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters"
 	_ "github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/metrics"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/ports/background_agent"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/ports/event_processors"
@@ -76,42 +81,227 @@ func StartAgent(cmd *cobra.Command, args []string) error {
 	if GetPlanPageSize == 0 {
 		GetPlanPageSize = 100
 	}
-	dbByHost := make(map[string]*sqlx.DB, len(config.TargetHosts))
+	if config.AgentID == "" {
+		config.AgentID, err = os.Hostname()
+		if err != nil {
+			panic(fmt.Errorf("failed to get hostname for the agent id: %w", err))
+		}
+	}
+	if config.Outbox.Dir == "" {
+		config.Outbox.Dir = "outbox"
+	}
+	if config.Outbox.MaxSizeMB == 0 {
+		config.Outbox.MaxSizeMB = 512
+	}
+	dbByHostByDriver := make(map[string]map[string]*sqlx.DB)
+	liveProgressThresholdByHost := make(map[string]time.Duration)
 	for _, tgt := range config.TargetHosts {
 		db, err := telemetry.OpenInstrumentedDB(tgt.Driver, tgt.ConnString)
 		if err != nil {
 			panic(fmt.Errorf("error connecting to %s: %w", tgt.Alias, err))
 		}
-		dbByHost[tgt.Alias] = db
+		if _, ok := dbByHostByDriver[tgt.Driver]; !ok {
+			dbByHostByDriver[tgt.Driver] = make(map[string]*sqlx.DB)
+		}
+		dbByHostByDriver[tgt.Driver][tgt.Alias] = db
+		liveProgressThresholdByHost[tgt.Alias] = tgt.LiveProgressThreshold
+		if tgt.LiveProgressThreshold == 0 {
+			liveProgressThresholdByHost[tgt.Alias] = time.Minute
+		}
+	}
+	readers := make(map[string]dataReader, len(dbByHostByDriver))
+	for driver, dbByHost := range dbByHostByDriver {
+		reader, err := newDataReader(driver, dbByHost, liveProgressThresholdByHost)
+		if err != nil {
+			panic(err)
+		}
+		readers[driver] = reader
 	}
-	reader := adapters.NewSQLServerDataReader(dbByHost)
 	for _, tgt := range config.TargetHosts {
 		router := events.NewEventRouter(tgt.Alias)
 		go router.StartMetrics(ctx)
-		a := app.NewApplication(reader, reader, adapters.NewGRPCIngestionClient(client), router)
+		reader := readers[tgt.Driver]
+		// only sql server reports deadlocks
+		deadlockReader, collectDeadlocks := reader.(domain.DeadlockReader)
+		systemMetricsReader, collectSystemMetrics := reader.(domain.SystemMetricsReader)
+		indexStatsReader, collectIndexStats := reader.(domain.IndexStatsReader)
+		queryStoreReader, collectQueryStore := reader.(domain.QueryStoreReader)
+		jobReader, collectJobs := reader.(domain.JobReader)
+		fileSizeReader, collectFileSizes := reader.(domain.FileSizeReader)
+		redactor, err := newSQLRedactor(tgt.Redaction, tgt.Driver)
+		if err != nil {
+			panic(fmt.Errorf("redaction config of %s: %w", tgt.Alias, err))
+		}
+		var samplesReader domain.SamplesReader = reader
+		var metricsReader domain.QueryMetricsReader = reader
+		if redactor.Mode() != parsers.RedactionOff {
+			redactingReader := adapters.NewRedactingReader(reader, reader, redactor)
+			samplesReader, metricsReader = redactingReader, redactingReader
+			if collectQueryStore {
+				queryStoreReader = adapters.NewRedactingQueryStoreReader(queryStoreReader, redactor)
+			}
+			if collectDeadlocks {
+				deadlockReader = adapters.NewRedactingDeadlockReader(deadlockReader, redactor)
+			}
+		}
+		var ingestionClient domain.IngestionClient = adapters.NewGRPCIngestionClient(client)
+		if config.Outbox.Enabled {
+			outbox, err := adapters.NewOutboxIngestionClient(ingestionClient, filepath.Join(config.Outbox.Dir, tgt.Alias),
+				tgt.Alias, config.Outbox.MaxSizeMB*1024*1024)
+			if err != nil {
+				panic(err)
+			}
+			go outbox.Run(ctx)
+			ingestionClient = outbox
+		}
+		a := app.NewApplication(samplesReader, metricsReader, deadlockReader, systemMetricsReader, indexStatsReader,
+			queryStoreReader, jobReader, fileSizeReader, ingestionClient, router)
 		pf := event_processors.NewPlanFetcher(*a)
 		mc := event_processors.NewPrometheusMetricsCollector()
 		sp := event_processors.NewDefaultSQLParser()
 		ld := event_processors.NewMetricsDetector(a, mc, sp)
+		snapshotWarningThresholds := event_processors.DefaultSnapshotWarningThresholds()
+		if tgt.OpenTransactionWarning > 0 {
+			snapshotWarningThresholds.MinOpenTransactionTime = tgt.OpenTransactionWarning
+		}
+		wd := event_processors.NewSnapshotWarningDetector(a, snapshotWarningThresholds)
+		pw := event_processors.NewPlanWarningDetector(a, parsers.NewExecutionPlanAnalyzer(parsers.DefaultPlanAnalyzerThresholds()))
+		qw := event_processors.NewQueryStatWarningDetector(a, event_processors.QueryStatWarningThresholds{
+			SpillsPerExecution:       tgt.QueryStatWarnings.SpillsPerExecution,
+			SpillsPerInterval:        tgt.QueryStatWarnings.SpillsPerInterval,
+			LogicalReadsPerExecution: tgt.QueryStatWarnings.LogicalReadsPerExecution,
+			LogicalReadsPerInterval:  tgt.QueryStatWarnings.LogicalReadsPerInterval,
+		})
+		replicaLagWarningThresholds := event_processors.DefaultReplicaLagWarningThresholds()
+		if tgt.ReplicaLagWarning.DataLoss > 0 {
+			replicaLagWarningThresholds.DataLoss = tgt.ReplicaLagWarning.DataLoss
+		}
+		if tgt.ReplicaLagWarning.RecoveryTime > 0 {
+			replicaLagWarningThresholds.RecoveryTime = tgt.ReplicaLagWarning.RecoveryTime
+		}
+		replicaLagWarningThresholds.LogSendQueueKB = tgt.ReplicaLagWarning.LogSendQueueKB
+		replicaLagWarningThresholds.RedoQueueKB = tgt.ReplicaLagWarning.RedoQueueKB
+		rw := event_processors.NewReplicaLagWarningDetector(a, replicaLagWarningThresholds)
+		wu := event_processors.NewWarningUploader(*a)
 		pf.Register(router)
 		ld.Register(router)
+		wd.Register(router)
+		pw.Register(router)
+		qw.Register(router)
+		rw.Register(router)
+		wu.Register(router)
 		go pf.Run()
 		go ld.Run()
-		startTarget(ctx, a, tgt, config.CollectMetrics, config.Databases)
+		go wd.Run()
+		go pw.Run()
+		go qw.Run()
+		go rw.Run()
+		go wu.Run()
+		hb := background_agent.NewHeartbeatSender(*a, domain.AgentRegistration{
+			AgentID:      config.AgentID,
+			AgentVersion: version,
+			Server:       common_domain.ServerMeta{Host: tgt.Alias, Type: tgt.Driver},
+			Tags:         append(slices.Clone(config.Tags), tgt.Tags...),
+		})
+		hb.Register(router)
+		go hb.Run(ctx, 30*time.Second)
+		cs := background_agent.NewCollectionSupervisor(*a, config.AgentID, localCollectionConfig(config, tgt),
+			background_agent.ReaderSupport{
+				Deadlocks:     collectDeadlocks,
+				SystemMetrics: collectSystemMetrics,
+				IndexStats:    collectIndexStats,
+				QueryStore:    collectQueryStore,
+				Jobs:          collectJobs,
+				FileSizes:     collectFileSizes,
+			})
+		go cs.Run(ctx, time.Minute)
 	}
 	<-ctx.Done()
 	return nil
 }
-func startTarget(ctx context.Context, a *app.Application, config config2.DBDataCollectionConfig, collectMetrics bool, databases []string) {
 
-	serverMeta := common_domain.ServerMeta{
-		Host: config.Alias,
-		Type: config.Driver,
+type dataReader interface {
+	domain.SamplesReader
+	domain.QueryMetricsReader
+}
+
+// newDataReader builds the reader for all targets sharing a database/sql driver
+func newDataReader(driver string, dbByHost map[string]*sqlx.DB, liveProgressThresholdByHost map[string]time.Duration) (dataReader, error) {
+	switch driver {
+	case "mssql":
+		return adapters.NewSQLServerDataReader(dbByHost, liveProgressThresholdByHost), nil
+	case "postgres":
+		return adapters.NewPostgresDataReader(dbByHost), nil
+	case "mysql":
+		return adapters.NewMySQLDataReader(dbByHost), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver %s", driver)
+	}
+}
+
+// localCollectionConfig is the collection config of a target from the agent file, used until the collector manages one
+func localCollectionConfig(config config2.AgentConfig, tgt config2.DBDataCollectionConfig) common_domain.CollectionConfig {
+	local := common_domain.CollectionConfig{
+		Server:             common_domain.ServerMeta{Host: tgt.Alias, Type: tgt.Driver},
+		SnapshotInterval:   10 * time.Second,
+		MetricsInterval:    1 * time.Minute,
+		DeadlockInterval:   1 * time.Minute,
+		IndexStatsInterval: 1 * time.Hour,
+		QueryStoreInterval: 15 * time.Minute,
+		JobInterval:        5 * time.Minute,
+		FileSizeInterval:   1 * time.Hour,
+		Databases:          common_domain.DatabaseFilter{Include: config.Databases, Exclude: tgt.ExcludeDatabases},
+		CollectMetrics:     config.CollectMetrics,
+		CollectDeadlocks:   true,
+		FetchPlans:         true,
+		CollectLockMetrics: true,
+	}
+	if tgt.SnapshotInterval > 0 {
+		local.SnapshotInterval = tgt.SnapshotInterval
+	}
+	if tgt.MetricsInterval > 0 {
+		local.MetricsInterval = tgt.MetricsInterval
+	}
+	if tgt.IndexStatsInterval > 0 {
+		local.IndexStatsInterval = tgt.IndexStatsInterval
+	}
+	if tgt.QueryStoreInterval > 0 {
+		local.QueryStoreInterval = tgt.QueryStoreInterval
+	}
+	if tgt.JobInterval > 0 {
+		local.JobInterval = tgt.JobInterval
 	}
-	sc := background_agent.NewSnapshotCollector(*a)
-	mc := background_agent.NewMetricsCollector(*a)
-	go sc.Run(ctx, serverMeta, databases, 10*time.Second)
-	if collectMetrics {
-		go mc.Run(ctx, serverMeta, databases, 1*time.Minute)
+	if tgt.FileSizeInterval > 0 {
+		local.FileSizeInterval = tgt.FileSizeInterval
+	}
+	if len(tgt.IncludeDatabases) > 0 {
+		local.Databases.Include = tgt.IncludeDatabases
+	}
+	if tgt.CollectMetrics != nil {
+		local.CollectMetrics = *tgt.CollectMetrics
+	}
+	if tgt.FetchPlans != nil {
+		local.FetchPlans = *tgt.FetchPlans
+	}
+	if tgt.LockMetrics != nil {
+		local.CollectLockMetrics = *tgt.LockMetrics
+	}
+	return local
+}
+
+// newSQLRedactor redacts in the dialect of the driver of the target
+func newSQLRedactor(config config2.RedactionConfig, driver string) (*parsers.SQLRedactor, error) {
+	masks := make([]parsers.RedactionMask, len(config.Masks))
+	for i, mask := range config.Masks {
+		pattern, err := regexp.Compile(mask.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("mask %d: %w", i, err)
+		}
+		replacement := mask.Replacement
+		if replacement == "" {
+			replacement = "?"
+		}
+		masks[i] = parsers.RedactionMask{Pattern: pattern, Replacement: replacement}
 	}
+	return parsers.NewSQLRedactor(parsers.RedactionMode(config.Mode), parsers.SQLDialect(driver), masks)
 }
@@ -62,7 +62,7 @@ func StartCollector(cmd *cobra.Command, args []string) error {
 		panic(err)
 	}
 	repo := adapters.NewPostgresRepo(db)
-	application := app.NewApplication(repo, repo, repo)
+	application := app.NewApplication(repo, repo, repo, repo, repo)
 	svc := ports.NewIngestionSvc(*application)
 	collectorv1.RegisterIngestionServiceServer(grpcServer, svc)
 	reflection.Register(grpcServer)
@@ -7,6 +7,9 @@ import (
 
 var configFileName string
 
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
 func main() {
 	c := &cobra.Command{
 		Use: "dbm",
@@ -7,6 +7,7 @@ require (
 	github.com/XSAM/otelsql v0.37.0
 	github.com/elastic/go-elasticsearch/v8 v8.4.0-alpha.1.0.20241127143524-a7d13656118f
 	github.com/fullstorydev/grpcui v1.5.0
+	github.com/go-sql-driver/mysql v1.9.3
 	github.com/golang-migrate/migrate/v4 v4.18.3
 	github.com/golang/protobuf v1.5.4
 	github.com/google/uuid v1.6.0
@@ -33,6 +34,7 @@ require (
 )
 
 require (
+	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/apache/arrow-go/v18 v18.4.1 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.14.1 // indirect
@@ -19,6 +19,17 @@ type AgentConfig struct {
 	Databases            []string                  `toml:"databases"`
 	Telemetry            telemetry.TelemetryConfig `toml:"telemetry"`
 	CollectMetrics       bool                      `toml:"collect_metrics"`
+	Outbox               OutboxConfig              `toml:"outbox"`
+	// AgentID identifies the agent to the collector, defaults to the hostname
+	AgentID string   `toml:"agent_id"`
+	Tags    []string `toml:"tags"`
+}
+
+// OutboxConfig keeps data on disk while the collector is unreachable, one directory per target under Dir
+type OutboxConfig struct {
+	Enabled   bool   `toml:"enabled"`
+	Dir       string `toml:"dir"`
+	MaxSizeMB int64  `toml:"max_size_mb"`
 }
 
 type GrpcConfig struct {
@@ -34,9 +45,63 @@ type TLSConfig struct {
 }
 
 type DBDataCollectionConfig struct {
-	Alias      string `toml:"alias"`
-	Driver     string `toml:"driver"`
-	ConnString string `toml:"conn_string"`
+	Alias             string                  `toml:"alias"`
+	Driver            string                  `toml:"driver"`
+	ConnString        string                  `toml:"conn_string"`
+	QueryStatWarnings QueryStatWarningConfig  `toml:"query_stat_warnings"`
+	ReplicaLagWarning ReplicaLagWarningConfig `toml:"replica_lag_warnings"`
+	Tags              []string                `toml:"tags"`
+	// SnapshotInterval, MetricsInterval, IndexStatsInterval, QueryStoreInterval, JobInterval and FileSizeInterval
+	// default to 10s, 1m, 1h, 15m, 5m and 1h
+	SnapshotInterval   time.Duration `toml:"snapshot_interval"`
+	MetricsInterval    time.Duration `toml:"metrics_interval"`
+	IndexStatsInterval time.Duration `toml:"index_stats_interval"`
+	QueryStoreInterval time.Duration `toml:"query_store_interval"`
+	JobInterval        time.Duration `toml:"job_interval"`
+	FileSizeInterval   time.Duration `toml:"file_size_interval"`
+	// OpenTransactionWarning is how long a transaction stays open before it is reported, defaults to 10m
+	OpenTransactionWarning time.Duration `toml:"open_transaction_warning"`
+	// LiveProgressThreshold is how long a sql server request runs before the progress of its plan operators is read,
+	// defaults to 1m, a negative value disables it
+	LiveProgressThreshold time.Duration `toml:"live_progress_threshold"`
+	// IncludeDatabases replaces the agent databases for the target, both lists accept shell patterns (orders_*)
+	IncludeDatabases []string `toml:"include_databases"`
+	ExcludeDatabases []string `toml:"exclude_databases"`
+	// CollectMetrics overrides the agent collect_metrics, FetchPlans and LockMetrics default to true
+	CollectMetrics *bool           `toml:"collect_metrics"`
+	FetchPlans     *bool           `toml:"fetch_plans"`
+	LockMetrics    *bool           `toml:"lock_metrics"`
+	Redaction      RedactionConfig `toml:"redaction"`
+}
+
+// RedactionConfig controls how query text is redacted before it is sent to the collector.
+// Mode is off (default), literals or hash, masks are applied in literals mode after the literals are replaced.
+type RedactionConfig struct {
+	Mode  string                `toml:"mode"`
+	Masks []RedactionMaskConfig `toml:"masks"`
+}
+
+type RedactionMaskConfig struct {
+	Pattern     string `toml:"pattern"`
+	Replacement string `toml:"replacement"`
+}
+
+// QueryStatWarningConfig holds the thresholds for memory spill and large read warnings, a zero threshold is disabled.
+// Spills are counted in the unit the target reports them (pages, temp blocks or on disk temp tables).
+type QueryStatWarningConfig struct {
+	SpillsPerExecution       int64 `toml:"spills_per_execution"`
+	SpillsPerInterval        int64 `toml:"spills_per_interval"`
+	LogicalReadsPerExecution int64 `toml:"logical_reads_per_execution"`
+	LogicalReadsPerInterval  int64 `toml:"logical_reads_per_interval"`
+}
+
+// ReplicaLagWarningConfig holds the thresholds of the availability group replica lag warning, DataLoss and
+// RecoveryTime default to 30s and 5m, the queue thresholds are disabled when zero
+type ReplicaLagWarningConfig struct {
+	DataLoss       time.Duration `toml:"data_loss"`
+	RecoveryTime   time.Duration `toml:"recovery_time"`
+	LogSendQueueKB int64         `toml:"log_send_queue_kb"`
+	RedoQueueKB    int64         `toml:"redo_queue_kb"`
 }
 
 type GRPCServerConfig struct {
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain/converters"
@@ -57,6 +58,111 @@ func (c GRPCIngestionClient) IngestMetrics(ctx context.Context, metrics []*commo
 	return nil
 }
 
+func (c GRPCIngestionClient) IngestSystemMetrics(ctx context.Context, metrics *common_domain.SystemMetrics) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestSystemMetrics")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.IngestMetrics(ctx, &collectorv1.DatabaseMetrics{
+		Server:    &dbmv1.ServerMetadata{Host: metrics.Server.Host, Type: metrics.Server.Type},
+		Timestamp: timestamppb.New(metrics.Timestamp),
+		Metrics: &collectorv1.DatabaseMetrics_SystemMetrics{SystemMetrics: &collectorv1.SystemMetrics{
+			WaitStats: converters.WaitStatsToProto(metrics.WaitStats),
+			Counters:  converters.PerformanceCountersToProto(metrics.Counters),
+			FileIo:    converters.FileIOStatsToProto(metrics.FileIO),
+			Replicas:  converters.ReplicaStatesToProto(metrics.Replicas),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("ingest system metrics: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) IngestIndexStats(ctx context.Context, stats *common_domain.IndexStats) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestIndexStats")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.IngestMetrics(ctx, &collectorv1.DatabaseMetrics{
+		Server:    &dbmv1.ServerMetadata{Host: stats.Server.Host, Type: stats.Server.Type},
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_IndexStats{IndexStats: converters.IndexStatsToProto(stats)},
+	})
+	if err != nil {
+		return fmt.Errorf("ingest index stats: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) IngestQueryStore(ctx context.Context, stats *common_domain.QueryStoreStats) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestQueryStore")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.IngestMetrics(ctx, &collectorv1.DatabaseMetrics{
+		Server:    &dbmv1.ServerMetadata{Host: stats.Server.Host, Type: stats.Server.Type},
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_QueryStore{QueryStore: converters.QueryStoreStatsToProto(stats)},
+	})
+	if err != nil {
+		return fmt.Errorf("ingest query store: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) IngestJobs(ctx context.Context, stats *common_domain.JobStats) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestJobs")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.IngestMetrics(ctx, &collectorv1.DatabaseMetrics{
+		Server:    &dbmv1.ServerMetadata{Host: stats.Server.Host, Type: stats.Server.Type},
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_Jobs{Jobs: converters.JobStatsToProto(stats)},
+	})
+	if err != nil {
+		return fmt.Errorf("ingest jobs: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) IngestFileSizes(ctx context.Context, stats *common_domain.FileSizeStats) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestFileSizes")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.IngestMetrics(ctx, &collectorv1.DatabaseMetrics{
+		Server:    &dbmv1.ServerMetadata{Host: stats.Server.Host, Type: stats.Server.Type},
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_FileSizes{FileSizes: converters.FileSizeStatsToProto(stats)},
+	})
+	if err != nil {
+		return fmt.Errorf("ingest file sizes: %w", err)
+	}
+	return nil
+}
+
 func (c GRPCIngestionClient) IngestSnapshot(ctx context.Context, snapshot *common_domain.DataBaseSnapshot) (err error) {
 	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestSnapshot")
 	defer func() {
@@ -66,6 +172,19 @@ func (c GRPCIngestionClient) IngestSnapshot(ctx context.Context, snapshot *commo
 		}
 		span.End()
 	}()
+	if len(snapshot.Samples) == 0 {
+		if len(snapshot.Transactions) == 0 {
+			return nil
+		}
+		// nothing running but transactions left open
+		_, err = c.client.IngestSnapshot(ctx, &collectorv1.IngestSnapshotRequest{
+			Snapshot: converters.DatabaseSnapshotToProto(snapshot),
+		})
+		if err != nil {
+			return fmt.Errorf("ingest snapshot: %w", err)
+		}
+		return nil
+	}
 	sampleChunks := slices.Chunk(snapshot.Samples, 50)
 	firstChunk := true
 	for samples := range sampleChunks {
@@ -179,3 +298,157 @@ func (c GRPCIngestionClient) GetKnownPlanHandles(ctx context.Context, server com
 
 	return knownHandlesSlice, nil
 }
+
+func (c GRPCIngestionClient) IngestDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, server common_domain.ServerMeta) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestDeadlocks")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	protoDeadlocks := make([]*dbmv1.Deadlock, len(deadlocks))
+	for i, d := range deadlocks {
+		protoDeadlocks[i] = converters.DeadlockToProto(d)
+	}
+	for chunk := range slices.Chunk(protoDeadlocks, 10) {
+		_, err = c.client.IngestDeadlocks(ctx, &collectorv1.IngestDeadlocksRequest{
+			Deadlocks: chunk,
+			Server:    &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type},
+		})
+		if err != nil {
+			return fmt.Errorf("ingest deadlocks: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) IngestWarnings(ctx context.Context, warnings []*common_domain.Warning, server common_domain.ServerMeta) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.IngestWarnings")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	protoWarnings := make([]*dbmv1.Warning, len(warnings))
+	for i, w := range warnings {
+		protoWarnings[i] = w.WarningData
+	}
+	for chunk := range slices.Chunk(protoWarnings, 50) {
+		_, err = c.client.IngestWarnings(ctx, &collectorv1.IngestWarningsRequest{
+			Warnings: chunk,
+			Server:   &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type},
+		})
+		if err != nil {
+			return fmt.Errorf("ingest warnings: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) GetKnownWarnings(ctx context.Context, server common_domain.ServerMeta) (_ map[string]struct{}, err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.GetKnownWarnings")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	const pageSize = 100
+	serverMetadata := &dbmv1.ServerMetadata{
+		Host: server.Host,
+		Type: server.Type,
+	}
+	known := make(map[string]struct{})
+	// the collector does not report a page count, pages are read until a short one comes back
+	for page := int32(1); ; page++ {
+		resp, err := c.client.GetKnownWarnings(ctx, &collectorv1.GetKnownWarningsRequest{
+			Server:     serverMetadata,
+			PageSize:   pageSize,
+			PageNumber: page,
+		})
+		if err != nil {
+			if grpcErr, ok := status.FromError(err); ok && grpcErr.Code() == codes.NotFound {
+				return known, nil
+			}
+			return nil, fmt.Errorf("error getting known warnings for %s page %d: %w", server.Host, page, err)
+		}
+		for _, w := range resp.Warnings {
+			known[w.Id] = struct{}{}
+		}
+		if len(resp.Warnings) < pageSize {
+			return known, nil
+		}
+	}
+}
+
+func (c GRPCIngestionClient) RegisterAgent(ctx context.Context, registration domain.AgentRegistration) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.RegisterAgent")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.RegisterAgent(ctx, &collectorv1.RegisterAgentRequest{
+		TargetHost:   registration.Server.Host,
+		TargetType:   registration.Server.Type,
+		AgentVersion: registration.AgentVersion,
+		Tags:         registration.Tags,
+		AgentId:      registration.AgentID,
+	})
+	if err != nil {
+		return fmt.Errorf("register agent: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) Heartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, collectionStatus common_domain.CollectionStatus) (err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.Heartbeat")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	_, err = c.client.Heartbeat(ctx, &collectorv1.HeartbeatRequest{
+		AgentId: agentID,
+		Server:  &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type},
+		Status:  converters.CollectionStatusToProto(collectionStatus),
+	})
+	if err != nil {
+		if grpcErr, ok := status.FromError(err); ok && grpcErr.Code() == codes.NotFound {
+			return custom_errors.NotFoundErr{Message: grpcErr.Message()}
+		}
+		return fmt.Errorf("heartbeat: %w", err)
+	}
+	return nil
+}
+
+func (c GRPCIngestionClient) GetCollectionConfig(ctx context.Context, agentID string, server common_domain.ServerMeta) (_ *common_domain.CollectionConfig, err error) {
+	ctx, span := c.trace.Start(ctx, "GRPCIngestionClient.GetCollectionConfig")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	resp, err := c.client.GetCollectionConfig(ctx, &collectorv1.GetCollectionConfigRequest{
+		Server:  &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type},
+		AgentId: agentID,
+	})
+	if err != nil {
+		if grpcErr, ok := status.FromError(err); ok && grpcErr.Code() == codes.NotFound {
+			return nil, custom_errors.NotFoundErr{Message: grpcErr.Message()}
+		}
+		return nil, fmt.Errorf("get collection config: %w", err)
+	}
+	return converters.CollectionConfigToDomain(resp.GetConfig()), nil
+}
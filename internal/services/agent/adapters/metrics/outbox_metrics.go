@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// OutboxDepth tracks the number of items waiting to be sent to the collector by target
+	OutboxDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sqlsights_outbox_depth",
+			Help: "Number of items waiting in the outbox",
+		},
+		[]string{"target"},
+	)
+
+	// OutboxOldestItemAge tracks how long the oldest item of the outbox has been waiting, in seconds
+	OutboxOldestItemAge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sqlsights_outbox_oldest_item_age_seconds",
+			Help: "Age of the oldest item waiting in the outbox in seconds",
+		},
+		[]string{"target"},
+	)
+
+	// OutboxDroppedTotal tracks items discarded because the outbox was full or the collector rejected or kept failing on them
+	OutboxDroppedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sqlsights_outbox_dropped_total",
+			Help: "Total number of items dropped from the outbox",
+		},
+		[]string{"target", "reason"},
+	)
+)
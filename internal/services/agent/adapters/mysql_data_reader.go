@@ -0,0 +1,444 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MySQLDataReader reads session samples and query metrics from performance_schema.
+// It requires MySQL 8.0+ (data_lock_waits) with the statement consumers enabled. MariaDB has no data_lock_waits, its
+// samples are read without the lock waits.
+type MySQLDataReader struct {
+	dbByHost                map[string]*sqlx.DB
+	lastQueryCountersByHost map[string]map[string]map[string]int64
+	qCountMu                *sync.Mutex
+	lockWaitsByHost         map[string]bool
+	lockWaitsMu             *sync.Mutex
+	tracer                  trace.Tracer
+}
+
+var _ domain.SamplesReader = (*MySQLDataReader)(nil)
+var _ domain.QueryMetricsReader = (*MySQLDataReader)(nil)
+
+func NewMySQLDataReader(dbByHost map[string]*sqlx.DB) MySQLDataReader {
+	return MySQLDataReader{dbByHost: dbByHost, lastQueryCountersByHost: make(map[string]map[string]map[string]int64), qCountMu: &sync.Mutex{},
+		lockWaitsByHost: make(map[string]bool), lockWaitsMu: &sync.Mutex{},
+		tracer: otel.Tracer("MySQLDataReader")}
+}
+
+type mysqlLockWait struct {
+	blockingThreadID int64
+	waitType         string
+	waitResource     string
+}
+
+func (m MySQLDataReader) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error) {
+	ctx, span := m.tracer.Start(ctx, "TakeSnapshot")
+	defer span.End()
+	db, ok := m.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("dbByHost[%s] not found", server.Host)
+	}
+	snapID := uuid.NewString()
+	snapTime := time.Now().In(time.UTC)
+	lockWaits := make(map[int64]mysqlLockWait)
+	hasLockWaits, err := m.hasLockWaits(ctx, db, server.Host)
+	if err != nil {
+		return nil, err
+	}
+	if hasLockWaits {
+		lockWaits, err = m.getLockWaits(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	blockers := make(map[int64]struct{}, len(lockWaits))
+	for _, lw := range lockWaits {
+		blockers[lw.blockingThreadID] = struct{}{}
+	}
+	// timer_wait is in picoseconds, for a statement still running it is the time elapsed so far
+	query := `
+select t.thread_id,
+       t.processlist_id,
+       coalesce(t.processlist_user, '')                          as user_name,
+       coalesce(t.processlist_host, '')                          as host_name,
+       coalesce(t.processlist_db, '')                            as db_name,
+       coalesce(t.processlist_command, '')                       as command,
+       coalesce(t.processlist_state, '')                         as state,
+       coalesce(t.processlist_time, 0)                           as state_time_s,
+       coalesce(a.attr_value, '')                                as program_name,
+       coalesce(s.event_id, 0)                                   as event_id,
+       coalesce(s.digest, '')                                    as digest,
+       coalesce(s.sql_text, t.processlist_info, '')              as sql_text,
+       coalesce(s.timer_wait, 0) div 1000000000                  as elapsed_ms,
+       coalesce(x.trx_id, '')                                    as trx_id
+from performance_schema.threads t
+         left join performance_schema.events_statements_current s
+                   on s.thread_id = t.thread_id and s.nesting_event_level = 0
+         left join performance_schema.session_connect_attrs a
+                   on a.processlist_id = t.processlist_id and a.attr_name = 'program_name'
+         left join information_schema.innodb_trx x on x.trx_mysql_thread_id = t.processlist_id
+where t.type = 'FOREGROUND'
+  and t.processlist_id <> connection_id()
+`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query performance_schema.threads: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	querySamples := make([]*common_domain.QuerySample, 0)
+	sessionByThread := make(map[int64]string)
+	sampleByThread := make(map[int64]*common_domain.QuerySample)
+	for rows.Next() {
+		var r mysqlThreadRow
+		err = rows.Scan(&r.threadID,
+			&r.processListID,
+			&r.userName,
+			&r.hostName,
+			&r.dbName,
+			&r.command,
+			&r.state,
+			&r.stateTime,
+			&r.programName,
+			&r.eventID,
+			&r.digest,
+			&r.text,
+			&r.elapsedMs,
+			&r.trxID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("query performance_schema.threads scan: %w", err)
+		}
+		sessionByThread[r.threadID] = strconv.FormatInt(r.processListID, 10)
+		// sleeping sessions are only interesting while they hold locks someone else is waiting on
+		if _, isBlocker := blockers[r.threadID]; r.command == "Sleep" && !isBlocker {
+			continue
+		}
+		if !databases.Matches(r.dbName) {
+			continue
+		}
+		qs := r.querySample(snapID, snapTime, lockWaits)
+		sampleByThread[r.threadID] = qs
+		querySamples = append(querySamples, qs)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("query performance_schema.threads rows: %w", err)
+	}
+	blockingMap := make(map[int64][]string)
+	for threadID, lw := range lockWaits {
+		qs, found := sampleByThread[threadID]
+		if !found {
+			continue
+		}
+		qs.Block.BlockedBy = sessionByThread[lw.blockingThreadID]
+		blockingMap[lw.blockingThreadID] = append(blockingMap[lw.blockingThreadID], qs.Session.SessionID)
+	}
+	for threadID, blocked := range blockingMap {
+		if qs, found := sampleByThread[threadID]; found {
+			qs.SetBlockedIds(blocked)
+		}
+	}
+	return []*common_domain.DataBaseSnapshot{
+		{
+			Samples: querySamples,
+			SnapInfo: common_domain.SnapInfo{
+				ID:        snapID,
+				Timestamp: snapTime,
+				Server: common_domain.ServerMeta{
+					Host: server.Host,
+					Type: server.Type,
+				},
+			},
+		},
+	}, nil
+}
+
+// mysqlThreadRow is a performance_schema.threads row with its current statement read by TakeSnapshot
+type mysqlThreadRow struct {
+	threadID      int64
+	processListID int64
+	userName      string
+	hostName      string
+	dbName        string
+	command       string
+	state         string
+	stateTime     int64
+	programName   string
+	eventID       int64
+	digest        string
+	text          string
+	elapsedMs     int64
+	trxID         string
+}
+
+// querySample maps the row, the wait is the lock the thread waits on when it is in lockWaits
+func (r mysqlThreadRow) querySample(snapID string, snapTime time.Time, lockWaits map[int64]mysqlLockWait) *common_domain.QuerySample {
+	status := "running"
+	if r.command == "Sleep" {
+		status = "sleeping"
+	}
+	sampleId := []byte(fmt.Sprintf("%d_%d", r.threadID, r.eventID))
+	qs := &common_domain.QuerySample{
+		Id:        base64.StdEncoding.EncodeToString(sampleId),
+		Status:    status,
+		Cmd:       r.command,
+		QueryHash: mysqlQueryHash(r.digest),
+		Text:      r.text,
+		Session: common_domain.SessionMetadata{
+			SessionID:            strconv.FormatInt(r.processListID, 10),
+			HostName:             r.hostName,
+			ProgramName:          r.programName,
+			LoginName:            r.userName,
+			Status:               r.state,
+			LastRequestStartTime: snapTime.Add(-time.Duration(r.elapsedMs) * time.Millisecond),
+			ConnectionId:         strconv.FormatInt(r.threadID, 10),
+		},
+		Database: common_domain.DataBaseMetadata{
+			DatabaseName: r.dbName,
+		},
+		Block: common_domain.BlockMetadata{
+			BlockedSessions: make([]string, 0),
+		},
+		Snapshot: common_domain.SnapshotMetadata{
+			ID:        snapID,
+			Timestamp: snapTime,
+		},
+		TimeElapsedMs: r.elapsedMs,
+		CommandMetadata: common_domain.CommandMetadata{
+			TransactionId: r.trxID,
+		},
+	}
+	if lw, isBlocked := lockWaits[r.threadID]; isBlocked {
+		waitType := lw.waitType
+		qs.IsBlocked = true
+		qs.Wait = common_domain.WaitMetadata{
+			WaitType:     &waitType,
+			WaitTime:     int(r.stateTime * 1000),
+			WaitResource: lw.waitResource,
+		}
+	}
+	return qs
+}
+
+// hasLockWaits tells whether the server has performance_schema.data_lock_waits, the version is read once per host
+func (m MySQLDataReader) hasLockWaits(ctx context.Context, db *sqlx.DB, host string) (bool, error) {
+	m.lockWaitsMu.Lock()
+	supported, ok := m.lockWaitsByHost[host]
+	m.lockWaitsMu.Unlock()
+	if ok {
+		return supported, nil
+	}
+	var version string
+	err := db.GetContext(ctx, &version, "select version()")
+	if err != nil {
+		return false, fmt.Errorf("query version: %w", err)
+	}
+	supported = mysqlHasDataLockWaits(version)
+	m.lockWaitsMu.Lock()
+	m.lockWaitsByHost[host] = supported
+	m.lockWaitsMu.Unlock()
+	return supported, nil
+}
+
+// mysqlHasDataLockWaits is false for MariaDB, its version reads like 10.11.6-MariaDB-log
+func mysqlHasDataLockWaits(version string) bool {
+	return !strings.Contains(strings.ToLower(version), "mariadb")
+}
+
+// getLockWaits returns the lock each waiting thread is blocked on, keyed by the waiting thread id.
+// A thread waiting behind several holders is reported as blocked by the first one.
+func (m MySQLDataReader) getLockWaits(ctx context.Context, db *sqlx.DB) (map[int64]mysqlLockWait, error) {
+	query := `
+select w.requesting_thread_id,
+       w.blocking_thread_id,
+       coalesce(l.object_schema, '') as object_schema,
+       coalesce(l.object_name, '')   as object_name,
+       coalesce(l.index_name, '')    as index_name,
+       l.lock_type,
+       l.lock_mode
+from performance_schema.data_lock_waits w
+         inner join performance_schema.data_locks l on l.engine_lock_id = w.requesting_engine_lock_id
+order by w.requesting_thread_id, w.blocking_thread_id
+`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query data_lock_waits: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make(map[int64]mysqlLockWait)
+	for rows.Next() {
+		var requestingThreadID int64
+		var blockingThreadID int64
+		var objectSchema string
+		var objectName string
+		var indexName string
+		var lockType string
+		var lockMode string
+		err = rows.Scan(&requestingThreadID, &blockingThreadID, &objectSchema, &objectName, &indexName, &lockType, &lockMode)
+		if err != nil {
+			return nil, fmt.Errorf("query data_lock_waits scan: %w", err)
+		}
+		if _, ok := ret[requestingThreadID]; ok {
+			continue
+		}
+		resource := objectSchema + "." + objectName
+		if indexName != "" {
+			resource += "." + indexName
+		}
+		ret[requestingThreadID] = mysqlLockWait{
+			blockingThreadID: blockingThreadID,
+			waitType:         lockType + ":" + lockMode,
+			waitResource:     resource,
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("query data_lock_waits rows: %w", err)
+	}
+	return ret, nil
+}
+
+func (m MySQLDataReader) CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error) {
+	ctx, span := m.tracer.Start(ctx, "CollectMetrics")
+	defer span.End()
+	db, ok := m.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	// timers are in picoseconds and are reported in microseconds, like sql server's.
+	// there are no page reads, only the counters with a sql server equivalent keep its names
+	query := `
+select coalesce(schema_name, '')                   as schema_name,
+       digest,
+       coalesce(digest_text, '')                   as digest_text,
+       count_star,
+       sum_timer_wait div 1000000                  as total_elapsed_us,
+       avg_timer_wait div 1000000                  as avg_elapsed_us,
+       sum_lock_time div 1000000                   as total_lock_us,
+       sum_rows_sent,
+       sum_rows_examined,
+       sum_rows_affected,
+       sum_created_tmp_disk_tables,
+       sum_sort_merge_passes,
+       sum_no_index_used,
+       unix_timestamp(last_seen)                   as last_seen
+from performance_schema.events_statements_summary_by_digest
+where digest is not null
+`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("collecting metrics: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.QueryMetric, 0)
+	for rows.Next() {
+		var schemaName string
+		var digest string
+		var text string
+		var executionCount int64
+		var totalElapsedTime int64
+		var avgElapsedTime int64
+		var totalLockTime int64
+		var totalRows int64
+		var totalRowsExamined int64
+		var totalRowsAffected int64
+		var totalTmpDiskTables int64
+		var totalSortMergePasses int64
+		var totalNoIndexUsed int64
+		var lastSeen float64
+		err = rows.Scan(&schemaName, &digest, &text, &executionCount, &totalElapsedTime, &avgElapsedTime, &totalLockTime,
+			&totalRows, &totalRowsExamined, &totalRowsAffected, &totalTmpDiskTables, &totalSortMergePasses, &totalNoIndexUsed, &lastSeen)
+		if err != nil {
+			return nil, fmt.Errorf("collecting metrics - scan: %w", err)
+		}
+		if !databases.Matches(schemaName) {
+			continue
+		}
+		counters := map[string]int64{
+			"executionCount":       executionCount,
+			"totalElapsedTime":     totalElapsedTime,
+			"totalLockTime":        totalLockTime,
+			"totalRows":            totalRows,
+			"totalRowsExamined":    totalRowsExamined,
+			"totalRowsAffected":    totalRowsAffected,
+			"totalSpills":          totalTmpDiskTables,
+			"totalSortMergePasses": totalSortMergePasses,
+			"totalNoIndexUsed":     totalNoIndexUsed,
+		}
+		queryHash := mysqlQueryHash(digest)
+		// the same digest is tracked separately for each schema
+		counterKey := schemaName + "/" + queryHash
+		m.qCountMu.Lock()
+		lastQueryCounters, found := m.lastQueryCountersByHost[server.Host]
+		if !found {
+			lastQueryCounters = make(map[string]map[string]int64)
+		}
+		lastCounters, ok := lastQueryCounters[counterKey]
+		lastQueryCounters[counterKey] = counters
+		m.lastQueryCountersByHost[server.Host] = lastQueryCounters
+		m.qCountMu.Unlock()
+		digestedCounters := counters
+		if ok {
+			digestedCounters = queryCounterDeltas(counters, lastCounters)
+			if digestedCounters["executionCount"] == 0 {
+				continue
+			}
+		} else {
+			if executionCount > 30 {
+				continue
+			}
+		}
+		ret = append(ret, &common_domain.QueryMetric{
+			QueryHash: queryHash,
+			Text:      text,
+			Database: common_domain.DataBaseMetadata{
+				DatabaseName: schemaName,
+			},
+			LastExecutionTime: time.UnixMicro(int64(lastSeen * 1e6)).UTC(),
+			LastElapsedTime:   time.Duration(avgElapsedTime) * time.Microsecond,
+			Counters:          digestedCounters,
+			Rates:             nil,
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("collecting metrics - rows.Err: %w", err)
+	}
+	return ret, nil
+}
+
+// GetPlanHandles returns no plans: mysql does not keep the plans of executed statements.
+func (m MySQLDataReader) GetPlanHandles(ctx context.Context, handles []string, server common_domain.ServerMeta) (map[string]*common_domain.ExecutionPlan, error) {
+	return make(map[string]*common_domain.ExecutionPlan), nil
+}
+
+// mysqlQueryHash re-encodes the hex statement digest as base64, the encoding used for sql server query hashes.
+func mysqlQueryHash(digest string) string {
+	b, err := hex.DecodeString(digest)
+	if err != nil {
+		return digest
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,59 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLHasDataLockWaits(t *testing.T) {
+	assert.True(t, mysqlHasDataLockWaits("8.0.36"))
+	assert.True(t, mysqlHasDataLockWaits("8.4.0-commercial"))
+	assert.False(t, mysqlHasDataLockWaits("10.11.6-MariaDB-log"))
+	assert.False(t, mysqlHasDataLockWaits("11.4.2-MariaDB-ubu2404"))
+}
+
+func TestMySQLThreadRow_QuerySample(t *testing.T) {
+	snapTime := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	row := mysqlThreadRow{
+		threadID:      88,
+		processListID: 31,
+		userName:      "orders",
+		hostName:      "10.0.0.12:53412",
+		dbName:        "orders",
+		command:       "Query",
+		state:         "updating",
+		stateTime:     4,
+		programName:   "orders-api",
+		eventID:       120,
+		digest:        "3f1c0a9b7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b",
+		text:          "update orders set status = 2 where id = 1",
+		elapsedMs:     4200,
+		trxID:         "5123",
+	}
+	lockWaits := map[int64]mysqlLockWait{88: {blockingThreadID: 77, waitType: "RECORD:X", waitResource: "orders.orders.PRIMARY"}}
+
+	sample := row.querySample("snap-1", snapTime, lockWaits)
+	assert.Equal(t, "running", sample.Status)
+	assert.Equal(t, "Query", sample.Cmd)
+	assert.Equal(t, "31", sample.Session.SessionID)
+	assert.Equal(t, "88", sample.Session.ConnectionId)
+	assert.Equal(t, snapTime.Add(-4200*time.Millisecond), sample.Session.LastRequestStartTime)
+	assert.Equal(t, "orders", sample.Database.DatabaseName)
+	assert.Equal(t, "5123", sample.CommandMetadata.TransactionId)
+	assert.Equal(t, mysqlQueryHash(row.digest), sample.QueryHash)
+	assert.True(t, sample.IsBlocked)
+	require.NotNil(t, sample.Wait.WaitType)
+	assert.Equal(t, "RECORD:X", *sample.Wait.WaitType)
+	assert.Equal(t, 4000, sample.Wait.WaitTime)
+	assert.Equal(t, "orders.orders.PRIMARY", sample.Wait.WaitResource)
+
+	// a sleeping session holding locks waits on nothing
+	row.command = "Sleep"
+	sample = row.querySample("snap-1", snapTime, nil)
+	assert.Equal(t, "sleeping", sample.Status)
+	assert.False(t, sample.IsBlocked)
+	assert.Nil(t, sample.Wait.WaitType)
+}
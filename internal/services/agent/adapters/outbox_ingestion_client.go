@@ -0,0 +1,550 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/metrics"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain/converters"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	collectorv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1/collector"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	outboxKindSnapshot  = "snapshot"
+	outboxKindMetrics   = "metrics"
+	outboxKindPlans     = "plans"
+	outboxKindDeadlocks = "deadlocks"
+	outboxKindWarnings  = "warnings"
+
+	outboxMinBackoff  = time.Second
+	outboxMaxBackoff  = time.Minute
+	outboxSendTimeout = 30 * time.Second
+	// outboxMaxAttempts is how many times an item the collector fails on is sent before it is dropped, failures
+	// reaching the collector do not count
+	outboxMaxAttempts = 10
+)
+
+type outboxItem struct {
+	name     string
+	kind     string
+	size     int64
+	enqueued time.Time
+}
+
+type vtMessage interface {
+	MarshalVT() ([]byte, error)
+}
+
+// OutboxIngestionClient persists everything sent to the collector in a directory and replays it in order
+// from Run, retrying with backoff while the collector is unreachable. Items are stored as the collector
+// request messages, one file per call, named after the enqueue time so a restarted agent resumes in order.
+// When the directory grows over maxBytes the oldest items are dropped.
+type OutboxIngestionClient struct {
+	inner    domain.IngestionClient
+	dir      string
+	target   string
+	maxBytes int64
+	trace    trace.Tracer
+	wake     chan struct{}
+
+	mu      sync.Mutex
+	items   []outboxItem
+	size    int64
+	lastSeq int64
+}
+
+var _ domain.IngestionClient = (*OutboxIngestionClient)(nil)
+
+func NewOutboxIngestionClient(inner domain.IngestionClient, dir string, target string, maxBytes int64) (*OutboxIngestionClient, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("create outbox dir %s: %w", dir, err)
+	}
+	c := &OutboxIngestionClient{
+		inner:    inner,
+		dir:      dir,
+		target:   target,
+		maxBytes: maxBytes,
+		trace:    otel.Tracer("OutboxIngestionClient"),
+		wake:     make(chan struct{}, 1),
+	}
+	err = c.load()
+	if err != nil {
+		return nil, fmt.Errorf("load outbox %s: %w", dir, err)
+	}
+	return c, nil
+}
+
+// load indexes the items left by a previous run, half written files are discarded
+func (c *OutboxIngestionClient) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), ".tmp") {
+			_ = os.Remove(filepath.Join(c.dir, entry.Name()))
+			continue
+		}
+		seqStr, kind, ok := strings.Cut(entry.Name(), ".")
+		if !ok {
+			continue
+		}
+		seq, err := strconv.ParseInt(seqStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		c.items = append(c.items, outboxItem{name: entry.Name(), kind: kind, size: info.Size(), enqueued: time.Unix(0, seq)})
+		c.size += info.Size()
+		c.lastSeq = max(c.lastSeq, seq)
+	}
+	sort.Slice(c.items, func(i, j int) bool { return c.items[i].name < c.items[j].name })
+	c.updateGauges()
+	return nil
+}
+
+func (c *OutboxIngestionClient) enqueue(kind string, msg vtMessage) error {
+	data, err := msg.MarshalVT()
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", kind, err)
+	}
+	if int64(len(data)) > c.maxBytes {
+		metrics.OutboxDroppedTotal.WithLabelValues(c.target, "too_large").Inc()
+		return fmt.Errorf("%s of %d bytes does not fit in the outbox", kind, len(data))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seq := max(time.Now().UnixNano(), c.lastSeq+1)
+	name := fmt.Sprintf("%020d.%s", seq, kind)
+	err = writeFileSync(filepath.Join(c.dir, name), data)
+	if err != nil {
+		return fmt.Errorf("write outbox item: %w", err)
+	}
+	c.lastSeq = seq
+	c.items = append(c.items, outboxItem{name: name, kind: kind, size: int64(len(data)), enqueued: time.Unix(0, seq)})
+	c.size += int64(len(data))
+	for c.size > c.maxBytes && len(c.items) > 1 {
+		c.removeLocked(c.items[0].name)
+		metrics.OutboxDroppedTotal.WithLabelValues(c.target, "full").Inc()
+	}
+	c.updateGauges()
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// writeFileSync writes to a temporary file first so a crash never leaves a truncated item behind
+func writeFileSync(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	err = errors.Join(err, f.Close())
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (c *OutboxIngestionClient) head() (outboxItem, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.items) == 0 {
+		return outboxItem{}, false
+	}
+	return c.items[0], true
+}
+
+func (c *OutboxIngestionClient) remove(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeLocked(name)
+	c.updateGauges()
+}
+
+// removeLocked deletes an item, it may already be gone when it was dropped while being sent
+func (c *OutboxIngestionClient) removeLocked(name string) {
+	for i, item := range c.items {
+		if item.name == name {
+			c.items = append(c.items[:i], c.items[i+1:]...)
+			c.size -= item.size
+			break
+		}
+	}
+	err := os.Remove(filepath.Join(c.dir, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("removing outbox item %s: %s\n", name, err.Error())
+	}
+}
+
+func (c *OutboxIngestionClient) updateGauges() {
+	metrics.OutboxDepth.WithLabelValues(c.target).Set(float64(len(c.items)))
+	if len(c.items) == 0 {
+		metrics.OutboxOldestItemAge.WithLabelValues(c.target).Set(0)
+		return
+	}
+	metrics.OutboxOldestItemAge.WithLabelValues(c.target).Set(time.Since(c.items[0].enqueued).Seconds())
+}
+
+// Run sends the queued items one at a time, oldest first, until ctx is done
+func (c *OutboxIngestionClient) Run(ctx context.Context) {
+	backoff := outboxMinBackoff
+	var failedItem string
+	attempts := 0
+	for {
+		item, ok := c.head()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.wake:
+			}
+			continue
+		}
+		err := c.send(ctx, item)
+		if err == nil {
+			c.remove(item.name)
+			backoff = outboxMinBackoff
+			continue
+		}
+		if isPermanent(err) {
+			fmt.Printf("dropping outbox item %s: %s\n", item.name, err.Error())
+			metrics.OutboxDroppedTotal.WithLabelValues(c.target, "rejected").Inc()
+			c.remove(item.name)
+			continue
+		}
+		if !isUnreachable(err) {
+			if failedItem != item.name {
+				failedItem, attempts = item.name, 0
+			}
+			attempts++
+			if attempts >= outboxMaxAttempts {
+				fmt.Printf("dropping outbox item %s after %d attempts: %s\n", item.name, attempts, err.Error())
+				metrics.OutboxDroppedTotal.WithLabelValues(c.target, "failed").Inc()
+				c.remove(item.name)
+				backoff = outboxMinBackoff
+				continue
+			}
+		}
+		fmt.Printf("sending outbox item %s, retrying in %s: %s\n", item.name, backoff, err.Error())
+		c.mu.Lock()
+		c.updateGauges()
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, outboxMaxBackoff)
+	}
+}
+
+type outboxDecodeError struct {
+	err error
+}
+
+func (e outboxDecodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e outboxDecodeError) Unwrap() error {
+	return e.err
+}
+
+// isPermanent reports errors retrying will not fix, the item is dropped instead of blocking the ones behind it
+func isPermanent(err error) bool {
+	if errors.As(err, &outboxDecodeError{}) {
+		return true
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.InvalidArgument, codes.AlreadyExists:
+			return true
+		}
+	}
+	return false
+}
+
+// isUnreachable reports errors sending to the collector, the item is retried until the collector is back
+func isUnreachable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return true
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.ResourceExhausted, codes.Aborted:
+			return true
+		}
+	}
+	return false
+}
+
+func (c *OutboxIngestionClient) send(ctx context.Context, item outboxItem) (err error) {
+	ctx, span := c.trace.Start(ctx, "OutboxIngestionClient.send")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	ctx, cancel := context.WithTimeout(ctx, outboxSendTimeout)
+	defer cancel()
+	data, err := os.ReadFile(filepath.Join(c.dir, item.name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read outbox item: %w", err)
+	}
+	switch item.kind {
+	case outboxKindSnapshot:
+		var req collectorv1.IngestSnapshotRequest
+		if err = req.UnmarshalVT(data); err != nil {
+			return outboxDecodeError{err: fmt.Errorf("unmarshal snapshot: %w", err)}
+		}
+		snapshot := converters.DatabaseSnapshotToDomain(req.Snapshot)
+		return c.inner.IngestSnapshot(ctx, &snapshot)
+	case outboxKindMetrics:
+		var req collectorv1.DatabaseMetrics
+		if err = req.UnmarshalVT(data); err != nil {
+			return outboxDecodeError{err: fmt.Errorf("unmarshal metrics: %w", err)}
+		}
+		if systemMetrics := req.GetSystemMetrics(); systemMetrics != nil {
+			return c.inner.IngestSystemMetrics(ctx, &common_domain.SystemMetrics{
+				Server:    serverMetaToDomain(req.Server),
+				Timestamp: req.Timestamp.AsTime(),
+				WaitStats: converters.WaitStatsToDomain(systemMetrics.WaitStats),
+				Counters:  converters.PerformanceCountersToDomain(systemMetrics.Counters),
+				FileIO:    converters.FileIOStatsToDomain(systemMetrics.FileIo),
+				Replicas:  converters.ReplicaStatesToDomain(systemMetrics.GetReplicas()),
+			})
+		}
+		if indexStats := req.GetIndexStats(); indexStats != nil {
+			return c.inner.IngestIndexStats(ctx, converters.IndexStatsToDomain(serverMetaToDomain(req.Server),
+				req.Timestamp.AsTime(), indexStats))
+		}
+		if queryStore := req.GetQueryStore(); queryStore != nil {
+			return c.inner.IngestQueryStore(ctx, converters.QueryStoreStatsToDomain(serverMetaToDomain(req.Server),
+				req.Timestamp.AsTime(), queryStore))
+		}
+		if jobs := req.GetJobs(); jobs != nil {
+			return c.inner.IngestJobs(ctx, converters.JobStatsToDomain(serverMetaToDomain(req.Server),
+				req.Timestamp.AsTime(), jobs))
+		}
+		if fileSizes := req.GetFileSizes(); fileSizes != nil {
+			return c.inner.IngestFileSizes(ctx, converters.FileSizeStatsToDomain(serverMetaToDomain(req.Server),
+				req.Timestamp.AsTime(), fileSizes))
+		}
+		queryMetrics := make([]*common_domain.QueryMetric, 0, len(req.GetQueryMetrics().GetQueryMetrics()))
+		for _, m := range req.GetQueryMetrics().GetQueryMetrics() {
+			metric, err2 := converters.QueryMetricToDomain(m)
+			if err2 != nil {
+				return outboxDecodeError{err: fmt.Errorf("convert metric: %w", err2)}
+			}
+			queryMetrics = append(queryMetrics, metric)
+		}
+		return c.inner.IngestMetrics(ctx, queryMetrics, serverMetaToDomain(req.Server), req.Timestamp.AsTime())
+	case outboxKindPlans:
+		var req collectorv1.IngestExecutionPlansRequest
+		if err = req.UnmarshalVT(data); err != nil {
+			return outboxDecodeError{err: fmt.Errorf("unmarshal plans: %w", err)}
+		}
+		plans := make(map[string]*common_domain.ExecutionPlan, len(req.Plans))
+		var server common_domain.ServerMeta
+		for _, p := range req.Plans {
+			plan, err2 := converters.ExecutionPlanToDomain(p)
+			if err2 != nil {
+				return outboxDecodeError{err: fmt.Errorf("convert plan: %w", err2)}
+			}
+			plans[plan.PlanHandle] = plan
+			server = plan.Server
+		}
+		return c.inner.IngestExecPlans(ctx, plans, server)
+	case outboxKindDeadlocks:
+		var req collectorv1.IngestDeadlocksRequest
+		if err = req.UnmarshalVT(data); err != nil {
+			return outboxDecodeError{err: fmt.Errorf("unmarshal deadlocks: %w", err)}
+		}
+		deadlocks := make([]*common_domain.Deadlock, len(req.Deadlocks))
+		for i, d := range req.Deadlocks {
+			deadlocks[i] = converters.DeadlockToDomain(d)
+		}
+		return c.inner.IngestDeadlocks(ctx, deadlocks, serverMetaToDomain(req.Server))
+	case outboxKindWarnings:
+		var req collectorv1.IngestWarningsRequest
+		if err = req.UnmarshalVT(data); err != nil {
+			return outboxDecodeError{err: fmt.Errorf("unmarshal warnings: %w", err)}
+		}
+		warnings := make([]*common_domain.Warning, len(req.Warnings))
+		for i, w := range req.Warnings {
+			warnings[i] = common_domain.NewWarning(w)
+		}
+		return c.inner.IngestWarnings(ctx, warnings, serverMetaToDomain(req.Server))
+	default:
+		return outboxDecodeError{err: fmt.Errorf("unknown outbox item kind %s", item.kind)}
+	}
+}
+
+func serverMetaToDomain(server *dbmv1.ServerMetadata) common_domain.ServerMeta {
+	return common_domain.ServerMeta{Host: server.GetHost(), Type: server.GetType()}
+}
+
+func serverMetaToProto(server common_domain.ServerMeta) *dbmv1.ServerMetadata {
+	return &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type}
+}
+
+func (c *OutboxIngestionClient) IngestMetrics(ctx context.Context, queryMetrics []*common_domain.QueryMetric, server common_domain.ServerMeta, timestamp time.Time) error {
+	protoMetrics := make([]*dbmv1.QueryMetric, len(queryMetrics))
+	for i, m := range queryMetrics {
+		protoMetric, err := converters.QueryMetricToProto(m)
+		if err != nil {
+			return fmt.Errorf("convert metric proto: %w - %v", err, m)
+		}
+		protoMetrics[i] = protoMetric
+	}
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(server),
+		Timestamp: timestamppb.New(timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_QueryMetrics{QueryMetrics: &collectorv1.DatabaseMetrics_QueryMetricSample{QueryMetrics: protoMetrics}},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestSystemMetrics(ctx context.Context, metrics *common_domain.SystemMetrics) error {
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(metrics.Server),
+		Timestamp: timestamppb.New(metrics.Timestamp),
+		Metrics: &collectorv1.DatabaseMetrics_SystemMetrics{SystemMetrics: &collectorv1.SystemMetrics{
+			WaitStats: converters.WaitStatsToProto(metrics.WaitStats),
+			Counters:  converters.PerformanceCountersToProto(metrics.Counters),
+			FileIo:    converters.FileIOStatsToProto(metrics.FileIO),
+			Replicas:  converters.ReplicaStatesToProto(metrics.Replicas),
+		}},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestIndexStats(ctx context.Context, stats *common_domain.IndexStats) error {
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(stats.Server),
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_IndexStats{IndexStats: converters.IndexStatsToProto(stats)},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestQueryStore(ctx context.Context, stats *common_domain.QueryStoreStats) error {
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(stats.Server),
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_QueryStore{QueryStore: converters.QueryStoreStatsToProto(stats)},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestJobs(ctx context.Context, stats *common_domain.JobStats) error {
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(stats.Server),
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_Jobs{Jobs: converters.JobStatsToProto(stats)},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestFileSizes(ctx context.Context, stats *common_domain.FileSizeStats) error {
+	return c.enqueue(outboxKindMetrics, &collectorv1.DatabaseMetrics{
+		Server:    serverMetaToProto(stats.Server),
+		Timestamp: timestamppb.New(stats.Timestamp),
+		Metrics:   &collectorv1.DatabaseMetrics_FileSizes{FileSizes: converters.FileSizeStatsToProto(stats)},
+	})
+}
+
+func (c *OutboxIngestionClient) IngestSnapshot(ctx context.Context, snapshot *common_domain.DataBaseSnapshot) error {
+	return c.enqueue(outboxKindSnapshot, &collectorv1.IngestSnapshotRequest{Snapshot: converters.DatabaseSnapshotToProto(snapshot)})
+}
+
+func (c *OutboxIngestionClient) IngestExecPlans(ctx context.Context, executionPlans map[string]*common_domain.ExecutionPlan, server common_domain.ServerMeta) error {
+	if len(executionPlans) == 0 {
+		return nil
+	}
+	protoPlans := make([]*dbmv1.ExecutionPlan, 0, len(executionPlans))
+	for _, p := range executionPlans {
+		protoPlan, err := converters.ExecutionPlanToProto(p)
+		if err != nil {
+			return fmt.Errorf("convert plan proto: %w", err)
+		}
+		protoPlans = append(protoPlans, protoPlan)
+	}
+	return c.enqueue(outboxKindPlans, &collectorv1.IngestExecutionPlansRequest{Plans: protoPlans})
+}
+
+func (c *OutboxIngestionClient) IngestDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, server common_domain.ServerMeta) error {
+	if len(deadlocks) == 0 {
+		return nil
+	}
+	protoDeadlocks := make([]*dbmv1.Deadlock, len(deadlocks))
+	for i, d := range deadlocks {
+		protoDeadlocks[i] = converters.DeadlockToProto(d)
+	}
+	return c.enqueue(outboxKindDeadlocks, &collectorv1.IngestDeadlocksRequest{Deadlocks: protoDeadlocks, Server: serverMetaToProto(server)})
+}
+
+func (c *OutboxIngestionClient) IngestWarnings(ctx context.Context, warnings []*common_domain.Warning, server common_domain.ServerMeta) error {
+	if len(warnings) == 0 {
+		return nil
+	}
+	protoWarnings := make([]*dbmv1.Warning, len(warnings))
+	for i, w := range warnings {
+		protoWarnings[i] = w.WarningData
+	}
+	return c.enqueue(outboxKindWarnings, &collectorv1.IngestWarningsRequest{Warnings: protoWarnings, Server: serverMetaToProto(server)})
+}
+
+func (c *OutboxIngestionClient) GetKnownPlanHandles(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error) {
+	return c.inner.GetKnownPlanHandles(ctx, server)
+}
+
+func (c *OutboxIngestionClient) GetKnownWarnings(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error) {
+	return c.inner.GetKnownWarnings(ctx, server)
+}
+
+// RegisterAgent and Heartbeat are not queued, a late heartbeat would report the agent alive when it was not
+func (c *OutboxIngestionClient) RegisterAgent(ctx context.Context, registration domain.AgentRegistration) error {
+	return c.inner.RegisterAgent(ctx, registration)
+}
+
+func (c *OutboxIngestionClient) Heartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus) error {
+	return c.inner.Heartbeat(ctx, agentID, server, status)
+}
+
+func (c *OutboxIngestionClient) GetCollectionConfig(ctx context.Context, agentID string, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error) {
+	return c.inner.GetCollectionConfig(ctx, agentID, server)
+}
@@ -0,0 +1,145 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIngestionClient struct {
+	mu        sync.Mutex
+	failures  int
+	snapshots []string
+}
+
+func (f *fakeIngestionClient) IngestSnapshot(ctx context.Context, snapshot *common_domain.DataBaseSnapshot) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("collector unavailable")
+	}
+	f.snapshots = append(f.snapshots, snapshot.SnapInfo.ID)
+	return nil
+}
+
+func (f *fakeIngestionClient) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.snapshots...)
+}
+
+func (f *fakeIngestionClient) IngestMetrics(ctx context.Context, metrics []*common_domain.QueryMetric, server common_domain.ServerMeta, timestamp time.Time) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestExecPlans(ctx context.Context, executionPlans map[string]*common_domain.ExecutionPlan, server common_domain.ServerMeta) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) GetKnownPlanHandles(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error) {
+	return nil, nil
+}
+
+func (f *fakeIngestionClient) IngestDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, server common_domain.ServerMeta) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestWarnings(ctx context.Context, warnings []*common_domain.Warning, server common_domain.ServerMeta) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) GetKnownWarnings(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error) {
+	return nil, nil
+}
+
+func snapshot(id string) *common_domain.DataBaseSnapshot {
+	return &common_domain.DataBaseSnapshot{SnapInfo: common_domain.SnapInfo{
+		ID:        id,
+		Timestamp: time.Date(2025, 12, 1, 10, 0, 0, 123456789, time.UTC),
+		Server:    common_domain.ServerMeta{Host: "test-server", Type: "mssql"},
+	}}
+}
+
+func TestOutboxIngestionClient_ReplaysInOrderAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	inner := &fakeIngestionClient{failures: 1}
+	outbox, err := adapters.NewOutboxIngestionClient(inner, dir, "test-server", 1<<20)
+	require.NoError(t, err)
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, outbox.IngestSnapshot(context.Background(), snapshot(id)))
+	}
+
+	// a new client on the same directory picks up what the previous one left
+	outbox, err = adapters.NewOutboxIngestionClient(inner, dir, "test-server", 1<<20)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go outbox.Run(ctx)
+
+	require.Eventually(t, func() bool { return len(inner.sent()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"1", "2", "3"}, inner.sent())
+	require.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestOutboxIngestionClient_DropsOldestWhenFull(t *testing.T) {
+	inner := &fakeIngestionClient{}
+	// each snapshot takes 40 bytes, only the last two fit
+	outbox, err := adapters.NewOutboxIngestionClient(inner, t.TempDir(), "test-server", 100)
+	require.NoError(t, err)
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		require.NoError(t, outbox.IngestSnapshot(context.Background(), snapshot(id)))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go outbox.Run(ctx)
+
+	require.Eventually(t, func() bool {
+		sent := inner.sent()
+		return len(sent) > 0 && sent[len(sent)-1] == "5"
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"4", "5"}, inner.sent())
+}
+
+func (f *fakeIngestionClient) RegisterAgent(ctx context.Context, registration domain.AgentRegistration) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) Heartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestSystemMetrics(ctx context.Context, metrics *common_domain.SystemMetrics) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestIndexStats(ctx context.Context, stats *common_domain.IndexStats) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestQueryStore(ctx context.Context, stats *common_domain.QueryStoreStats) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestJobs(ctx context.Context, stats *common_domain.JobStats) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) IngestFileSizes(ctx context.Context, stats *common_domain.FileSizeStats) error {
+	return nil
+}
+
+func (f *fakeIngestionClient) GetCollectionConfig(ctx context.Context, agentID string, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error) {
+	return nil, nil
+}
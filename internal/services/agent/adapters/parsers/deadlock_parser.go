@@ -0,0 +1,185 @@
+package parsers
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type xmlDeadlock struct {
+	XMLName   xml.Name             `xml:"deadlock"`
+	Victims   []xmlDeadlockVictim  `xml:"victim-list>victimProcess"`
+	Processes []xmlDeadlockProcess `xml:"process-list>process"`
+	Resources xmlResourceList      `xml:"resource-list"`
+}
+
+type xmlDeadlockVictim struct {
+	ID string `xml:"id,attr"`
+}
+
+type xmlDeadlockProcess struct {
+	ID              string             `xml:"id,attr"`
+	WaitResource    string             `xml:"waitresource,attr"`
+	WaitTime        int64              `xml:"waittime,attr"`
+	TransactionName string             `xml:"transactionname,attr"`
+	LastTranStarted string             `xml:"lasttranstarted,attr"`
+	LockMode        string             `xml:"lockMode,attr"`
+	Status          string             `xml:"status,attr"`
+	SPID            string             `xml:"spid,attr"`
+	TranCount       int                `xml:"trancount,attr"`
+	ClientApp       string             `xml:"clientapp,attr"`
+	HostName        string             `xml:"hostname,attr"`
+	LoginName       string             `xml:"loginname,attr"`
+	IsolationLevel  string             `xml:"isolationlevel,attr"`
+	CurrentDB       string             `xml:"currentdb,attr"`
+	CurrentDBName   string             `xml:"currentdbname,attr"`
+	Frames          []xmlDeadlockFrame `xml:"executionStack>frame"`
+	InputBuffer     string             `xml:"inputbuf"`
+}
+
+type xmlDeadlockFrame struct {
+	ProcName      string `xml:"procname,attr"`
+	Line          int    `xml:"line,attr"`
+	SqlHandle     string `xml:"sqlhandle,attr"`
+	QueryHash     string `xml:"queryhash,attr"`
+	QueryPlanHash string `xml:"queryplanhash,attr"`
+	Text          string `xml:",chardata"`
+}
+
+type xmlResourceList struct {
+	Resources []xmlDeadlockResource `xml:",any"`
+}
+
+// xmlDeadlockResource covers every resource kind (keylock, pagelock, ridlock, objectlock, exchangeEvent...),
+// the kind is the element name and attributes a kind does not have are left empty.
+type xmlDeadlockResource struct {
+	XMLName    xml.Name
+	HobtID     string            `xml:"hobtid,attr"`
+	DbID       string            `xml:"dbid,attr"`
+	ObjectName string            `xml:"objectname,attr"`
+	IndexName  string            `xml:"indexname,attr"`
+	Mode       string            `xml:"mode,attr"`
+	Owners     []xmlDeadlockLock `xml:"owner-list>owner"`
+	Waiters    []xmlDeadlockLock `xml:"waiter-list>waiter"`
+}
+
+type xmlDeadlockLock struct {
+	ID          string `xml:"id,attr"`
+	Mode        string `xml:"mode,attr"`
+	RequestType string `xml:"requestType,attr"`
+}
+
+// ParseDeadlockReport parses the deadlock graph of an xml_deadlock_report event.
+// The returned deadlock has no ID, server or timestamp, those come from the event itself.
+func ParseDeadlockReport(xmlData string) (*common_domain.Deadlock, error) {
+	var report xmlDeadlock
+	err := xml.Unmarshal([]byte(xmlData), &report)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal deadlock report: %w", err)
+	}
+	victims := make(map[string]struct{}, len(report.Victims))
+	victimIDs := make([]string, len(report.Victims))
+	for i, v := range report.Victims {
+		victims[v.ID] = struct{}{}
+		victimIDs[i] = v.ID
+	}
+	processes := make([]common_domain.DeadlockProcess, len(report.Processes))
+	for i, p := range report.Processes {
+		frames := make([]common_domain.DeadlockFrame, len(p.Frames))
+		for j, f := range p.Frames {
+			text := strings.TrimSpace(f.Text)
+			if text == "unknown" {
+				text = ""
+			}
+			frames[j] = common_domain.DeadlockFrame{
+				ProcName:      f.ProcName,
+				Line:          f.Line,
+				SqlHandle:     hexToBase64(f.SqlHandle),
+				QueryHash:     hexToBase64(f.QueryHash),
+				QueryPlanHash: hexToBase64(f.QueryPlanHash),
+				Text:          text,
+			}
+		}
+		_, isVictim := victims[p.ID]
+		processes[i] = common_domain.DeadlockProcess{
+			ID:        p.ID,
+			SessionID: p.SPID,
+			IsVictim:  isVictim,
+			Database: common_domain.DataBaseMetadata{
+				DatabaseID:   p.CurrentDB,
+				DatabaseName: p.CurrentDBName,
+			},
+			LoginName:              p.LoginName,
+			HostName:               p.HostName,
+			ProgramName:            p.ClientApp,
+			IsolationLevel:         p.IsolationLevel,
+			Status:                 p.Status,
+			LockMode:               p.LockMode,
+			WaitResource:           p.WaitResource,
+			WaitTimeMs:             p.WaitTime,
+			TransactionName:        p.TransactionName,
+			LastTransactionStarted: parseDeadlockTime(p.LastTranStarted),
+			TransactionCount:       p.TranCount,
+			InputBuffer:            strings.TrimSpace(p.InputBuffer),
+			Frames:                 frames,
+		}
+	}
+	resources := make([]common_domain.DeadlockResource, len(report.Resources.Resources))
+	for i, r := range report.Resources.Resources {
+		resources[i] = common_domain.DeadlockResource{
+			ResourceType: r.XMLName.Local,
+			DatabaseID:   r.DbID,
+			ObjectName:   r.ObjectName,
+			IndexName:    r.IndexName,
+			HobtID:       r.HobtID,
+			Mode:         r.Mode,
+			Owners:       deadlockLocksToDomain(r.Owners),
+			Waiters:      deadlockLocksToDomain(r.Waiters),
+		}
+	}
+	return &common_domain.Deadlock{
+		VictimProcessIDs: victimIDs,
+		Processes:        processes,
+		Resources:        resources,
+		XmlData:          xmlData,
+	}, nil
+}
+
+func deadlockLocksToDomain(locks []xmlDeadlockLock) []common_domain.DeadlockLock {
+	ret := make([]common_domain.DeadlockLock, len(locks))
+	for i, l := range locks {
+		ret[i] = common_domain.DeadlockLock{
+			ProcessID:   l.ID,
+			Mode:        l.Mode,
+			RequestType: l.RequestType,
+		}
+	}
+	return ret
+}
+
+// hexToBase64 converts the 0x prefixed handles of the report to the base64 encoding the readers use for handles.
+// Handles of frames sql server could not resolve are all zeroes and map to an empty string.
+func hexToBase64(h string) string {
+	b, err := hex.DecodeString(strings.TrimPrefix(h, "0x"))
+	if err != nil || len(b) == 0 {
+		return ""
+	}
+	if strings.Trim(string(b), "\x00") == "" {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// parseDeadlockTime parses the report timestamps, they carry no offset and are read as utc.
+func parseDeadlockTime(s string) time.Time {
+	t, err := time.Parse("2006-01-02T15:04:05.999999999", s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
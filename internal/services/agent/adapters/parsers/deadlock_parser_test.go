@@ -0,0 +1,49 @@
+package parsers_test
+
+import (
+	"embed"
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+//go:embed testdata
+var testData embed.FS
+
+func TestParseDeadlockReport(t *testing.T) {
+	data, err := testData.ReadFile("testdata/key_deadlock.xml")
+	require.NoError(t, err)
+	deadlock, err := parsers.ParseDeadlockReport(string(data))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"process1f8e0b2a8c8"}, deadlock.VictimProcessIDs)
+	require.Len(t, deadlock.Processes, 2)
+	victim := deadlock.Processes[0]
+	require.True(t, victim.IsVictim)
+	require.Equal(t, "55", victim.SessionID)
+	require.Equal(t, "Sales", victim.Database.DatabaseName)
+	require.Equal(t, int64(2531), victim.WaitTimeMs)
+	require.Equal(t, time.Date(2025, 10, 5, 10, 15, 22, 477000000, time.UTC), victim.LastTransactionStarted)
+	require.Equal(t, "exec dbo.usp_update_order @order_id = 1, @status = 2", victim.InputBuffer)
+	require.Len(t, victim.Frames, 2)
+	require.Equal(t, "Sales.dbo.usp_update_order", victim.Frames[0].ProcName)
+	require.Equal(t, "Pp96HCtNXm8=", victim.Frames[0].QueryHash)
+	require.Equal(t, "UPDATE dbo.orders SET status = @status WHERE order_id = @order_id", victim.Frames[0].Text)
+	require.Empty(t, victim.Frames[1].Text)
+	require.Empty(t, victim.Frames[1].QueryHash)
+
+	other := deadlock.Processes[1]
+	require.False(t, other.IsVictim)
+	require.Equal(t, "billing-service", other.ProgramName)
+	require.Empty(t, other.Frames[0].QueryHash)
+	require.NotEmpty(t, other.Frames[0].SqlHandle)
+
+	require.Len(t, deadlock.Resources, 2)
+	require.Equal(t, "keylock", deadlock.Resources[0].ResourceType)
+	require.Equal(t, "Sales.dbo.orders", deadlock.Resources[0].ObjectName)
+	require.Equal(t, "PK_orders", deadlock.Resources[0].IndexName)
+	require.Equal(t, "process1f8e0b2a4e8", deadlock.Resources[0].Owners[0].ProcessID)
+	require.Equal(t, "wait", deadlock.Resources[0].Waiters[0].RequestType)
+}
@@ -0,0 +1,243 @@
+package parsers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+)
+
+type PlanAnalyzerThresholds struct {
+	// MinScanRows is the number of rows a scan has to read to be reported as a large table scan
+	MinScanRows float64
+	// MinMissingIndexImpact is the estimated improvement (percent) a missing index needs to be recommended
+	MinMissingIndexImpact float64
+}
+
+func DefaultPlanAnalyzerThresholds() PlanAnalyzerThresholds {
+	return PlanAnalyzerThresholds{
+		MinScanRows:           100_000,
+		MinMissingIndexImpact: 10,
+	}
+}
+
+// ExecutionPlanAnalyzer finds implicit conversions, missing indexes and large scans in showplan xml
+type ExecutionPlanAnalyzer struct {
+	thresholds PlanAnalyzerThresholds
+}
+
+func NewExecutionPlanAnalyzer(thresholds PlanAnalyzerThresholds) *ExecutionPlanAnalyzer {
+	return &ExecutionPlanAnalyzer{thresholds: thresholds}
+}
+
+// xmlPlanNode is a generic showplan element, plans nest statements and operators in many different
+// elements so the analyzer walks the whole tree instead of modelling every operator.
+type xmlPlanNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr    `xml:",any,attr"`
+	Children []xmlPlanNode `xml:",any"`
+}
+
+func (n *xmlPlanNode) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *xmlPlanNode) floatAttr(name string) float64 {
+	f, _ := strconv.ParseFloat(n.attr(name), 64)
+	return f
+}
+
+// find returns the descendants with the given element name, it does not descend into matches
+func (n *xmlPlanNode) find(name string) []*xmlPlanNode {
+	ret := make([]*xmlPlanNode, 0)
+	for i := range n.Children {
+		child := &n.Children[i]
+		if child.XMLName.Local == name {
+			ret = append(ret, child)
+			continue
+		}
+		ret = append(ret, child.find(name)...)
+	}
+	return ret
+}
+
+// findAll returns every descendant with the given element name, including nested ones
+func (n *xmlPlanNode) findAll(name string) []*xmlPlanNode {
+	ret := make([]*xmlPlanNode, 0)
+	for i := range n.Children {
+		child := &n.Children[i]
+		if child.XMLName.Local == name {
+			ret = append(ret, child)
+		}
+		ret = append(ret, child.findAll(name)...)
+	}
+	return ret
+}
+
+// CONVERT_IMPLICIT(nvarchar(50),[master].[dbo].[trades].[strategy],0)
+var convertImplicitRegex = regexp.MustCompile(`CONVERT_IMPLICIT\((\w+(?:\([^)]*\))?),((?:\[[^\]]+\]\.)*\[[^\]]+\])`)
+
+var scanOperators = map[string]struct{}{
+	"Table Scan":           {},
+	"Clustered Index Scan": {},
+	"Index Scan":           {},
+}
+
+func (a *ExecutionPlanAnalyzer) AnalyzePlan(xmlData string) ([]*dbmv1.ExecutionPlanWarning, error) {
+	var root xmlPlanNode
+	if err := xml.Unmarshal([]byte(xmlData), &root); err != nil {
+		return nil, fmt.Errorf("parse execution plan: %w", err)
+	}
+	ret := make([]*dbmv1.ExecutionPlanWarning, 0)
+	for _, stmt := range root.findAll("StmtSimple") {
+		queryHash := hexToBase64(stmt.attr("QueryHash"))
+		planHash := hexToBase64(stmt.attr("QueryPlanHash"))
+		for _, queryPlan := range stmt.find("QueryPlan") {
+			ret = append(ret, a.implicitConversions(queryPlan, queryHash, planHash, stmt.floatAttr("StatementEstRows"))...)
+			ret = append(ret, a.missingIndexes(queryPlan, queryHash, planHash)...)
+			if a.hasLargeScan(queryPlan) {
+				ret = append(ret, &dbmv1.ExecutionPlanWarning{
+					Warning: &dbmv1.ExecutionPlanWarning_LargeTableScan{LargeTableScan: &dbmv1.LargeTableScan{
+						QueryHash:     queryHash,
+						QueryPlanHash: planHash,
+					}},
+				})
+			}
+		}
+	}
+	return ret, nil
+}
+
+// implicitConversions reports plan affecting conversions of table columns, conversions of variables
+// and parameters do not change the plan shape. The plan only carries the target type of the conversion.
+func (a *ExecutionPlanAnalyzer) implicitConversions(queryPlan *xmlPlanNode, queryHash string, planHash string, statementRows float64) []*dbmv1.ExecutionPlanWarning {
+	ret := make([]*dbmv1.ExecutionPlanWarning, 0)
+	seen := make(map[string]struct{})
+	for _, convert := range queryPlan.findAll("PlanAffectingConvert") {
+		match := convertImplicitRegex.FindStringSubmatch(convert.attr("Expression"))
+		if match == nil {
+			continue
+		}
+		parts := strings.Split(match[2], ".")
+		column := parts[len(parts)-1]
+		if len(parts) < 2 || strings.HasPrefix(column, "[@") {
+			continue
+		}
+		table := strings.Join(parts[:len(parts)-1], ".")
+		if _, ok := seen[match[2]]; ok {
+			continue
+		}
+		seen[match[2]] = struct{}{}
+		rows := a.rowsRead(queryPlan, parts[len(parts)-2])
+		if rows == 0 {
+			rows = statementRows
+		}
+		ret = append(ret, &dbmv1.ExecutionPlanWarning{
+			Warning: &dbmv1.ExecutionPlanWarning_ImplicitConversion{ImplicitConversion: &dbmv1.ImplicitConversion{
+				QueryHash:     queryHash,
+				QueryPlanHash: planHash,
+				ColumnName:    column,
+				ToType:        match[1],
+				EstimatedRows: int64(rows),
+				TableName:     table,
+			}},
+		})
+	}
+	return ret
+}
+
+// rowsRead is the largest estimated number of rows read from the table by any operator of the plan
+func (a *ExecutionPlanAnalyzer) rowsRead(queryPlan *xmlPlanNode, table string) float64 {
+	var ret float64
+	for _, relOp := range queryPlan.findAll("RelOp") {
+		for _, object := range relOpObjects(relOp) {
+			if object.attr("Table") == table {
+				ret = max(ret, relOp.floatAttr("EstimatedRowsRead"), relOp.floatAttr("EstimateRows"))
+			}
+		}
+	}
+	return ret
+}
+
+func (a *ExecutionPlanAnalyzer) missingIndexes(queryPlan *xmlPlanNode, queryHash string, planHash string) []*dbmv1.ExecutionPlanWarning {
+	ret := make([]*dbmv1.ExecutionPlanWarning, 0)
+	for _, group := range queryPlan.findAll("MissingIndexGroup") {
+		if group.floatAttr("Impact") < a.thresholds.MinMissingIndexImpact {
+			continue
+		}
+		for _, missingIndex := range group.find("MissingIndex") {
+			ret = append(ret, &dbmv1.ExecutionPlanWarning{
+				Warning: &dbmv1.ExecutionPlanWarning_MissingIndex{MissingIndex: &dbmv1.RecommendedIndex{
+					QueryHash:     queryHash,
+					QueryPlanHash: planHash,
+					Index:         createIndexStatement(missingIndex),
+				}},
+			})
+		}
+	}
+	return ret
+}
+
+func createIndexStatement(missingIndex *xmlPlanNode) string {
+	var keyColumns, includeColumns []string
+	// equality columns go first in the key, same as the index suggested by ssms
+	for _, usage := range []string{"EQUALITY", "INEQUALITY", "INCLUDE"} {
+		for _, group := range missingIndex.find("ColumnGroup") {
+			if group.attr("Usage") != usage {
+				continue
+			}
+			for _, column := range group.find("Column") {
+				if usage == "INCLUDE" {
+					includeColumns = append(includeColumns, column.attr("Name"))
+				} else {
+					keyColumns = append(keyColumns, column.attr("Name"))
+				}
+			}
+		}
+	}
+	table := missingIndex.attr("Table")
+	name := "IX_" + strings.Trim(table, "[]")
+	for _, column := range keyColumns {
+		name += "_" + strings.Trim(column, "[]")
+	}
+	stmt := fmt.Sprintf("CREATE NONCLUSTERED INDEX [%s] ON %s.%s.%s (%s)", name, missingIndex.attr("Database"),
+		missingIndex.attr("Schema"), table, strings.Join(keyColumns, ", "))
+	if len(includeColumns) > 0 {
+		stmt += fmt.Sprintf(" INCLUDE (%s)", strings.Join(includeColumns, ", "))
+	}
+	return stmt
+}
+
+// hasLargeScan is true when the plan scans a table or index reading at least MinScanRows rows
+func (a *ExecutionPlanAnalyzer) hasLargeScan(queryPlan *xmlPlanNode) bool {
+	for _, relOp := range queryPlan.findAll("RelOp") {
+		if _, ok := scanOperators[relOp.attr("PhysicalOp")]; !ok {
+			continue
+		}
+		if max(relOp.floatAttr("EstimatedRowsRead"), relOp.floatAttr("EstimateRows")) >= a.thresholds.MinScanRows {
+			return true
+		}
+	}
+	return false
+}
+
+// relOpObjects returns the objects an operator reads, nested operators are not included
+func relOpObjects(relOp *xmlPlanNode) []*xmlPlanNode {
+	ret := make([]*xmlPlanNode, 0)
+	for i := range relOp.Children {
+		for j := range relOp.Children[i].Children {
+			if relOp.Children[i].Children[j].XMLName.Local == "Object" {
+				ret = append(ret, &relOp.Children[i].Children[j])
+			}
+		}
+	}
+	return ret
+}
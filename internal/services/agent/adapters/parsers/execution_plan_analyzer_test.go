@@ -0,0 +1,58 @@
+package parsers_test
+
+import (
+	"testing"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionPlanAnalyzer_AnalyzePlan(t *testing.T) {
+	analyzer := parsers.NewExecutionPlanAnalyzer(parsers.DefaultPlanAnalyzerThresholds())
+	analyze := func(file string) map[string]int {
+		data, err := testData.ReadFile(file)
+		require.NoError(t, err)
+		warnings, err := analyzer.AnalyzePlan(string(data))
+		require.NoError(t, err)
+		ret := make(map[string]int)
+		for _, w := range warnings {
+			switch {
+			case w.GetImplicitConversion() != nil:
+				ret["implicit_conversion"]++
+			case w.GetMissingIndex() != nil:
+				ret["missing_index"]++
+			case w.GetLargeTableScan() != nil:
+				ret["large_table_scan"]++
+			}
+		}
+		return ret
+	}
+
+	require.Equal(t, map[string]int{"implicit_conversion": 1, "large_table_scan": 1}, analyze("testdata/loop_with_implicit_conversion.xml"))
+	require.Equal(t, map[string]int{"missing_index": 1, "large_table_scan": 1}, analyze("testdata/missing_index.xml"))
+	require.Equal(t, map[string]int{"missing_index": 1, "large_table_scan": 1}, analyze("testdata/table_scan.xml"))
+	require.Empty(t, analyze("testdata/select_groupby.xml"))
+}
+
+func TestExecutionPlanAnalyzer_Details(t *testing.T) {
+	analyzer := parsers.NewExecutionPlanAnalyzer(parsers.DefaultPlanAnalyzerThresholds())
+	data, err := testData.ReadFile("testdata/loop_with_implicit_conversion.xml")
+	require.NoError(t, err)
+	warnings, err := analyzer.AnalyzePlan(string(data))
+	require.NoError(t, err)
+	conversion := warnings[0].GetImplicitConversion()
+	require.NotNil(t, conversion)
+	require.Equal(t, "Hw5xAW0IGyY=", conversion.QueryHash)
+	require.Equal(t, "Qwt5O48y7WU=", conversion.QueryPlanHash)
+	require.Equal(t, "[master].[dbo].[trades]", conversion.TableName)
+	require.Equal(t, "[strategy]", conversion.ColumnName)
+	require.Equal(t, "nvarchar(50)", conversion.ToType)
+	require.Equal(t, int64(2027000), conversion.EstimatedRows)
+
+	data, err = testData.ReadFile("testdata/missing_index.xml")
+	require.NoError(t, err)
+	warnings, err = analyzer.AnalyzePlan(string(data))
+	require.NoError(t, err)
+	require.Equal(t, "CREATE NONCLUSTERED INDEX [IX_trades_account] ON [master].[dbo].[trades] ([account]) INCLUDE ([strategy], [qty], [price], [asset])",
+		warnings[0].GetMissingIndex().Index)
+}
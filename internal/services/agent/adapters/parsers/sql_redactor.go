@@ -0,0 +1,300 @@
+package parsers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type RedactionMode string
+
+const (
+	// RedactionOff ships the text as read from the target
+	RedactionOff RedactionMode = "off"
+	// RedactionLiterals replaces string, numeric, binary and money literals with ? and applies the masks
+	RedactionLiterals RedactionMode = "literals"
+	// RedactionHash replaces the whole text with a hash of the text without literals
+	RedactionHash RedactionMode = "hash"
+)
+
+// RedactionMask replaces what Pattern matches in the text left after the literals are redacted
+type RedactionMask struct {
+	Pattern     *regexp.Regexp
+	Replacement string
+}
+
+// SQLDialect is the SQL flavour of a target, named after the database/sql driver of the target
+type SQLDialect string
+
+const (
+	// DialectTSQL quotes identifiers with [] and "", strings escape quotes by doubling them and $12.50 is money
+	DialectTSQL SQLDialect = "mssql"
+	// DialectPostgres quotes identifiers with "", has dollar quoted ($$...$$) and escape (E'...') strings and $n
+	// parameters
+	DialectPostgres SQLDialect = "postgres"
+	// DialectMySQL quotes identifiers with backticks, "..." is a string and strings escape with backslashes
+	DialectMySQL SQLDialect = "mysql"
+)
+
+// SQLRedactor removes sensitive values from the SQL text of a target before it leaves the agent
+type SQLRedactor struct {
+	mode    RedactionMode
+	dialect SQLDialect
+	masks   []RedactionMask
+}
+
+// NewSQLRedactor validates the mode and the dialect, an empty mode is off
+func NewSQLRedactor(mode RedactionMode, dialect SQLDialect, masks []RedactionMask) (*SQLRedactor, error) {
+	switch mode {
+	case "":
+		mode = RedactionOff
+	case RedactionOff, RedactionLiterals, RedactionHash:
+	default:
+		return nil, fmt.Errorf("unknown redaction mode %q", mode)
+	}
+	switch dialect {
+	case DialectTSQL, DialectPostgres, DialectMySQL:
+	default:
+		return nil, fmt.Errorf("unknown sql dialect %q", dialect)
+	}
+	return &SQLRedactor{mode: mode, dialect: dialect, masks: masks}, nil
+}
+
+func (r *SQLRedactor) Mode() RedactionMode {
+	return r.mode
+}
+
+func (r *SQLRedactor) Redact(text string) string {
+	switch r.mode {
+	case RedactionLiterals:
+		redacted := RedactDialectLiterals(r.dialect, text)
+		for _, mask := range r.masks {
+			redacted = mask.Pattern.ReplaceAllString(redacted, mask.Replacement)
+		}
+		return redacted
+	case RedactionHash:
+		if text == "" {
+			return ""
+		}
+		// statements differing only by literals share the hash
+		sum := sha256.Sum256([]byte(RedactDialectLiterals(r.dialect, text)))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	default:
+		return text
+	}
+}
+
+// RedactLiterals replaces the literals of a T-SQL batch with ?, leaving comments, variables and
+// quoted identifiers ([name] and "name") untouched.
+func RedactLiterals(text string) string {
+	return RedactDialectLiterals(DialectTSQL, text)
+}
+
+// RedactDialectLiterals replaces the literals of SQL text in dialect with ?, leaving comments, variables, parameters
+// and quoted identifiers untouched
+func RedactDialectLiterals(dialect SQLDialect, text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+	n := len(text)
+	for i := 0; i < n; {
+		c := text[i]
+		switch {
+		case c == '-' && i+1 < n && text[i+1] == '-':
+			end := strings.IndexByte(text[i:], '\n')
+			if end < 0 {
+				end = n - i
+			}
+			sb.WriteString(text[i : i+end])
+			i += end
+		case c == '/' && i+1 < n && text[i+1] == '*':
+			end := blockCommentEnd(text, i, dialect != DialectMySQL)
+			sb.WriteString(text[i:end])
+			i = end
+		case c == '[' && dialect == DialectTSQL:
+			end := quotedEnd(text, i, ']', false)
+			sb.WriteString(text[i:end])
+			i = end
+		case c == '`' && dialect == DialectMySQL:
+			end := quotedEnd(text, i, '`', false)
+			sb.WriteString(text[i:end])
+			i = end
+		case c == '"' && dialect == DialectMySQL:
+			sb.WriteByte('?')
+			i = quotedEnd(text, i, '"', true)
+		case c == '"':
+			end := quotedEnd(text, i, '"', false)
+			sb.WriteString(text[i:end])
+			i = end
+		case c == '\'':
+			sb.WriteByte('?')
+			i = quotedEnd(text, i, '\'', dialect == DialectMySQL)
+		case (c == 'N' || c == 'n') && i+1 < n && text[i+1] == '\'' && !isIdentifierChar(previous(text, i)):
+			sb.WriteByte('?')
+			i = quotedEnd(text, i+1, '\'', dialect == DialectMySQL)
+		case (c == 'E' || c == 'e') && dialect == DialectPostgres && i+1 < n && text[i+1] == '\'' &&
+			!isIdentifierChar(previous(text, i)):
+			sb.WriteByte('?')
+			i = quotedEnd(text, i+1, '\'', true)
+		case c == '$' && dialect == DialectPostgres && !isIdentifierChar(previous(text, i)):
+			// $n parameters are kept, $tag$...$tag$ strings are redacted
+			end := i + 1
+			for end < n && isDigit(text[end]) {
+				end++
+			}
+			if end > i+1 {
+				sb.WriteString(text[i:end])
+				i = end
+				continue
+			}
+			end = dollarQuotedEnd(text, i)
+			if end < 0 {
+				sb.WriteByte(c)
+				i++
+				continue
+			}
+			sb.WriteByte('?')
+			i = end
+		case isIdentifierChar(c) && !isLiteralStart(dialect, text, i):
+			// identifiers, keywords and variables are copied whole so their digits are kept
+			end := i
+			for end < n && isIdentifierChar(text[end]) {
+				end++
+			}
+			sb.WriteString(text[i:end])
+			i = end
+		case isLiteralStart(dialect, text, i):
+			sb.WriteByte('?')
+			i = numberEnd(text, i)
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '@' || c == '#' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func previous(text string, i int) byte {
+	if i == 0 {
+		return ' '
+	}
+	return text[i-1]
+}
+
+// isLiteralStart reports numbers (12, .5, 0x1F) and T-SQL money ($12.50) that are not part of an identifier
+func isLiteralStart(dialect SQLDialect, text string, i int) bool {
+	if isIdentifierChar(previous(text, i)) {
+		return false
+	}
+	c := text[i]
+	next := byte(' ')
+	if i+1 < len(text) {
+		next = text[i+1]
+	}
+	return isDigit(c) || ((c == '.' || (c == '$' && dialect == DialectTSQL)) && isDigit(next))
+}
+
+func numberEnd(text string, i int) int {
+	n := len(text)
+	if text[i] == '$' {
+		i++
+	}
+	if text[i] == '0' && i+1 < n && (text[i+1] == 'x' || text[i+1] == 'X') {
+		i += 2
+		for i < n && isHexDigit(text[i]) {
+			i++
+		}
+		return i
+	}
+	for i < n && (isDigit(text[i]) || text[i] == '.') {
+		i++
+	}
+	if i < n && (text[i] == 'e' || text[i] == 'E') {
+		j := i + 1
+		if j < n && (text[j] == '+' || text[j] == '-') {
+			j++
+		}
+		if j < n && isDigit(text[j]) {
+			i = j
+			for i < n && isDigit(text[i]) {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// quotedEnd returns the position after the closing quote, a doubled quote is an escaped one and so is a quote after a
+// backslash when backslashEscapes
+func quotedEnd(text string, start int, quote byte, backslashEscapes bool) int {
+	for i := start + 1; i < len(text); i++ {
+		if backslashEscapes && text[i] == '\\' {
+			i++
+			continue
+		}
+		if text[i] != quote {
+			continue
+		}
+		if i+1 < len(text) && text[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(text)
+}
+
+// blockCommentEnd returns the position after a block comment, T-SQL and Postgres block comments nest
+func blockCommentEnd(text string, start int, nested bool) int {
+	depth := 0
+	for i := start; i < len(text)-1; i++ {
+		switch {
+		case text[i] == '/' && text[i+1] == '*' && (nested || depth == 0):
+			depth++
+			i++
+		case text[i] == '*' && text[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(text)
+}
+
+// dollarQuotedEnd returns the position after a Postgres dollar quoted string starting at start, $$...$$ or
+// $tag$...$tag$, it is -1 when no tag starts there
+func dollarQuotedEnd(text string, start int) int {
+	end := start + 1
+	for end < len(text) && text[end] != '$' {
+		c := text[end]
+		if !(c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (isDigit(c) && end > start+1)) {
+			return -1
+		}
+		end++
+	}
+	if end >= len(text) {
+		return -1
+	}
+	tag := text[start : end+1]
+	closing := strings.Index(text[end+1:], tag)
+	if closing < 0 {
+		return len(text)
+	}
+	return end + 1 + closing + len(tag)
+}
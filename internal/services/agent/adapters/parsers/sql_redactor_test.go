@@ -0,0 +1,169 @@
+package parsers_test
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactLiterals(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "strings and numbers",
+			text: "SELECT * FROM users WHERE email = 'john@example.com' AND account = 12345678",
+			want: "SELECT * FROM users WHERE email = ? AND account = ?",
+		},
+		{
+			name: "unicode string and escaped quote",
+			text: "UPDATE t1 SET name = N'O''Brien', balance = -12.5e3 WHERE id IN (1, 2)",
+			want: "UPDATE t1 SET name = ?, balance = -? WHERE id IN (?, ?)",
+		},
+		{
+			name: "binary and money",
+			text: "INSERT INTO payments VALUES (0x1F2E, $10.50, .5)",
+			want: "INSERT INTO payments VALUES (?, ?, ?)",
+		},
+		{
+			name: "identifiers and variables keep their digits",
+			text: "(@p1 int)SELECT col2 FROM [table 1] JOIN #tmp3 ON \"x'1\".id = @p1",
+			want: "(@p1 int)SELECT col2 FROM [table 1] JOIN #tmp3 ON \"x'1\".id = @p1",
+		},
+		{
+			name: "comments are kept",
+			text: "/* batch /* 42 */ 'a' */ SELECT 1 -- 'note' 7\nFROM t",
+			want: "/* batch /* 42 */ 'a' */ SELECT ? -- 'note' 7\nFROM t",
+		},
+		{
+			name: "unterminated string",
+			text: "SELECT 'abc",
+			want: "SELECT ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parsers.RedactLiterals(tt.text))
+		})
+	}
+}
+
+func TestRedactDialectLiterals(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect parsers.SQLDialect
+		text    string
+		want    string
+	}{
+		{
+			name:    "mysql double quoted strings",
+			dialect: parsers.DialectMySQL,
+			text:    `SELECT * FROM users WHERE email = "alice@example.com" AND id = 7`,
+			want:    "SELECT * FROM users WHERE email = ? AND id = ?",
+		},
+		{
+			name:    "mysql backslash escapes",
+			dialect: parsers.DialectMySQL,
+			text:    `UPDATE notes SET body = 'it\'s 4111-1111', tag = "say \"hi\"" WHERE id = 1`,
+			want:    "UPDATE notes SET body = ?, tag = ? WHERE id = ?",
+		},
+		{
+			name:    "mysql backticks and variables",
+			dialect: parsers.DialectMySQL,
+			text:    "SELECT `col 1`, @row2 FROM `orders2024` WHERE `x'1` = ?",
+			want:    "SELECT `col 1`, @row2 FROM `orders2024` WHERE `x'1` = ?",
+		},
+		{
+			name:    "postgres dollar quoted strings",
+			dialect: parsers.DialectPostgres,
+			text:    "SELECT $$alice@example.com$$, $tag$it's $$ secret$tag$ FROM t",
+			want:    "SELECT ?, ? FROM t",
+		},
+		{
+			name:    "postgres escape strings",
+			dialect: parsers.DialectPostgres,
+			text:    `SELECT * FROM cards WHERE number = E'it\'s 4111-1111' AND note = 'a''b'`,
+			want:    "SELECT * FROM cards WHERE number = ? AND note = ?",
+		},
+		{
+			name:    "postgres parameters, arrays and quoted identifiers",
+			dialect: parsers.DialectPostgres,
+			text:    `SELECT "col 1", tags[2] FROM orders WHERE id = $1 AND total > $2 AND code = ANY(ARRAY[10, 20])`,
+			want:    `SELECT "col 1", tags[?] FROM orders WHERE id = $1 AND total > $2 AND code = ANY(ARRAY[?, ?])`,
+		},
+		{
+			name:    "tsql money and bracketed identifiers",
+			dialect: parsers.DialectTSQL,
+			text:    "SELECT [col 1] FROM t WHERE price = $10.50",
+			want:    "SELECT [col 1] FROM t WHERE price = ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parsers.RedactDialectLiterals(tt.dialect, tt.text))
+		})
+	}
+}
+
+func TestSQLRedactor_Redact(t *testing.T) {
+	text := "EXEC notify @to = 'jane@example.com' -- contact jane@example.com"
+
+	off, err := parsers.NewSQLRedactor("", parsers.DialectTSQL, nil)
+	require.NoError(t, err)
+	assert.Equal(t, text, off.Redact(text))
+
+	literals, err := parsers.NewSQLRedactor(parsers.RedactionLiterals, parsers.DialectTSQL, []parsers.RedactionMask{
+		{Pattern: regexp.MustCompile(`[\w.]+@[\w.]+`), Replacement: "<email>"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "EXEC notify @to = ? -- contact <email>", literals.Redact(text))
+
+	hash, err := parsers.NewSQLRedactor(parsers.RedactionHash, parsers.DialectTSQL, nil)
+	require.NoError(t, err)
+	redacted := hash.Redact("SELECT * FROM t WHERE id = 1")
+	assert.True(t, strings.HasPrefix(redacted, "sha256:"))
+	assert.Equal(t, redacted, hash.Redact("SELECT * FROM t WHERE id = 2"))
+	assert.NotEqual(t, redacted, hash.Redact("SELECT * FROM t2 WHERE id = 1"))
+
+	_, err = parsers.NewSQLRedactor("everything", parsers.DialectTSQL, nil)
+	require.Error(t, err)
+	_, err = parsers.NewSQLRedactor(parsers.RedactionLiterals, "oracle", nil)
+	require.Error(t, err)
+}
+
+func TestSQLRedactor_RedactPlanXML(t *testing.T) {
+	redactor, err := parsers.NewSQLRedactor(parsers.RedactionLiterals, parsers.DialectTSQL, nil)
+	require.NoError(t, err)
+	plan := `<StmtSimple StatementText="select * from users where email = N&apos;jane@example.com&apos; and id = @id">` +
+		`<ScalarOperator ScalarString="[users].[email]=N&apos;jane@example.com&apos;"><Const ConstValue="N&apos;jane@example.com&apos;"/></ScalarOperator>` +
+		`<ParameterList><ColumnReference Column="@id" ParameterCompiledValue="(42)" ParameterRuntimeValue="(43)"/></ParameterList></StmtSimple>`
+
+	redacted := redactor.RedactPlanXML(plan)
+	assert.NotContains(t, redacted, "jane@example.com")
+	assert.NotContains(t, redacted, "42")
+	assert.Contains(t, redacted, `StatementText="select * from users where email = ? and id = @id"`)
+	assert.Contains(t, redacted, `ParameterCompiledValue="?" ParameterRuntimeValue="?"`)
+
+	off, err := parsers.NewSQLRedactor(parsers.RedactionOff, parsers.DialectTSQL, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plan, off.RedactPlanXML(plan))
+}
+
+func TestSQLRedactor_RedactDeadlockXML(t *testing.T) {
+	redactor, err := parsers.NewSQLRedactor(parsers.RedactionLiterals, parsers.DialectTSQL, nil)
+	require.NoError(t, err)
+	graph, err := os.ReadFile("testdata/key_deadlock.xml")
+	require.NoError(t, err)
+
+	redacted := redactor.RedactDeadlockXML(string(graph))
+	assert.Contains(t, redacted, "exec dbo.usp_update_order @order_id = ?, @status = ?")
+	assert.Contains(t, redacted, "update dbo.invoices set paid = ? where order_id = ?")
+	assert.Contains(t, redacted, `<frame procname="adhoc" line="1" stmtstart="58"`)
+}
@@ -0,0 +1,39 @@
+package parsers
+
+import (
+	"html"
+	"regexp"
+)
+
+var (
+	// planTextAttribute holds the T-SQL of a statement or of an operator predicate
+	planTextAttribute = regexp.MustCompile(`\b(StatementText|ScalarString)="([^"]*)"`)
+	// planValueAttribute holds the parameter values a plan was compiled or ran with and the constants of its operators
+	planValueAttribute = regexp.MustCompile(`\b(ParameterCompiledValue|ParameterRuntimeValue|ConstValue)="[^"]*"`)
+	// deadlockTextElement holds the input buffer of a process or the statement of a frame of its stack
+	deadlockTextElement = regexp.MustCompile(`(?s)(<inputbuf>|<frame\b(?:[^>]*[^/>])?>)(.*?)(</inputbuf>|</frame>)`)
+)
+
+// RedactPlanXML redacts the statements and predicates of a showplan and replaces the parameter values and constants
+// with ?, the rest of the plan is kept for the analysis of the collector
+func (r *SQLRedactor) RedactPlanXML(plan string) string {
+	if r.mode == RedactionOff {
+		return plan
+	}
+	plan = planTextAttribute.ReplaceAllStringFunc(plan, func(attr string) string {
+		m := planTextAttribute.FindStringSubmatch(attr)
+		return m[1] + `="` + html.EscapeString(r.Redact(html.UnescapeString(m[2]))) + `"`
+	})
+	return planValueAttribute.ReplaceAllString(plan, `$1="?"`)
+}
+
+// RedactDeadlockXML redacts the input buffers and the frame statements of a deadlock graph
+func (r *SQLRedactor) RedactDeadlockXML(graph string) string {
+	if r.mode == RedactionOff {
+		return graph
+	}
+	return deadlockTextElement.ReplaceAllStringFunc(graph, func(element string) string {
+		m := deadlockTextElement.FindStringSubmatch(element)
+		return m[1] + html.EscapeString(r.Redact(html.UnescapeString(m[2]))) + m[3]
+	})
+}
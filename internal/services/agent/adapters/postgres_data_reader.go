@@ -0,0 +1,338 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PostgresDataReader reads session samples from pg_stat_activity and query metrics from pg_stat_statements.
+// It requires PostgreSQL 14+ (query_id on pg_stat_activity) with the pg_stat_statements extension installed
+// on the database the agent connects to.
+type PostgresDataReader struct {
+	dbByHost                map[string]*sqlx.DB
+	lastQueryCountersByHost map[string]map[string]map[string]int64
+	qCountMu                *sync.Mutex
+	tracer                  trace.Tracer
+}
+
+var _ domain.SamplesReader = (*PostgresDataReader)(nil)
+var _ domain.QueryMetricsReader = (*PostgresDataReader)(nil)
+
+func NewPostgresDataReader(dbByHost map[string]*sqlx.DB) PostgresDataReader {
+	return PostgresDataReader{dbByHost: dbByHost, lastQueryCountersByHost: make(map[string]map[string]map[string]int64), qCountMu: &sync.Mutex{},
+		tracer: otel.Tracer("PostgresDataReader")}
+}
+
+func (p PostgresDataReader) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error) {
+	ctx, span := p.tracer.Start(ctx, "TakeSnapshot")
+	defer span.End()
+	db, ok := p.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("dbByHost[%s] not found", server.Host)
+	}
+	snapID := uuid.NewString()
+	snapTime := time.Now().In(time.UTC)
+	// idle sessions cannot hold locks, "idle in transaction" ones can and are kept so sleeping blockers show up
+	query := `
+select a.pid,
+       coalesce(a.backend_start, now())                                     as backend_start,
+       coalesce(a.client_hostname, '')                                      as client_hostname,
+       coalesce(a.application_name, '')                                     as application_name,
+       coalesce(a.usename, '')                                              as usename,
+       coalesce(a.state, '')                                                as state,
+       coalesce(a.datid, 0)                                                 as datid,
+       coalesce(a.datname, '')                                              as datname,
+       a.wait_event_type || ':' || a.wait_event                             as wait_type,
+       case
+           when a.wait_event is null then 0
+           else (extract(epoch from now() - coalesce(a.state_change, a.query_start)) * 1000)::bigint
+           end                                                              as wait_ms,
+       (extract(epoch from now() - coalesce(a.query_start, now())) * 1000)::bigint as elapsed_ms,
+       coalesce(a.query_start, a.backend_start, now())                      as query_start,
+       coalesce(a.state_change, a.query_start, now())                       as state_change,
+       coalesce(a.backend_xid::text, '')                                    as backend_xid,
+       coalesce(a.query_id, 0)                                              as query_id,
+       coalesce(host(a.client_addr), '')                                    as client_addr,
+       coalesce(a.query, '')                                                as query,
+       pg_blocking_pids(a.pid)                                              as blocking_pids
+from pg_stat_activity a
+where a.pid <> pg_backend_pid()
+  and a.backend_type = 'client backend'
+  and coalesce(a.state, '') <> 'idle'
+`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query pg_stat_activity: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	querySamples := make([]*common_domain.QuerySample, 0)
+	blockingMap := make(map[int][]string)
+	for rows.Next() {
+		var r postgresActivityRow
+		err = rows.Scan(&r.pid,
+			&r.backendStart,
+			&r.clientHostname,
+			&r.applicationName,
+			&r.userName,
+			&r.state,
+			&r.datID,
+			&r.datName,
+			&r.waitType,
+			&r.waitMs,
+			&r.elapsedMs,
+			&r.queryStart,
+			&r.stateChange,
+			&r.backendXid,
+			&r.queryID,
+			&r.clientAddr,
+			&r.text,
+			&r.blockingPids,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("query pg_stat_activity scan: %w", err)
+		}
+		if !databases.Matches(r.datName) {
+			continue
+		}
+		for _, blockingPid := range r.blockingPids {
+			blockingMap[int(blockingPid)] = append(blockingMap[int(blockingPid)], strconv.Itoa(r.pid))
+		}
+		querySamples = append(querySamples, r.querySample(snapID, snapTime))
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("query pg_stat_activity rows: %w", err)
+	}
+	for _, qs := range querySamples {
+		var sessionID int
+		sessionID, err = strconv.Atoi(qs.Session.SessionID)
+		if err != nil {
+			continue
+		}
+		if bl, ok := blockingMap[sessionID]; ok {
+			qs.SetBlockedIds(bl)
+		}
+	}
+	return []*common_domain.DataBaseSnapshot{
+		{
+			Samples: querySamples,
+			SnapInfo: common_domain.SnapInfo{
+				ID:        snapID,
+				Timestamp: snapTime,
+				Server: common_domain.ServerMeta{
+					Host: server.Host,
+					Type: server.Type,
+				},
+			},
+		},
+	}, nil
+}
+
+// postgresActivityRow is a pg_stat_activity row read by TakeSnapshot
+type postgresActivityRow struct {
+	pid             int
+	backendStart    time.Time
+	clientHostname  string
+	applicationName string
+	userName        string
+	state           string
+	datID           int
+	datName         string
+	waitType        *string
+	waitMs          int64
+	elapsedMs       int64
+	queryStart      time.Time
+	stateChange     time.Time
+	backendXid      string
+	queryID         int64
+	clientAddr      string
+	text            string
+	blockingPids    pq.Int64Array
+}
+
+// querySample maps the row, a backend blocked by several others is reported as blocked by the first one
+func (r postgresActivityRow) querySample(snapID string, snapTime time.Time) *common_domain.QuerySample {
+	var blockedBy string
+	if len(r.blockingPids) > 0 {
+		blockedBy = strconv.FormatInt(r.blockingPids[0], 10)
+	}
+	sampleId := []byte(fmt.Sprintf("%d_%d_%d", r.pid, r.backendStart.UnixNano(), r.queryStart.UnixNano()))
+	return &common_domain.QuerySample{
+		Id:        base64.StdEncoding.EncodeToString(sampleId),
+		Status:    r.state,
+		Cmd:       "",
+		QueryHash: postgresQueryHash(r.queryID),
+		Text:      r.text,
+		IsBlocked: len(r.blockingPids) > 0,
+		IsBlocker: false,
+		Session: common_domain.SessionMetadata{
+			SessionID:            strconv.Itoa(r.pid),
+			LoginTime:            r.backendStart,
+			HostName:             r.clientHostname,
+			ProgramName:          r.applicationName,
+			LoginName:            r.userName,
+			Status:               r.state,
+			LastRequestStartTime: r.queryStart,
+			LastRequestEndTime:   r.stateChange,
+			ConnectionId:         strconv.Itoa(r.pid),
+			ClientIP:             r.clientAddr,
+		},
+		Database: common_domain.DataBaseMetadata{
+			DatabaseID:   strconv.Itoa(r.datID),
+			DatabaseName: r.datName,
+		},
+		Block: common_domain.BlockMetadata{
+			BlockedBy:       blockedBy,
+			BlockedSessions: make([]string, 0),
+		},
+		Wait: common_domain.WaitMetadata{
+			WaitType: r.waitType,
+			WaitTime: int(r.waitMs),
+		},
+		Snapshot: common_domain.SnapshotMetadata{
+			ID:        snapID,
+			Timestamp: snapTime,
+		},
+		TimeElapsedMs: r.elapsedMs,
+		CommandMetadata: common_domain.CommandMetadata{
+			TransactionId: r.backendXid,
+		},
+	}
+}
+
+func (p PostgresDataReader) CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error) {
+	ctx, span := p.tracer.Start(ctx, "CollectMetrics")
+	defer span.End()
+	db, ok := p.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	// pg_stat_statements keeps one row per (user, database, query, toplevel), so the counters are summed per query and database.
+	// there is no cpu time or memory grant information, only the counters with a sql server equivalent are reported
+	query := `
+select s.queryid,
+       coalesce(d.datname, '')                              as datname,
+       max(s.query)                                         as query,
+       sum(s.calls)::bigint                                 as calls,
+       (sum(s.total_exec_time) * 1000)::bigint              as total_exec_time_us,
+       coalesce(max(s.mean_exec_time) * 1000, 0)::bigint    as mean_exec_time_us,
+       sum(s.rows)::bigint                                  as rows,
+       sum(s.shared_blks_hit + s.shared_blks_read)::bigint  as logical_reads,
+       sum(s.shared_blks_read)::bigint                      as physical_reads,
+       sum(s.shared_blks_dirtied)::bigint                   as logical_writes,
+       sum(s.temp_blks_written)::bigint                     as spills
+from pg_stat_statements s
+         left join pg_database d on d.oid = s.dbid
+where s.queryid is not null
+group by s.queryid, d.datname
+`
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("collecting metrics: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	collectedAt := time.Now()
+	ret := make([]*common_domain.QueryMetric, 0)
+	for rows.Next() {
+		var queryID int64
+		var dbName string
+		var text string
+		var calls int64
+		var totalExecTime int64
+		var meanExecTime int64
+		var rowCount int64
+		var logicalReads int64
+		var physicalReads int64
+		var logicalWrites int64
+		var spills int64
+		err = rows.Scan(&queryID, &dbName, &text, &calls, &totalExecTime, &meanExecTime, &rowCount,
+			&logicalReads, &physicalReads, &logicalWrites, &spills)
+		if err != nil {
+			return nil, fmt.Errorf("collecting metrics - scan: %w", err)
+		}
+		if !databases.Matches(dbName) {
+			continue
+		}
+		counters := map[string]int64{
+			"executionCount":     calls,
+			"totalElapsedTime":   totalExecTime,
+			"totalRows":          rowCount,
+			"totalLogicalReads":  logicalReads,
+			"totalPhysicalReads": physicalReads,
+			"totalLogicalWrites": logicalWrites,
+			"totalSpills":        spills,
+		}
+		queryHash := postgresQueryHash(queryID)
+		counterKey := dbName + "/" + queryHash
+		p.qCountMu.Lock()
+		lastQueryCounters, found := p.lastQueryCountersByHost[server.Host]
+		if !found {
+			lastQueryCounters = make(map[string]map[string]int64)
+		}
+		lastCounters, ok := lastQueryCounters[counterKey]
+		lastQueryCounters[counterKey] = counters
+		p.lastQueryCountersByHost[server.Host] = lastQueryCounters
+		p.qCountMu.Unlock()
+		digestedCounters := counters
+		if ok {
+			digestedCounters = queryCounterDeltas(counters, lastCounters)
+			if digestedCounters["executionCount"] == 0 {
+				continue
+			}
+		} else {
+			if calls > 30 {
+				continue
+			}
+		}
+		ret = append(ret, &common_domain.QueryMetric{
+			QueryHash: queryHash,
+			Text:      text,
+			Database: common_domain.DataBaseMetadata{
+				DatabaseName: dbName,
+			},
+			LastExecutionTime: collectedAt,
+			LastElapsedTime:   time.Duration(meanExecTime) * time.Microsecond,
+			Counters:          digestedCounters,
+			Rates:             nil,
+		})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("collecting metrics - rows.Err: %w", err)
+	}
+	return ret, nil
+}
+
+// GetPlanHandles returns no plans: postgres has no plan cache that can be read from outside the session.
+func (p PostgresDataReader) GetPlanHandles(ctx context.Context, handles []string, server common_domain.ServerMeta) (map[string]*common_domain.ExecutionPlan, error) {
+	return make(map[string]*common_domain.ExecutionPlan), nil
+}
+
+// postgresQueryHash encodes a pg query id the same way sql server query hashes are encoded, so samples
+// and pg_stat_statements metrics of the same query share a hash.
+func postgresQueryHash(queryID int64) string {
+	if queryID == 0 {
+		return ""
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(queryID))
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresActivityRow_QuerySample(t *testing.T) {
+	snapTime := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	queryStart := snapTime.Add(-1500 * time.Millisecond)
+	waitType := "Lock:transactionid"
+	row := postgresActivityRow{
+		pid:             4242,
+		backendStart:    snapTime.Add(-time.Hour),
+		applicationName: "orders-api",
+		userName:        "orders",
+		state:           "active",
+		datID:           16384,
+		datName:         "orders",
+		waitType:        &waitType,
+		waitMs:          1200,
+		elapsedMs:       1500,
+		queryStart:      queryStart,
+		stateChange:     queryStart,
+		backendXid:      "7781",
+		queryID:         -3457625470474785046,
+		clientAddr:      "10.0.0.12",
+		text:            "update orders set status = $1 where id = $2",
+		blockingPids:    pq.Int64Array{4100, 4101},
+	}
+
+	sample := row.querySample("snap-1", snapTime)
+	assert.Equal(t, "active", sample.Status)
+	assert.Equal(t, "4242", sample.Session.SessionID)
+	assert.Equal(t, "10.0.0.12", sample.Session.ClientIP)
+	assert.Equal(t, "16384", sample.Database.DatabaseID)
+	assert.True(t, sample.IsBlocked)
+	assert.Equal(t, "4100", sample.Block.BlockedBy)
+	require.NotNil(t, sample.Wait.WaitType)
+	assert.Equal(t, waitType, *sample.Wait.WaitType)
+	assert.Equal(t, 1200, sample.Wait.WaitTime)
+	assert.Equal(t, int64(1500), sample.TimeElapsedMs)
+	assert.Equal(t, "7781", sample.CommandMetadata.TransactionId)
+	assert.Equal(t, "snap-1", sample.Snapshot.ID)
+	hash, err := base64.StdEncoding.DecodeString(sample.QueryHash)
+	require.NoError(t, err)
+	assert.Len(t, hash, 8)
+
+	// a backend without pg_stat_statements query id or blockers
+	row.queryID, row.blockingPids, row.waitType = 0, nil, nil
+	sample = row.querySample("snap-1", snapTime)
+	assert.Empty(t, sample.QueryHash)
+	assert.False(t, sample.IsBlocked)
+	assert.Empty(t, sample.Block.BlockedBy)
+	assert.Nil(t, sample.Wait.WaitType)
+}
@@ -0,0 +1,15 @@
+package adapters
+
+// queryCounterDeltas returns the difference between two cumulative counter readings of the same query.
+// When the execution count went backwards the source statistics were reset (plan evicted, stats reset,
+// server restarted), so the current reading is already the delta for the interval.
+func queryCounterDeltas(current map[string]int64, last map[string]int64) map[string]int64 {
+	if current["executionCount"] < last["executionCount"] {
+		return current
+	}
+	deltas := make(map[string]int64, len(current))
+	for k, v := range current {
+		deltas[k] = v - last[k]
+	}
+	return deltas
+}
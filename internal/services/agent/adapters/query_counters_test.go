@@ -0,0 +1,46 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryCounterDeltas(t *testing.T) {
+	tests := []struct {
+		name    string
+		current map[string]int64
+		last    map[string]int64
+		want    map[string]int64
+	}{
+		{
+			name:    "counters grew",
+			current: map[string]int64{"executionCount": 15, "totalElapsedTime": 900, "totalRows": 40},
+			last:    map[string]int64{"executionCount": 10, "totalElapsedTime": 600, "totalRows": 25},
+			want:    map[string]int64{"executionCount": 5, "totalElapsedTime": 300, "totalRows": 15},
+		},
+		{
+			name:    "statistics reset",
+			current: map[string]int64{"executionCount": 3, "totalElapsedTime": 120},
+			last:    map[string]int64{"executionCount": 10, "totalElapsedTime": 600},
+			want:    map[string]int64{"executionCount": 3, "totalElapsedTime": 120},
+		},
+		{
+			name:    "first reading",
+			current: map[string]int64{"executionCount": 7, "totalElapsedTime": 350},
+			last:    nil,
+			want:    map[string]int64{"executionCount": 7, "totalElapsedTime": 350},
+		},
+		{
+			name:    "nothing ran",
+			current: map[string]int64{"executionCount": 10, "totalElapsedTime": 600},
+			last:    map[string]int64{"executionCount": 10, "totalElapsedTime": 600},
+			want:    map[string]int64{"executionCount": 0, "totalElapsedTime": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, queryCounterDeltas(tt.current, tt.last))
+		})
+	}
+}
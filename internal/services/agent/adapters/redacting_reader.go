@@ -0,0 +1,136 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var (
+	_ domain.SamplesReader      = (*RedactingReader)(nil)
+	_ domain.QueryMetricsReader = (*RedactingReader)(nil)
+)
+
+// RedactingReader redacts the query text of the samples and metrics read from a target before anything else sees it
+type RedactingReader struct {
+	samples  domain.SamplesReader
+	metrics  domain.QueryMetricsReader
+	redactor *parsers.SQLRedactor
+}
+
+func NewRedactingReader(samples domain.SamplesReader, metrics domain.QueryMetricsReader, redactor *parsers.SQLRedactor) *RedactingReader {
+	return &RedactingReader{samples: samples, metrics: metrics, redactor: redactor}
+}
+
+func (r *RedactingReader) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error) {
+	snapshots, err := r.samples.TakeSnapshot(ctx, server, databases)
+	if err != nil {
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		for _, sample := range snapshot.Samples {
+			r.redactSample(sample)
+		}
+	}
+	return snapshots, nil
+}
+
+// redactSample redacts the batch around the statement apart from it so the statement offsets still locate it, a
+// hashed batch has no statement to locate
+func (r *RedactingReader) redactSample(sample *common_domain.QuerySample) {
+	if sample.IdleSession != nil {
+		sample.IdleSession.InputBuffer = r.redactor.Redact(sample.IdleSession.InputBuffer)
+	}
+	statement := sample.Statement
+	if statement == nil || r.redactor.Mode() == parsers.RedactionHash {
+		sample.Text = r.redactor.Redact(sample.Text)
+		if statement != nil {
+			statement.Text = r.redactor.Redact(statement.Text)
+			statement.StartOffset, statement.EndOffset = 0, 0
+		}
+		return
+	}
+	before, after := statement.Split(sample.Text)
+	sample.Text, sample.Statement = common_domain.JoinStatement(r.redactor.Redact(before),
+		r.redactor.Redact(statement.Text), r.redactor.Redact(after), statement.ObjectName)
+}
+
+func (r *RedactingReader) GetPlanHandles(ctx context.Context, handles []string, server common_domain.ServerMeta) (map[string]*common_domain.ExecutionPlan, error) {
+	plans, err := r.samples.GetPlanHandles(ctx, handles, server)
+	if err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		plan.XmlData = r.redactor.RedactPlanXML(plan.XmlData)
+	}
+	return plans, nil
+}
+
+func (r *RedactingReader) CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error) {
+	metrics, err := r.metrics.CollectMetrics(ctx, server, databases)
+	if err != nil {
+		return nil, err
+	}
+	for _, metric := range metrics {
+		metric.Text = r.redactor.Redact(metric.Text)
+	}
+	return metrics, nil
+}
+
+var _ domain.QueryStoreReader = (*RedactingQueryStoreReader)(nil)
+
+// RedactingQueryStoreReader redacts the query text and the showplan of the plans read from the query store
+type RedactingQueryStoreReader struct {
+	reader   domain.QueryStoreReader
+	redactor *parsers.SQLRedactor
+}
+
+func NewRedactingQueryStoreReader(reader domain.QueryStoreReader, redactor *parsers.SQLRedactor) *RedactingQueryStoreReader {
+	return &RedactingQueryStoreReader{reader: reader, redactor: redactor}
+}
+
+func (r *RedactingQueryStoreReader) ReadQueryStore(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, since map[string]common_domain.QueryStoreWatermark) (*common_domain.QueryStoreStats, error) {
+	stats, err := r.reader.ReadQueryStore(ctx, server, databases, since)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stats.Plans {
+		stats.Plans[i].QueryText = r.redactor.Redact(stats.Plans[i].QueryText)
+		stats.Plans[i].PlanXML = r.redactor.RedactPlanXML(stats.Plans[i].PlanXML)
+	}
+	return stats, nil
+}
+
+var _ domain.DeadlockReader = (*RedactingDeadlockReader)(nil)
+
+// RedactingDeadlockReader redacts the input buffers and the statements of the processes of the deadlocks read from a
+// target, in the parsed processes and in the deadlock graph
+type RedactingDeadlockReader struct {
+	reader   domain.DeadlockReader
+	redactor *parsers.SQLRedactor
+}
+
+func NewRedactingDeadlockReader(reader domain.DeadlockReader, redactor *parsers.SQLRedactor) *RedactingDeadlockReader {
+	return &RedactingDeadlockReader{reader: reader, redactor: redactor}
+}
+
+func (r *RedactingDeadlockReader) ReadDeadlocks(ctx context.Context, server common_domain.ServerMeta, since time.Time) ([]*common_domain.Deadlock, error) {
+	deadlocks, err := r.reader.ReadDeadlocks(ctx, server, since)
+	if err != nil {
+		return nil, err
+	}
+	for _, deadlock := range deadlocks {
+		for i := range deadlock.Processes {
+			process := &deadlock.Processes[i]
+			process.InputBuffer = r.redactor.Redact(process.InputBuffer)
+			for j := range process.Frames {
+				process.Frames[j].Text = r.redactor.Redact(process.Frames[j].Text)
+			}
+		}
+		deadlock.XmlData = r.redactor.RedactDeadlockXML(deadlock.XmlData)
+	}
+	return deadlocks, nil
+}
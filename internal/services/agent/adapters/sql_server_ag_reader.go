@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+const hadrEnabledQuery = `select isnull(cast(serverproperty('IsHadrEnabled') as int), 0)`
+
+// replicaStatesQuery reads the state of the availability databases on every replica known to the instance. The data
+// loss of a replica is how far its last commit is behind the last commit of the primary, it is only known on the
+// primary, the secondaries do not see the state of the other replicas.
+const replicaStatesQuery = `
+select ag.name,
+       ar.replica_server_name,
+       adc.database_name,
+       isnull(ars.role_desc, 'RESOLVING'),
+       ar.availability_mode_desc,
+       ar.failover_mode_desc,
+       isnull(ars.connected_state_desc, ''),
+       drs.is_local,
+       isnull(drs.synchronization_state_desc, ''),
+       isnull(drs.synchronization_health_desc, ''),
+       drs.is_suspended,
+       isnull(drs.suspend_reason_desc, ''),
+       isnull(drs.log_send_queue_size, 0),
+       isnull(drs.log_send_rate, 0),
+       isnull(drs.redo_queue_size, 0),
+       isnull(drs.redo_rate, 0),
+       drs.last_commit_time,
+       cast(isnull(datediff(second, drs.last_commit_time, p.last_commit_time), 0) as bigint)
+from sys.dm_hadr_database_replica_states drs
+         inner join sys.availability_groups ag on ag.group_id = drs.group_id
+         inner join sys.availability_replicas ar on ar.replica_id = drs.replica_id
+         inner join sys.availability_databases_cluster adc
+                    on adc.group_id = drs.group_id and adc.group_database_id = drs.group_database_id
+         left join sys.dm_hadr_availability_replica_states ars on ars.replica_id = drs.replica_id
+         outer apply (select top 1 pdrs.last_commit_time
+                      from sys.dm_hadr_database_replica_states pdrs
+                               inner join sys.dm_hadr_availability_replica_states pars
+                                          on pars.replica_id = pdrs.replica_id
+                      where pdrs.group_database_id = drs.group_database_id
+                        and pars.role = 1) p
+order by ag.name, ar.replica_server_name, adc.database_name
+`
+
+// ReadReplicaStates returns the availability group replica states of the target, empty when HADR is not enabled
+func (S SQLServerDataReader) ReadReplicaStates(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.ReplicaState, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadReplicaStates")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	var hadrEnabled int
+	err := db.QueryRowContext(ctx, hadrEnabledQuery).Scan(&hadrEnabled)
+	if err != nil {
+		return nil, fmt.Errorf("read hadr enabled: %w", err)
+	}
+	if hadrEnabled != 1 {
+		return nil, nil
+	}
+	rows, err := db.QueryContext(ctx, replicaStatesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("read replica states: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.ReplicaState, 0)
+	for rows.Next() {
+		var r common_domain.ReplicaState
+		var lastCommit sql.NullTime
+		err = rows.Scan(&r.AGName, &r.ReplicaServerName, &r.DatabaseName, &r.Role, &r.AvailabilityMode, &r.FailoverMode,
+			&r.ConnectedState, &r.IsLocal, &r.SynchronizationState, &r.SynchronizationHealth, &r.IsSuspended,
+			&r.SuspendReason, &r.LogSendQueueKB, &r.LogSendRateKBps, &r.RedoQueueKB, &r.RedoRateKBps, &lastCommit,
+			&r.EstimatedDataLossS)
+		if err != nil {
+			return nil, fmt.Errorf("read replica states scan: %w", err)
+		}
+		if lastCommit.Valid {
+			r.LastCommitTime = lastCommit.Time
+		}
+		r.EstimatedDataLossS = max(r.EstimatedDataLossS, 0)
+		r.EstimatedRecoveryTimeS = common_domain.EstimateRecoveryTime(r.RedoQueueKB, r.RedoRateKBps)
+		ret = append(ret, r)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read replica states rows: %w", err)
+	}
+	return ret, nil
+}
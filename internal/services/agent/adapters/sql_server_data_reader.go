@@ -25,18 +25,39 @@ type SQLServerDataReader struct {
 	dbByHost                map[string]*sqlx.DB
 	lastQueryCountersByHost map[string]map[string]map[string]int64
 	qCountMu                *sync.Mutex
-	tracer                  trace.Tracer
+	lastWaitStatsByHost     map[string]map[string]common_domain.WaitStat
+	waitStatsMu             *sync.Mutex
+	lastCountersByHost      map[string]counterReading
+	countersMu              *sync.Mutex
+	lastFileIOByHost        map[string]map[fileKey]fileIOReading
+	fileIOMu                *sync.Mutex
+	waitObjectsByHost       map[string]waitObjectCache
+	waitObjectsMu           *sync.Mutex
+	// pageInfoDisabledUntilByHost is until when the page lookups of a host are skipped after one failed, guarded by
+	// waitObjectsMu
+	pageInfoDisabledUntilByHost map[string]time.Time
+	// liveProgressThresholdByHost is how long a request runs before its operator progress is read
+	liveProgressThresholdByHost map[string]time.Duration
+	tracer                      trace.Tracer
 }
 
 var _ domain.SamplesReader = (*SQLServerDataReader)(nil)
 var _ domain.QueryMetricsReader = (*SQLServerDataReader)(nil)
 
-func NewSQLServerDataReader(dbByHost map[string]*sqlx.DB) SQLServerDataReader {
+func NewSQLServerDataReader(dbByHost map[string]*sqlx.DB, liveProgressThresholdByHost map[string]time.Duration) SQLServerDataReader {
 	return SQLServerDataReader{dbByHost: dbByHost, lastQueryCountersByHost: make(map[string]map[string]map[string]int64), qCountMu: &sync.Mutex{},
-		tracer: otel.Tracer("SQLServerDataReader")}
+		lastWaitStatsByHost: make(map[string]map[string]common_domain.WaitStat), waitStatsMu: &sync.Mutex{},
+		lastCountersByHost: make(map[string]counterReading), countersMu: &sync.Mutex{},
+		lastFileIOByHost: make(map[string]map[fileKey]fileIOReading), fileIOMu: &sync.Mutex{},
+		waitObjectsByHost: make(map[string]waitObjectCache), waitObjectsMu: &sync.Mutex{},
+		pageInfoDisabledUntilByHost: make(map[string]time.Time),
+		liveProgressThresholdByHost: liveProgressThresholdByHost,
+		tracer:                      otel.Tracer("SQLServerDataReader")}
 }
 
-func (S SQLServerDataReader) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases []string) ([]*common_domain.DataBaseSnapshot, error) {
+func (S SQLServerDataReader) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error) {
+	ctx, span := S.tracer.Start(ctx, "TakeSnapshot")
+	defer span.End()
 	qDBName := `select database_id, name from sys.databases`
 	db, ok := S.dbByHost[server.Host]
 	if !ok {
@@ -94,7 +115,10 @@ SELECT s.session_id,
        sql_handle,
   plan_handle,
        text, p.request_id, p.transaction_id, p.connection_id, p.percent_complete, p.estimated_completion_time, s.transaction_isolation_level,
-       query_hash, isnull(c.client_net_address, '') as client_net_address
+       query_hash, isnull(c.client_net_address, '') as client_net_address,
+       p.statement_start_offset,
+       p.statement_end_offset,
+       isnull(object_schema_name(objectid, dbid) + '.' + object_name(objectid, dbid), '') as object_name
 FROM sys.dm_exec_sessions s
          inner join sys.dm_exec_requests  p on p.session_id = s.session_id
 left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
@@ -144,6 +168,9 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 		var transactionIsolationLevel int
 		var queryHash []byte
 		var clientNetAddress string
+		var statementStartOffset int64
+		var statementEndOffset int64
+		var objectName string
 		err = rows.Scan(&sessionID,
 			&loginTime,
 			&hostName,
@@ -177,11 +204,14 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 			&transactionIsolationLevel,
 			&queryHash,
 			&clientNetAddress,
+			&statementStartOffset,
+			&statementEndOffset,
+			&objectName,
 		)
 		if err != nil {
 			return nil, err
 		}
-		if len(databases) > 0 && !slices.Contains(databases, dbInfo[strconv.Itoa(databaseId)].DatabaseName) {
+		if !databases.Matches(dbInfo[strconv.Itoa(databaseId)].DatabaseName) {
 			continue
 		}
 		var blockedBy string
@@ -241,6 +271,7 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 				EstimatedCompletionTime: int64(estimatedCompletionTime),
 				PercentComplete:         percentComplete,
 			},
+			Statement: common_domain.NewStatementMetadata(text, statementStartOffset, statementEndOffset, objectName),
 		}
 		if _, ok := querySamplesByDB[strconv.Itoa(databaseId)]; !ok {
 			querySamplesByDB[strconv.Itoa(databaseId)] = make([]*common_domain.QuerySample, 0)
@@ -265,6 +296,7 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 		}
 		querySamples = append(querySamples, qs2...)
 	}
+	S.resolveWaitResources(ctx, db, server.Host, dbInfo, querySamples)
 	missingBlockingSessionIds := make([]int, 0, len(blockingMap))
 	for i := range blockingMap {
 		missingBlockingSessionIds = append(missingBlockingSessionIds, i)
@@ -285,8 +317,31 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 		}
 	}
 	querySamples = append(querySamples, sleepingSamples...)
+	err = S.readChainLocks(ctx, db, server.Host, dbInfo, querySamples)
+	if err != nil {
+		// the locks are best effort, keep the samples without them
+		span.RecordError(fmt.Errorf("readChainLocks: %w", err))
+		for _, qs := range querySamples {
+			qs.Locks = nil
+		}
+	}
+	S.readLiveProgress(ctx, db, server.Host, querySamples)
+	transactions, err := S.readOpenTransactions(ctx, db, databases)
+	if err != nil {
+		span.RecordError(fmt.Errorf("readOpenTransactions: %w", err))
+		fmt.Printf("reading open transactions of %s: %s\n", server.Host, err.Error())
+		transactions = nil
+	}
+	versionStoreKB, err := S.readVersionStoreKB(ctx, db)
+	if err != nil {
+		span.RecordError(fmt.Errorf("readVersionStoreKB: %w", err))
+		fmt.Printf("reading version store size of %s: %s\n", server.Host, err.Error())
+		versionStoreKB = 0
+	}
 	snapshots = append(snapshots, &common_domain.DataBaseSnapshot{
-		Samples: querySamples,
+		Samples:        querySamples,
+		Transactions:   transactions,
+		VersionStoreKB: versionStoreKB,
 		SnapInfo: common_domain.SnapInfo{
 			ID:        snapID,
 			Timestamp: snapTime,
@@ -299,7 +354,7 @@ left JOIN sys.dm_exec_connections AS c on s.session_id = c.session_id
 	return snapshots, nil
 }
 
-func (S SQLServerDataReader) CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases []string) ([]*common_domain.QueryMetric, error) {
+func (S SQLServerDataReader) CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error) {
 	ctx, span := S.tracer.Start(ctx, "CollectMetrics")
 	defer span.End()
 	db, ok := S.dbByHost[server.Host]
@@ -470,7 +525,7 @@ from qstats_aggr_split qas
 			return nil, fmt.Errorf("collecting metrics - scan: %w", err)
 		}
 
-		if len(databases) > 0 && !slices.Contains(databases, dbName) {
+		if !databases.Matches(dbName) {
 			continue
 		}
 		counters := map[string]int64{
@@ -695,7 +750,7 @@ SELECT s.session_id,
        s.status,
        c.most_recent_sql_handle AS sql_handle,
        0x0 AS plan_handle,
-       t.text,
+       isnull(t.text, isnull(ib.event_info, '')) AS text,
        0 AS request_id,
        0 AS transaction_id,
        c.connection_id,
@@ -703,17 +758,24 @@ SELECT s.session_id,
        0 AS estimated_completion_time,
        s.transaction_isolation_level,
        0x0 as query_hash,
-       isnull(c.client_net_address, '') as client_net_address 
+       isnull(c.client_net_address, '') as client_net_address,
+       isnull(ib.event_type, '') as input_buffer_event_type,
+       isnull(ib.event_info, '') as input_buffer,
+       s.open_transaction_count,
+       dateadd(minute, datediff(minute, getdate(), getutcdate()), tx.transaction_begin_time) AS transaction_begin_time
 
 FROM sys.dm_exec_sessions s
 INNER JOIN sys.dm_exec_connections c
     ON s.session_id = c.session_id
-CROSS APPLY sys.dm_exec_sql_text(c.most_recent_sql_handle) t
+OUTER APPLY sys.dm_exec_sql_text(c.most_recent_sql_handle) t
+OUTER APPLY sys.dm_exec_input_buffer(s.session_id, NULL) ib
+OUTER APPLY (SELECT min(at.transaction_begin_time) AS transaction_begin_time
+             FROM sys.dm_tran_session_transactions st
+                      INNER JOIN sys.dm_tran_active_transactions at ON at.transaction_id = st.transaction_id
+             WHERE st.session_id = s.session_id) tx
 LEFT JOIN sys.dm_exec_query_stats qs
     ON c.most_recent_sql_handle = qs.sql_handle
 WHERE s.status = 'sleeping'
-  AND c.most_recent_sql_handle IS NOT NULL
-  AND t.text IS NOT NULL
     AND s.session_id IN (%s)`, strings.Join(placeholders, ","))
 
 	rows, err := db.QueryContext(ctx, query, args...)
@@ -758,6 +820,10 @@ WHERE s.status = 'sleeping'
 		var transactionIsolationLevel int
 		var queryHash []byte
 		var clientNetAddress string
+		var inputBufferEventType string
+		var inputBuffer string
+		var openTransactionCount int64
+		var transactionBeginTime sql.NullTime
 		err = rows.Scan(&sessionID,
 			&loginTime,
 			&hostName,
@@ -791,6 +857,10 @@ WHERE s.status = 'sleeping'
 			&transactionIsolationLevel,
 			&queryHash,
 			&clientNetAddress,
+			&inputBufferEventType,
+			&inputBuffer,
+			&openTransactionCount,
+			&transactionBeginTime,
 		)
 		if err != nil {
 			return nil, err
@@ -843,6 +913,12 @@ WHERE s.status = 'sleeping'
 				EstimatedCompletionTime: int64(estimatedCompletionTime),
 				PercentComplete:         percentComplete,
 			},
+			IdleSession: &common_domain.IdleSessionMetadata{
+				InputBuffer:          inputBuffer,
+				InputBufferEventType: inputBufferEventType,
+				OpenTransactionCount: openTransactionCount,
+				TransactionBeginTime: transactionBeginTime.Time,
+			},
 		}
 		result = append(result, &qs)
 	}
@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/adapters/parsers"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ domain.DeadlockReader = (*SQLServerDataReader)(nil)
+
+// deadlocks from the system_health event files, they keep more history than the ring buffer
+const deadlocksFromEventFileQuery = `
+select event_time, report
+from (select x.event_data.value('(event/@timestamp)[1]', 'datetime2')                                            as event_time,
+             cast(x.event_data.query('event/data[@name="xml_report"]/value/deadlock') as nvarchar(max)) as report
+      from (select cast(event_data as xml) as event_data
+            from sys.fn_xe_file_target_read_file('system_health*.xel', null, null, null)
+            where object_name = 'xml_deadlock_report') x) d
+where d.event_time > @since
+order by d.event_time
+`
+
+// deadlocks from the system_health ring buffer, used where the event files cannot be read (e.g. azure sql)
+const deadlocksFromRingBufferQuery = `
+select xed.value('@timestamp', 'datetime2')                                                        as event_time,
+       cast(xed.query('data[@name="xml_report"]/value/deadlock') as nvarchar(max)) as report
+from (select cast(st.target_data as xml) as target_data
+      from sys.dm_xe_session_targets st
+               inner join sys.dm_xe_sessions s on s.address = st.event_session_address
+      where s.name = 'system_health'
+        and st.target_name = 'ring_buffer') t
+         cross apply t.target_data.nodes('RingBufferTarget/event[@name="xml_deadlock_report"]') x(xed)
+where xed.value('@timestamp', 'datetime2') > @since
+order by event_time
+`
+
+func (S SQLServerDataReader) ReadDeadlocks(ctx context.Context, server common_domain.ServerMeta, since time.Time) ([]*common_domain.Deadlock, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadDeadlocks")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("dbByHost[%s] not found", server.Host)
+	}
+	deadlocks, err := S.readDeadlocks(ctx, db, deadlocksFromEventFileQuery, server, since)
+	if err != nil {
+		var err2 error
+		deadlocks, err2 = S.readDeadlocks(ctx, db, deadlocksFromRingBufferQuery, server, since)
+		if err2 != nil {
+			return nil, fmt.Errorf("read deadlocks from event file: %w, from ring buffer: %w", err, err2)
+		}
+	}
+	return deadlocks, nil
+}
+
+func (S SQLServerDataReader) readDeadlocks(ctx context.Context, db *sqlx.DB, query string, server common_domain.ServerMeta, since time.Time) ([]*common_domain.Deadlock, error) {
+	rows, err := db.QueryContext(ctx, query, sql.Named("since", since.UTC()))
+	if err != nil {
+		return nil, fmt.Errorf("query deadlocks: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.Deadlock, 0)
+	for rows.Next() {
+		var eventTime time.Time
+		var report string
+		err = rows.Scan(&eventTime, &report)
+		if err != nil {
+			return nil, fmt.Errorf("query deadlocks scan: %w", err)
+		}
+		deadlock, err := parsers.ParseDeadlockReport(report)
+		if err != nil {
+			return nil, fmt.Errorf("parse deadlock at %s: %w", eventTime.Format(time.RFC3339), err)
+		}
+		// the same event is read again on every run while it stays in the session, the id has to be stable
+		hash := sha256.Sum256([]byte(server.Host + report))
+		deadlock.ID = hex.EncodeToString(hash[:])
+		deadlock.Server = server
+		// event timestamps are utc, the driver reads datetime2 without an offset as utc
+		deadlock.Timestamp = eventTime
+		ret = append(ret, deadlock)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("query deadlocks rows: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+const fileIOQuery = `
+select coalesce(db_name(vfs.database_id), cast(vfs.database_id as nvarchar(20))),
+       vfs.file_id,
+       mf.name,
+       mf.physical_name,
+       mf.type_desc,
+       vfs.sample_ms,
+       vfs.num_of_reads,
+       vfs.num_of_bytes_read,
+       vfs.io_stall_read_ms,
+       vfs.num_of_writes,
+       vfs.num_of_bytes_written,
+       vfs.io_stall_write_ms
+from sys.dm_io_virtual_file_stats(null, null) vfs
+         inner join sys.master_files mf on mf.database_id = vfs.database_id and mf.file_id = vfs.file_id
+`
+
+type fileKey struct {
+	database string
+	fileID   int64
+}
+
+// fileIOReading holds the cumulative values of a file, sampleMs is the dm_io_virtual_file_stats clock
+type fileIOReading struct {
+	stat     common_domain.FileIOStat
+	sampleMs int64
+}
+
+// ReadFileIOStats returns the file I/O since the previous call, the first call only takes the baseline
+func (S SQLServerDataReader) ReadFileIOStats(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.FileIOStat, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadFileIOStats")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	rows, err := db.QueryContext(ctx, fileIOQuery)
+	if err != nil {
+		return nil, fmt.Errorf("read file io stats: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	current := make(map[fileKey]fileIOReading)
+	for rows.Next() {
+		var r fileIOReading
+		err = rows.Scan(&r.stat.DatabaseName, &r.stat.FileID, &r.stat.LogicalName, &r.stat.PhysicalName, &r.stat.FileType,
+			&r.sampleMs, &r.stat.Reads, &r.stat.BytesRead, &r.stat.ReadStallMs, &r.stat.Writes, &r.stat.BytesWritten,
+			&r.stat.WriteStallMs)
+		if err != nil {
+			return nil, fmt.Errorf("read file io stats scan: %w", err)
+		}
+		current[fileKey{database: r.stat.DatabaseName, fileID: r.stat.FileID}] = r
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read file io stats rows: %w", err)
+	}
+	S.fileIOMu.Lock()
+	last, found := S.lastFileIOByHost[server.Host]
+	S.lastFileIOByHost[server.Host] = current
+	S.fileIOMu.Unlock()
+	if !found {
+		return nil, nil
+	}
+	return fileIODeltas(current, last), nil
+}
+
+// fileIODeltas returns the files with I/O between two readings. Counters going backwards mean the database was
+// brought online again, the current reading is then the delta. Files first seen or with a clock going backwards (a
+// restart) have no interval to measure and are left out until the next reading.
+func fileIODeltas(current map[fileKey]fileIOReading, last map[fileKey]fileIOReading) []common_domain.FileIOStat {
+	ret := make([]common_domain.FileIOStat, 0)
+	for key, r := range current {
+		prev, ok := last[key]
+		if !ok || r.sampleMs <= prev.sampleMs {
+			continue
+		}
+		delta := r.stat
+		delta.IntervalMs = r.sampleMs - prev.sampleMs
+		if r.stat.Reads >= prev.stat.Reads && r.stat.Writes >= prev.stat.Writes {
+			delta.Reads -= prev.stat.Reads
+			delta.BytesRead -= prev.stat.BytesRead
+			delta.ReadStallMs -= prev.stat.ReadStallMs
+			delta.Writes -= prev.stat.Writes
+			delta.BytesWritten -= prev.stat.BytesWritten
+			delta.WriteStallMs -= prev.stat.WriteStallMs
+		}
+		if delta.Reads == 0 && delta.Writes == 0 {
+			continue
+		}
+		ret = append(ret, delta)
+	}
+	return ret
+}
@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileIODeltas(t *testing.T) {
+	data := fileKey{database: "sales", fileID: 1}
+	log := fileKey{database: "sales", fileID: 2}
+	idle := fileKey{database: "archive", fileID: 1}
+	restored := fileKey{database: "reports", fileID: 1}
+	added := fileKey{database: "reports", fileID: 3}
+	stat := func(key fileKey, reads, bytesRead, readStall, writes, bytesWritten, writeStall int64) common_domain.FileIOStat {
+		return common_domain.FileIOStat{DatabaseName: key.database, FileID: key.fileID, Reads: reads, BytesRead: bytesRead,
+			ReadStallMs: readStall, Writes: writes, BytesWritten: bytesWritten, WriteStallMs: writeStall}
+	}
+	last := map[fileKey]fileIOReading{
+		data:     {stat: stat(data, 100, 819200, 500, 10, 81920, 20), sampleMs: 1000},
+		log:      {stat: stat(log, 0, 0, 0, 50, 102400, 25), sampleMs: 1000},
+		idle:     {stat: stat(idle, 7, 57344, 3, 0, 0, 0), sampleMs: 1000},
+		restored: {stat: stat(restored, 900, 7372800, 4000, 80, 655360, 100), sampleMs: 1000},
+	}
+	current := map[fileKey]fileIOReading{
+		data:     {stat: stat(data, 140, 1146880, 900, 12, 98304, 22), sampleMs: 16000},
+		log:      {stat: stat(log, 0, 0, 0, 90, 184320, 45), sampleMs: 16000},
+		idle:     {stat: stat(idle, 7, 57344, 3, 0, 0, 0), sampleMs: 16000},
+		restored: {stat: stat(restored, 5, 40960, 10, 0, 0, 0), sampleMs: 16000},
+		added:    {stat: stat(added, 1, 8192, 1, 0, 0, 0), sampleMs: 16000},
+	}
+
+	deltas := make(map[fileKey]common_domain.FileIOStat)
+	for _, f := range fileIODeltas(current, last) {
+		deltas[fileKey{database: f.DatabaseName, fileID: f.FileID}] = f
+	}
+	assert.Len(t, deltas, 3)
+	want := stat(data, 40, 327680, 400, 2, 16384, 2)
+	want.IntervalMs = 15000
+	assert.Equal(t, want, deltas[data])
+	assert.Equal(t, 10.0, deltas[data].ReadLatencyMs())
+	assert.Equal(t, 0.5, deltas[log].WriteLatencyMs())
+	want = current[restored].stat
+	want.IntervalMs = 15000
+	assert.Equal(t, want, deltas[restored])
+}
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var _ domain.FileSizeReader = (*SQLServerDataReader)(nil)
+
+// fileSizesQuery reads the files of one database, %[1]s is the quoted database name. FILEPROPERTY and
+// dm_db_log_space_usage report on the current database, the statement runs in the database through its
+// sp_executesql. A max_size of -1 is unlimited and 0 forbids growth, it is then reported as the current size.
+const fileSizesQuery = `
+exec %[1]s.sys.sp_executesql N'
+select df.file_id,
+       df.name,
+       df.physical_name,
+       df.type_desc,
+       cast(df.size as bigint) * 8,
+       case
+           when df.type = 1 then (select cast(used_log_space_in_bytes / 1024 as bigint) from sys.dm_db_log_space_usage)
+           else cast(isnull(fileproperty(df.name, ''SpaceUsed''), 0) as bigint) * 8 end,
+       case df.max_size when -1 then 0 when 0 then cast(df.size as bigint) * 8 else cast(df.max_size as bigint) * 8 end,
+       case when df.is_percent_growth = 1 then cast(df.growth as bigint) else cast(df.growth as bigint) * 8 end,
+       df.is_percent_growth,
+       isnull(vs.available_bytes / 1024, 0)
+from sys.database_files df
+         outer apply sys.dm_os_volume_stats(db_id(), df.file_id) vs
+where df.type in (0, 1)'
+`
+
+const defaultTracePathQuery = `select isnull((select path from sys.traces where is_default = 1), '')`
+
+// autogrowthEventsQuery reads the data (92) and log (93) file growths of the default trace from its first rollover
+// file, the trace logs server local time and duration in microseconds, IntegerData is the growth in 8KB pages
+const autogrowthEventsQuery = `
+select isnull(t.DatabaseName, ''),
+       isnull(t.FileName, ''),
+       case t.EventClass when 92 then 'ROWS' else 'LOG' end,
+       dateadd(minute, datediff(minute, getdate(), getutcdate()), t.StartTime),
+       cast(isnull(t.Duration, 0) / 1000 as bigint),
+       cast(isnull(t.IntegerData, 0) as bigint) * 8
+from sys.fn_trace_gettable(@path, default) t
+where t.EventClass in (92, 93)
+order by t.StartTime
+`
+
+// ReadFileSizes returns the size of the files of the user databases and the autogrowth events started after since,
+// the events are left out when the default trace is off. A database that cannot be read is skipped and reported in
+// Failed.
+func (S SQLServerDataReader) ReadFileSizes(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, since time.Time) (*common_domain.FileSizeStats, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadFileSizes")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	var names []string
+	err := db.SelectContext(ctx, &names, userDatabasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list databases: %w", err)
+	}
+	stats := &common_domain.FileSizeStats{Server: server, Timestamp: time.Now(), Failed: make(common_domain.DatabaseErrors)}
+	for _, name := range names {
+		if !databases.Matches(name) {
+			continue
+		}
+		files, err2 := S.readFileSizes(ctx, db.DB, name)
+		if err2 != nil {
+			stats.Failed[name] = fmt.Errorf("read file sizes: %w", err2)
+			continue
+		}
+		stats.Files = append(stats.Files, files...)
+	}
+	events, err := S.readAutogrowthEvents(ctx, db.DB, since)
+	if err != nil {
+		return nil, fmt.Errorf("read autogrowth events: %w", err)
+	}
+	stats.LastGrowthEvent = since
+	for _, e := range events {
+		stats.LastGrowthEvent = maxTime(stats.LastGrowthEvent, e.StartTime)
+		if databases.Matches(e.DatabaseName) {
+			stats.GrowthEvents = append(stats.GrowthEvents, e)
+		}
+	}
+	return stats, nil
+}
+
+func (S SQLServerDataReader) readFileSizes(ctx context.Context, db *sql.DB, database string) ([]common_domain.DatabaseFileSize, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(fileSizesQuery, quoteName(database)))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.DatabaseFileSize, 0)
+	for rows.Next() {
+		f := common_domain.DatabaseFileSize{DatabaseName: database}
+		err = rows.Scan(&f.FileID, &f.LogicalName, &f.PhysicalName, &f.FileType, &f.SizeKB, &f.UsedKB, &f.MaxSizeKB,
+			&f.Growth, &f.IsPercentGrowth, &f.VolumeFreeKB)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		ret = append(ret, f)
+	}
+	return ret, rows.Err()
+}
+
+// readAutogrowthEvents returns the growths started after since. The default trace files are log_<n>.trc, reading
+// from log.trc includes the rolled over files still on disk.
+func (S SQLServerDataReader) readAutogrowthEvents(ctx context.Context, db *sql.DB, since time.Time) ([]common_domain.AutogrowthEvent, error) {
+	var path string
+	err := db.QueryRowContext(ctx, defaultTracePathQuery).Scan(&path)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, nil
+	}
+	if sep := strings.LastIndexAny(path, `\/`); sep >= 0 {
+		path = path[:sep+1] + "log.trc"
+	}
+	rows, err := db.QueryContext(ctx, autogrowthEventsQuery, sql.Named("path", path))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.AutogrowthEvent, 0)
+	for rows.Next() {
+		var e common_domain.AutogrowthEvent
+		err = rows.Scan(&e.DatabaseName, &e.LogicalName, &e.FileType, &e.StartTime, &e.DurationMs, &e.GrowthKB)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		if !e.StartTime.After(since) {
+			continue
+		}
+		ret = append(ret, e)
+	}
+	return ret, rows.Err()
+}
+
+func maxTime(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var _ domain.IndexStatsReader = (*SQLServerDataReader)(nil)
+
+// userDatabasesQuery lists the online user databases the login can read
+const userDatabasesQuery = `
+select name
+from sys.databases
+where database_id > 4
+  and state = 0
+  and has_dbaccess(name) = 1
+`
+
+// indexUsageQuery reads the indexes of the user tables of one database, %[1]s is the quoted database name
+const indexUsageQuery = `
+select s.name,
+       o.name,
+       isnull(i.name, ''),
+       i.index_id,
+       i.type_desc,
+       i.is_unique,
+       i.is_primary_key,
+       isnull(us.user_seeks, 0),
+       isnull(us.user_scans, 0),
+       isnull(us.user_lookups, 0),
+       isnull(us.user_updates, 0),
+       (select max(v) from (values (us.last_user_seek), (us.last_user_scan), (us.last_user_lookup)) as reads(v)),
+       us.last_user_update,
+       isnull(ps.size_kb, 0),
+       isnull(ps.row_count, 0)
+from %[1]s.sys.indexes i
+         inner join %[1]s.sys.objects o on o.object_id = i.object_id
+         inner join %[1]s.sys.schemas s on s.schema_id = o.schema_id
+         left join sys.dm_db_index_usage_stats us
+                   on us.database_id = db_id(@database) and us.object_id = i.object_id and us.index_id = i.index_id
+         outer apply (select sum(p.used_page_count) * 8 as size_kb, sum(p.row_count) as row_count
+                      from %[1]s.sys.dm_db_partition_stats p
+                      where p.object_id = i.object_id
+                        and p.index_id = i.index_id) ps
+where o.type = 'U'
+  and o.is_ms_shipped = 0
+  and i.type > 0
+  and i.is_hypothetical = 0
+`
+
+const missingIndexesQuery = `
+select db_name(d.database_id),
+       d.statement,
+       isnull(d.equality_columns, ''),
+       isnull(d.inequality_columns, ''),
+       isnull(d.included_columns, ''),
+       gs.unique_compiles,
+       gs.user_seeks,
+       gs.user_scans,
+       gs.avg_total_user_cost,
+       gs.avg_user_impact,
+       gs.last_user_seek
+from sys.dm_db_missing_index_details d
+         inner join sys.dm_db_missing_index_groups g on g.index_handle = d.index_handle
+         inner join sys.dm_db_missing_index_group_stats gs on gs.group_handle = g.index_group_handle
+where d.database_id > 4
+`
+
+// ReadIndexStats returns the index usage of the user databases and the missing index suggestions, a database that
+// cannot be read is skipped and reported in Failed
+func (S SQLServerDataReader) ReadIndexStats(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (*common_domain.IndexStats, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadIndexStats")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	var names []string
+	err := db.SelectContext(ctx, &names, userDatabasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list databases: %w", err)
+	}
+	stats := &common_domain.IndexStats{Server: server, Timestamp: time.Now(), Failed: make(common_domain.DatabaseErrors)}
+	for _, name := range names {
+		if !databases.Matches(name) {
+			continue
+		}
+		indexes, err2 := S.readIndexUsage(ctx, db.DB, name)
+		if err2 != nil {
+			stats.Failed[name] = fmt.Errorf("read index usage: %w", err2)
+			continue
+		}
+		stats.Indexes = append(stats.Indexes, indexes...)
+	}
+	missing, err := S.readMissingIndexes(ctx, db.DB, databases)
+	if err != nil {
+		return nil, fmt.Errorf("read missing indexes: %w", err)
+	}
+	stats.MissingIndexes = missing
+	return stats, nil
+}
+
+func (S SQLServerDataReader) readIndexUsage(ctx context.Context, db *sql.DB, database string) ([]common_domain.IndexUsage, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(indexUsageQuery, quoteName(database)), sql.Named("database", database))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.IndexUsage, 0)
+	for rows.Next() {
+		index := common_domain.IndexUsage{DatabaseName: database}
+		var lastRead, lastUpdate sql.NullTime
+		err = rows.Scan(&index.SchemaName, &index.TableName, &index.IndexName, &index.IndexID, &index.IndexType,
+			&index.IsUnique, &index.IsPrimaryKey, &index.UserSeeks, &index.UserScans, &index.UserLookups,
+			&index.UserUpdates, &lastRead, &lastUpdate, &index.SizeKB, &index.RowCount)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		index.LastUserRead = lastRead.Time
+		index.LastUserUpdate = lastUpdate.Time
+		ret = append(ret, index)
+	}
+	return ret, rows.Err()
+}
+
+func (S SQLServerDataReader) readMissingIndexes(ctx context.Context, db *sql.DB, databases common_domain.DatabaseFilter) ([]common_domain.MissingIndex, error) {
+	rows, err := db.QueryContext(ctx, missingIndexesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.MissingIndex, 0)
+	for rows.Next() {
+		var m common_domain.MissingIndex
+		var databaseName sql.NullString
+		var lastSeek sql.NullTime
+		err = rows.Scan(&databaseName, &m.TableName, &m.EqualityColumns, &m.InequalityColumns, &m.IncludedColumns,
+			&m.UniqueCompiles, &m.UserSeeks, &m.UserScans, &m.AvgTotalUserCost, &m.AvgUserImpact, &lastSeek)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		// the suggestions of a dropped database stay until the server restarts
+		if !databaseName.Valid || !databases.Matches(databaseName.String) {
+			continue
+		}
+		m.DatabaseName = databaseName.String
+		m.LastUserSeek = lastSeek.Time
+		ret = append(ret, m)
+	}
+	return ret, rows.Err()
+}
+
+// quoteName quotes an identifier the way QUOTENAME does
+func quoteName(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
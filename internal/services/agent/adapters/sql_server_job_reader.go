@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var _ domain.JobReader = (*SQLServerDataReader)(nil)
+
+// jobHistoryInitialLookbackDays is how far back the first read of the job history goes, enough runs for the median
+// duration of the daily jobs
+const jobHistoryInitialLookbackDays = 30
+
+// jobHistoryQuery reads the job outcomes (step 0) logged after @last_instance_id, the first read is limited to the
+// runs started in the last @lookback_days. msdb dates are server local time, they are moved to UTC.
+const jobHistoryQuery = `
+select h.instance_id,
+       convert(varchar(36), j.job_id),
+       j.name,
+       h.run_status,
+       dateadd(minute, datediff(minute, getdate(), getutcdate()), msdb.dbo.agent_datetime(h.run_date, h.run_time)),
+       cast((h.run_duration / 10000) * 3600 + (h.run_duration / 100 % 100) * 60 + h.run_duration % 100 as bigint) * 1000,
+       isnull(h.message, '')
+from msdb.dbo.sysjobhistory h
+         inner join msdb.dbo.sysjobs j on j.job_id = h.job_id
+where h.step_id = 0
+  and h.instance_id > @last_instance_id
+  and (@last_instance_id > 0 or
+       h.run_date >= convert(int, convert(varchar(8), dateadd(day, -@lookback_days, getdate()), 112)))
+order by h.instance_id
+`
+
+// runningJobsQuery reads the jobs started and not stopped in the current session of the SQL Agent, the step after
+// the last executed one is reported as the current step. The start is truncated to the second like the job history.
+const runningJobsQuery = `
+select convert(varchar(36), j.job_id),
+       j.name,
+       dateadd(minute, datediff(minute, getdate(), getutcdate()),
+               dateadd(millisecond, -datepart(millisecond, a.start_execution_date), a.start_execution_date)),
+       cast(datediff(second, a.start_execution_date, getdate()) as bigint) * 1000,
+       isnull(js.step_name, '')
+from msdb.dbo.sysjobactivity a
+         inner join msdb.dbo.sysjobs j on j.job_id = a.job_id
+         left join msdb.dbo.sysjobsteps js
+                   on js.job_id = a.job_id and js.step_id = isnull(a.last_executed_step_id, 0) + 1
+where a.session_id = (select max(session_id) from msdb.dbo.syssessions)
+  and a.start_execution_date is not null
+  and a.stop_execution_date is null
+`
+
+// ReadJobs returns the job runs logged after lastInstanceID and the jobs running now
+func (S SQLServerDataReader) ReadJobs(ctx context.Context, server common_domain.ServerMeta, lastInstanceID int64) (*common_domain.JobStats, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadJobs")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	stats := &common_domain.JobStats{Server: server, Timestamp: time.Now()}
+	runs, nextInstanceID, err := S.readJobHistory(ctx, db.DB, lastInstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("read job history: %w", err)
+	}
+	running, err := S.readRunningJobs(ctx, db.DB)
+	if err != nil {
+		return nil, fmt.Errorf("read running jobs: %w", err)
+	}
+	stats.Runs = append(runs, running...)
+	stats.LastInstanceID = nextInstanceID
+	return stats, nil
+}
+
+func (S SQLServerDataReader) readJobHistory(ctx context.Context, db *sql.DB, lastInstanceID int64) ([]common_domain.JobRun, int64, error) {
+	rows, err := db.QueryContext(ctx, jobHistoryQuery, sql.Named("last_instance_id", lastInstanceID),
+		sql.Named("lookback_days", jobHistoryInitialLookbackDays))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.JobRun, 0)
+	next := lastInstanceID
+	for rows.Next() {
+		var run common_domain.JobRun
+		var instanceID int64
+		var status int
+		err = rows.Scan(&instanceID, &run.JobID, &run.JobName, &status, &run.StartTime, &run.DurationMs, &run.Message)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scan: %w", err)
+		}
+		run.Status = jobRunStatus(status)
+		ret = append(ret, run)
+		next = max(next, instanceID)
+	}
+	return ret, next, rows.Err()
+}
+
+func (S SQLServerDataReader) readRunningJobs(ctx context.Context, db *sql.DB) ([]common_domain.JobRun, error) {
+	rows, err := db.QueryContext(ctx, runningJobsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.JobRun, 0)
+	for rows.Next() {
+		run := common_domain.JobRun{Status: common_domain.JobRunRunning}
+		err = rows.Scan(&run.JobID, &run.JobName, &run.StartTime, &run.DurationMs, &run.CurrentStep)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		ret = append(ret, run)
+	}
+	return ret, rows.Err()
+}
+
+// jobRunStatus maps the run_status of sysjobhistory
+func jobRunStatus(status int) string {
+	switch status {
+	case 0:
+		return common_domain.JobRunFailed
+	case 1:
+		return common_domain.JobRunSucceeded
+	case 2:
+		return common_domain.JobRunRetry
+	case 3:
+		return common_domain.JobRunCanceled
+	default:
+		return common_domain.JobRunRunning
+	}
+}
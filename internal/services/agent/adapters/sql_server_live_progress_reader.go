@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+)
+
+// queryProfilesQuery reads the operators of the requests of the sessions %s, summed over the threads of parallel
+// plans. An operator is active while one of its threads opened it and did not close it.
+const queryProfilesQuery = `
+select session_id,
+       request_id,
+       node_id,
+       physical_operator_name,
+       sum(row_count),
+       max(estimate_row_count),
+       max(elapsed_time_ms),
+       sum(cpu_time_ms),
+       sum(logical_read_count),
+       cast(max(case when open_time > 0 and close_time = 0 then 1 else 0 end) as bit)
+from sys.dm_exec_query_profiles
+where session_id in (%s)
+group by session_id, request_id, node_id, physical_operator_name
+order by session_id, request_id, node_id
+`
+
+// readLiveProgress attaches the operator progress to the requests running longer than the threshold of the host.
+// sys.dm_exec_query_profiles only has rows with lightweight profiling on (default from SQL Server 2019) and does not
+// exist before 2014, the progress is left out when it cannot be read.
+func (S SQLServerDataReader) readLiveProgress(ctx context.Context, db *sqlx.DB, host string, samples []*common_domain.QuerySample) {
+	threshold, ok := S.liveProgressThresholdByHost[host]
+	if !ok || threshold <= 0 {
+		return
+	}
+	type requestKey struct {
+		sessionID string
+		requestID string
+	}
+	byRequest := make(map[requestKey]*common_domain.QuerySample)
+	sessionIDs := make(map[string]struct{})
+	for _, sample := range samples {
+		if sample.IdleSession != nil || time.Duration(sample.TimeElapsedMs)*time.Millisecond < threshold {
+			continue
+		}
+		byRequest[requestKey{sessionID: sample.Session.SessionID, requestID: sample.CommandMetadata.RequestId}] = sample
+		sessionIDs[sample.Session.SessionID] = struct{}{}
+	}
+	if len(byRequest) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(sessionIDs))
+	args := make([]interface{}, 0, len(sessionIDs))
+	for sessionID := range sessionIDs {
+		id, err := strconv.Atoi(sessionID)
+		if err != nil {
+			continue
+		}
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(queryProfilesQuery, strings.Join(placeholders, ",")), args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sessionID, requestID int
+		var p common_domain.OperatorProgress
+		err = rows.Scan(&sessionID, &requestID, &p.NodeID, &p.PhysicalOperator, &p.RowCount, &p.EstimatedRowCount,
+			&p.ElapsedTimeMs, &p.CPUTimeMs, &p.LogicalReads, &p.Active)
+		if err != nil {
+			return
+		}
+		sample, ok := byRequest[requestKey{sessionID: strconv.Itoa(sessionID), requestID: strconv.Itoa(requestID)}]
+		if !ok {
+			continue
+		}
+		sample.LiveProgress = append(sample.LiveProgress, p)
+	}
+}
@@ -0,0 +1,114 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+)
+
+// chainLocksQuery reads the locks of the sessions of a blocking chain, %s are the session id placeholders. Granted
+// locks are kept when they are table level or on a resource another session of the chain waits for, the row locks
+// of a large transaction would otherwise flood the snapshot. The shared database lock of every session is left out.
+const chainLocksQuery = `
+with chain_locks as (select request_session_id,
+                            resource_type,
+                            resource_database_id,
+                            rtrim(resource_description) as resource_description,
+                            resource_associated_entity_id,
+                            request_mode,
+                            request_status
+                     from sys.dm_tran_locks
+                     where request_session_id in (%s)
+                       and not (resource_type = 'DATABASE' and request_mode = 'S' and request_status = 'GRANT'))
+select l.request_session_id,
+       l.resource_type,
+       l.resource_database_id,
+       l.resource_description,
+       l.resource_associated_entity_id,
+       l.request_mode,
+       l.request_status
+from chain_locks l
+where l.request_status <> 'GRANT'
+   or l.resource_type = 'OBJECT'
+   or exists (select 1
+              from chain_locks w
+              where w.request_status <> 'GRANT'
+                and w.resource_type = l.resource_type
+                and w.resource_database_id = l.resource_database_id
+                and w.resource_associated_entity_id = l.resource_associated_entity_id
+                and w.resource_description = l.resource_description)
+order by l.request_session_id, l.request_status desc, l.resource_type
+`
+
+// readChainLocks attaches the locks of the sessions blocked or blocking to their samples
+func (S SQLServerDataReader) readChainLocks(ctx context.Context, db *sqlx.DB, host string, dbInfo map[string]common_domain.DataBaseMetadata, samples []*common_domain.QuerySample) error {
+	bySession := make(map[int][]*common_domain.QuerySample)
+	for _, sample := range samples {
+		if !sample.IsBlocked && !sample.IsBlocker {
+			continue
+		}
+		sessionID, err := strconv.Atoi(sample.Session.SessionID)
+		if err != nil {
+			continue
+		}
+		bySession[sessionID] = append(bySession[sessionID], sample)
+	}
+	if len(bySession) == 0 {
+		return nil
+	}
+	placeholders := make([]string, 0, len(bySession))
+	args := make([]interface{}, 0, len(bySession))
+	for sessionID := range bySession {
+		placeholders = append(placeholders, "?")
+		args = append(args, sessionID)
+	}
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(chainLocksQuery, strings.Join(placeholders, ",")), args...)
+	if err != nil {
+		return fmt.Errorf("query locks: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sessionID int
+		var lock common_domain.LockDetail
+		err = rows.Scan(&sessionID, &lock.ResourceType, &lock.DatabaseID, &lock.ResourceDescription,
+			&lock.AssociatedEntityID, &lock.Mode, &lock.Status)
+		if err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+		lock.DatabaseName = dbInfo[strconv.FormatInt(lock.DatabaseID, 10)].DatabaseName
+		lock.ResourceObject = S.lockResourceObject(ctx, db, host, lock)
+		for _, sample := range bySession[sessionID] {
+			sample.Locks = append(sample.Locks, lock)
+		}
+	}
+	return rows.Err()
+}
+
+// lockResourceObject decodes the object of a lock, the associated entity of row and page locks is their hobt
+func (S SQLServerDataReader) lockResourceObject(ctx context.Context, db *sqlx.DB, host string, lock common_domain.LockDetail) *common_domain.WaitResourceObject {
+	if lock.DatabaseName == "" {
+		return nil
+	}
+	var key waitObjectKey
+	switch lock.ResourceType {
+	case common_domain.WaitResourceTypeDatabase:
+		return &common_domain.WaitResourceObject{Type: lock.ResourceType, DatabaseName: lock.DatabaseName}
+	case common_domain.WaitResourceTypeKey, common_domain.WaitResourceTypePage, common_domain.WaitResourceTypeRID,
+		common_domain.WaitResourceTypeHobt:
+		key = waitObjectKey{databaseID: lock.DatabaseID, hobt: true, id: lock.AssociatedEntityID}
+	case common_domain.WaitResourceTypeObject:
+		key = waitObjectKey{databaseID: lock.DatabaseID, id: lock.AssociatedEntityID, indexID: -1}
+	default:
+		return nil
+	}
+	object, err := S.lookupWaitObject(ctx, db, host, lock.DatabaseName, key)
+	if err != nil {
+		return nil
+	}
+	return &common_domain.WaitResourceObject{Type: lock.ResourceType, DatabaseName: lock.DatabaseName,
+		SchemaName: object.schemaName, TableName: object.tableName, IndexName: object.indexName}
+}
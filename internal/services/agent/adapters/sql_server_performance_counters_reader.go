@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+const (
+	// counterTypeRaw is PERF_COUNTER_LARGE_RAWCOUNT, the value is a point-in-time reading
+	counterTypeRaw = 65792
+	// counterTypeBulkCount is PERF_COUNTER_BULK_COUNT, the value is cumulative since the server started
+	counterTypeBulkCount = 272696576
+)
+
+// performanceCountersQuery reads the counters of the instance, object names are prefixed with SQLServer: or
+// MSSQL$<instance>: and the columns are padded nchar
+const performanceCountersQuery = `
+select rtrim(counter_name), cntr_value, cntr_type
+from sys.dm_os_performance_counters
+where cntr_type in (65792, 272696576)
+  and ((rtrim(object_name) like N'%:SQL Statistics'
+    and rtrim(counter_name) in (N'Batch Requests/sec', N'SQL Compilations/sec', N'SQL Re-Compilations/sec'))
+    or (rtrim(object_name) like N'%:Buffer Manager'
+        and rtrim(counter_name) in (N'Page life expectancy', N'Page reads/sec', N'Page writes/sec', N'Lazy writes/sec'))
+    or (rtrim(object_name) like N'%:Locks' and rtrim(instance_name) = N'_Total'
+        and rtrim(counter_name) in (N'Lock Waits/sec', N'Lock Timeouts/sec', N'Number of Deadlocks/sec'))
+    or (rtrim(object_name) like N'%:General Statistics'
+        and rtrim(counter_name) in (N'User Connections', N'Processes blocked', N'Logins/sec'))
+    or (rtrim(object_name) like N'%:Memory Manager' and rtrim(counter_name) = N'Memory Grants Pending')
+    or (rtrim(object_name) like N'%:Access Methods'
+        and rtrim(counter_name) in (N'Full Scans/sec', N'Page Splits/sec', N'Forwarded Records/sec')))
+`
+
+type counterReading struct {
+	at     time.Time
+	values map[string]int64
+	types  map[string]int64
+}
+
+// ReadPerformanceCounters returns the point-in-time counters and the rates of the cumulative ones since the previous
+// call, the first call has no rates
+func (S SQLServerDataReader) ReadPerformanceCounters(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.PerformanceCounter, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadPerformanceCounters")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	rows, err := db.QueryContext(ctx, performanceCountersQuery)
+	if err != nil {
+		return nil, fmt.Errorf("read performance counters: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	current := counterReading{at: time.Now(), values: make(map[string]int64), types: make(map[string]int64)}
+	for rows.Next() {
+		var name string
+		var value, counterType int64
+		err = rows.Scan(&name, &value, &counterType)
+		if err != nil {
+			return nil, fmt.Errorf("read performance counters scan: %w", err)
+		}
+		current.values[name] = value
+		current.types[name] = counterType
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read performance counters rows: %w", err)
+	}
+	S.countersMu.Lock()
+	last := S.lastCountersByHost[server.Host]
+	S.lastCountersByHost[server.Host] = current
+	S.countersMu.Unlock()
+	return performanceCounterRates(current, last), nil
+}
+
+// performanceCounterRates turns the cumulative counters into rates over the time between the readings, they are
+// left out when there is no previous reading. A counter going backwards means the server restarted, the current
+// reading is then the increase for the interval.
+func performanceCounterRates(current counterReading, last counterReading) []common_domain.PerformanceCounter {
+	ret := make([]common_domain.PerformanceCounter, 0, len(current.values))
+	elapsed := current.at.Sub(last.at).Seconds()
+	for name, value := range current.values {
+		if current.types[name] == counterTypeRaw {
+			ret = append(ret, common_domain.PerformanceCounter{Name: name, Value: value})
+			continue
+		}
+		prev, ok := last.values[name]
+		if !ok || elapsed <= 0 {
+			continue
+		}
+		delta := value
+		if value >= prev {
+			delta -= prev
+		}
+		ret = append(ret, common_domain.PerformanceCounter{Name: name, Value: delta, Rate: float64(delta) / elapsed})
+	}
+	return ret
+}
@@ -0,0 +1,45 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerformanceCounterRates(t *testing.T) {
+	now := time.Date(2025, 12, 14, 10, 0, 0, 0, time.UTC)
+	types := map[string]int64{
+		"Batch Requests/sec":   counterTypeBulkCount,
+		"Lock Waits/sec":       counterTypeBulkCount,
+		"Page Splits/sec":      counterTypeBulkCount,
+		"Page life expectancy": counterTypeRaw,
+	}
+	last := counterReading{at: now.Add(-10 * time.Second), types: types, values: map[string]int64{
+		"Batch Requests/sec":   1000,
+		"Lock Waits/sec":       500,
+		"Page life expectancy": 3000,
+	}}
+	current := counterReading{at: now, types: types, values: map[string]int64{
+		"Batch Requests/sec": 1250,
+		// the server restarted, the reading is the increase
+		"Lock Waits/sec": 20,
+		// first seen, no rate yet
+		"Page Splits/sec":      70,
+		"Page life expectancy": 2900,
+	}}
+
+	counters := make(map[string]common_domain.PerformanceCounter)
+	for _, c := range performanceCounterRates(current, last) {
+		counters[c.Name] = c
+	}
+	assert.Len(t, counters, 3)
+	assert.Equal(t, common_domain.PerformanceCounter{Name: "Batch Requests/sec", Value: 250, Rate: 25}, counters["Batch Requests/sec"])
+	assert.Equal(t, common_domain.PerformanceCounter{Name: "Lock Waits/sec", Value: 20, Rate: 2}, counters["Lock Waits/sec"])
+	assert.Equal(t, common_domain.PerformanceCounter{Name: "Page life expectancy", Value: 2900}, counters["Page life expectancy"])
+
+	// the first reading only has the point-in-time counters
+	first := performanceCounterRates(current, counterReading{})
+	assert.Equal(t, []common_domain.PerformanceCounter{{Name: "Page life expectancy", Value: 2900}}, first)
+}
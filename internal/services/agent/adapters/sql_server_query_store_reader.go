@@ -0,0 +1,192 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var _ domain.QueryStoreReader = (*SQLServerDataReader)(nil)
+
+// queryStoreInitialLookback is how far back the first read of a database goes, the default retention of the query
+// store
+const queryStoreInitialLookback = 30 * 24 * time.Hour
+
+// queryStoreStateQuery reads the actual state of the query store of a database with the server time, %[1]s is the
+// quoted database name. The query store is readable when READ_ONLY (1) or READ_WRITE (2).
+const queryStoreStateQuery = `
+select actual_state, sysdatetimeoffset()
+from %[1]s.sys.database_query_store_options
+`
+
+// queryStoreRuntimeStatsQuery sums the runtime stats of each plan over the execution types of the intervals started
+// since @since, execution types 3 and 4 are the aborted and failed executions
+const queryStoreRuntimeStatsQuery = `
+select rs.plan_id,
+       i.start_time,
+       i.end_time,
+       sum(rs.count_executions),
+       sum(case when rs.execution_type in (3, 4) then rs.count_executions else 0 end),
+       sum(rs.avg_duration * rs.count_executions) / sum(rs.count_executions),
+       max(rs.max_duration),
+       sum(rs.avg_cpu_time * rs.count_executions) / sum(rs.count_executions),
+       sum(rs.avg_logical_io_reads * rs.count_executions) / sum(rs.count_executions),
+       sum(rs.avg_physical_io_reads * rs.count_executions) / sum(rs.count_executions),
+       sum(rs.avg_rowcount * rs.count_executions) / sum(rs.count_executions),
+       max(rs.last_execution_time)
+from %[1]s.sys.query_store_runtime_stats rs
+         inner join %[1]s.sys.query_store_runtime_stats_interval i
+                    on i.runtime_stats_interval_id = rs.runtime_stats_interval_id
+where i.start_time >= @since
+group by rs.plan_id, i.start_time, i.end_time
+`
+
+// queryStorePlansQuery reads the plans executed in the intervals started since @since, the plan xml is only read for
+// the plans compiled since @compiled_since
+const queryStorePlansQuery = `
+select p.plan_id,
+       q.query_id,
+       q.query_hash,
+       p.query_plan_hash,
+       qt.query_sql_text,
+       case when p.last_compile_start_time >= @compiled_since then isnull(p.query_plan, '') else '' end,
+       p.is_forced_plan,
+       p.initial_compile_start_time,
+       p.last_execution_time
+from %[1]s.sys.query_store_plan p
+         inner join %[1]s.sys.query_store_query q on q.query_id = p.query_id
+         inner join %[1]s.sys.query_store_query_text qt on qt.query_text_id = q.query_text_id
+where exists (select 1
+              from %[1]s.sys.query_store_runtime_stats rs
+                       inner join %[1]s.sys.query_store_runtime_stats_interval i
+                                  on i.runtime_stats_interval_id = rs.runtime_stats_interval_id
+              where rs.plan_id = p.plan_id
+                and i.start_time >= @since)
+`
+
+// ReadQueryStore returns the plans and runtime stats the query store of each database recorded since its watermark,
+// a database without a watermark goes back queryStoreInitialLookback. A database that cannot be read is skipped and
+// reported in Failed.
+func (S SQLServerDataReader) ReadQueryStore(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, since map[string]common_domain.QueryStoreWatermark) (*common_domain.QueryStoreStats, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadQueryStore")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	var names []string
+	err := db.SelectContext(ctx, &names, userDatabasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("list databases: %w", err)
+	}
+	stats := &common_domain.QueryStoreStats{Server: server, Timestamp: time.Now(),
+		Watermarks: make(map[string]common_domain.QueryStoreWatermark), Failed: make(common_domain.DatabaseErrors)}
+	for _, name := range names {
+		if !databases.Matches(name) {
+			continue
+		}
+		var state int
+		var readAt time.Time
+		err = db.QueryRowContext(ctx, fmt.Sprintf(queryStoreStateQuery, quoteName(name))).Scan(&state, &readAt)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			stats.Failed[name] = fmt.Errorf("read query store state: %w", err)
+			continue
+		}
+		if state != 1 && state != 2 {
+			continue
+		}
+		last, found := since[name]
+		intervalsSince, compiledSince := last.IntervalStart, last.ReadAt
+		if !found {
+			intervalsSince = readAt.Add(-queryStoreInitialLookback)
+		}
+		runtimeStats, err2 := S.readQueryStoreRuntimeStats(ctx, db.DB, name, intervalsSince)
+		if err2 != nil {
+			stats.Failed[name] = fmt.Errorf("read query store runtime stats: %w", err2)
+			continue
+		}
+		plans, err2 := S.readQueryStorePlans(ctx, db.DB, name, intervalsSince, compiledSince)
+		if err2 != nil {
+			stats.Failed[name] = fmt.Errorf("read query store plans: %w", err2)
+			continue
+		}
+		stats.RuntimeStats = append(stats.RuntimeStats, runtimeStats...)
+		stats.Plans = append(stats.Plans, plans...)
+		stats.Watermarks[name] = nextQueryStoreWatermark(last, runtimeStats, readAt)
+	}
+	return stats, nil
+}
+
+// nextQueryStoreWatermark moves the watermark to the latest interval read, it stays put when nothing ran
+func nextQueryStoreWatermark(last common_domain.QueryStoreWatermark, runtimeStats []common_domain.QueryStoreRuntimeStats, readAt time.Time) common_domain.QueryStoreWatermark {
+	next := common_domain.QueryStoreWatermark{IntervalStart: last.IntervalStart, ReadAt: readAt}
+	for _, rs := range runtimeStats {
+		if rs.IntervalStart.After(next.IntervalStart) {
+			next.IntervalStart = rs.IntervalStart
+		}
+	}
+	if next.IntervalStart.IsZero() {
+		next.IntervalStart = readAt
+	}
+	return next
+}
+
+func (S SQLServerDataReader) readQueryStoreRuntimeStats(ctx context.Context, db *sql.DB, database string, since time.Time) ([]common_domain.QueryStoreRuntimeStats, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(queryStoreRuntimeStatsQuery, quoteName(database)), sql.Named("since", since))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.QueryStoreRuntimeStats, 0)
+	for rows.Next() {
+		rs := common_domain.QueryStoreRuntimeStats{DatabaseName: database}
+		var lastExecution sql.NullTime
+		err = rows.Scan(&rs.PlanID, &rs.IntervalStart, &rs.IntervalEnd, &rs.Executions, &rs.AbortedExecutions,
+			&rs.AvgDurationUs, &rs.MaxDurationUs, &rs.AvgCPUTimeUs, &rs.AvgLogicalReads, &rs.AvgPhysicalReads,
+			&rs.AvgRowCount, &lastExecution)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		rs.LastExecutionTime = lastExecution.Time
+		ret = append(ret, rs)
+	}
+	return ret, rows.Err()
+}
+
+func (S SQLServerDataReader) readQueryStorePlans(ctx context.Context, db *sql.DB, database string, since time.Time, compiledSince time.Time) ([]common_domain.QueryStorePlan, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(queryStorePlansQuery, quoteName(database)), sql.Named("since", since),
+		sql.Named("compiled_since", compiledSince))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.QueryStorePlan, 0)
+	for rows.Next() {
+		p := common_domain.QueryStorePlan{DatabaseName: database}
+		var queryHash, planHash []byte
+		var lastExecution sql.NullTime
+		err = rows.Scan(&p.PlanID, &p.QueryID, &queryHash, &planHash, &p.QueryText, &p.PlanXML, &p.IsForcedPlan,
+			&p.FirstCompileTime, &lastExecution)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		p.QueryHash = base64.StdEncoding.EncodeToString(queryHash)
+		p.QueryPlanHash = base64.StdEncoding.EncodeToString(planHash)
+		p.LastExecutionTime = lastExecution.Time
+		ret = append(ret, p)
+	}
+	return ret, rows.Err()
+}
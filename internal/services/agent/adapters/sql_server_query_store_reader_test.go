@@ -0,0 +1,30 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextQueryStoreWatermark(t *testing.T) {
+	hour := time.Date(2025, 12, 16, 10, 0, 0, 0, time.UTC)
+	readAt := hour.Add(25 * time.Minute)
+	runtimeStats := []common_domain.QueryStoreRuntimeStats{
+		{PlanID: 1, IntervalStart: hour.Add(-time.Hour)},
+		{PlanID: 1, IntervalStart: hour},
+		{PlanID: 2, IntervalStart: hour.Add(-time.Hour)},
+	}
+
+	// the open interval is read again next time
+	next := nextQueryStoreWatermark(common_domain.QueryStoreWatermark{}, runtimeStats, readAt)
+	assert.Equal(t, common_domain.QueryStoreWatermark{IntervalStart: hour, ReadAt: readAt}, next)
+
+	// nothing ran, only the compile watermark moves
+	later := readAt.Add(15 * time.Minute)
+	assert.Equal(t, common_domain.QueryStoreWatermark{IntervalStart: hour, ReadAt: later}, nextQueryStoreWatermark(next, nil, later))
+
+	// a database that never ran anything starts from the read
+	assert.Equal(t, common_domain.QueryStoreWatermark{IntervalStart: readAt, ReadAt: readAt}, nextQueryStoreWatermark(common_domain.QueryStoreWatermark{}, nil, readAt))
+}
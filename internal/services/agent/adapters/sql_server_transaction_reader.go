@@ -0,0 +1,117 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+)
+
+// openTransactionMinAge is how long a transaction has to be open to be part of a snapshot, shorter ones are the
+// regular traffic
+const openTransactionMinAge = 5 * time.Second
+
+// openTransactionsQuery reads the user transactions open since before @min_age_seconds with the session owning them,
+// the log they hold in every database but the resource database (32767) and whether they keep row versions alive. The
+// begin time is converted from the server time zone to UTC.
+const openTransactionsQuery = `
+select at.transaction_id,
+       at.name,
+       s.session_id,
+       isnull(s.host_name, ''),
+       isnull(s.program_name, ''),
+       s.login_name,
+       s.status,
+       dateadd(minute, datediff(minute, getdate(), getutcdate()), at.transaction_begin_time),
+       cast(datediff(second, at.transaction_begin_time, getdate()) as bigint) * 1000,
+       case at.transaction_type
+           when 1 then 'read/write'
+           when 2 then 'read-only'
+           when 3 then 'system'
+           when 4 then 'distributed'
+           else 'unknown' end,
+       case at.transaction_state
+           when 0 then 'not initialized'
+           when 1 then 'initialized'
+           when 2 then 'active'
+           when 3 then 'ended'
+           when 4 then 'commit initiated'
+           when 5 then 'prepared'
+           when 6 then 'committed'
+           when 7 then 'rolling back'
+           when 8 then 'rolled back'
+           else 'unknown' end,
+       isnull(top_db.database_name, ''),
+       isnull(lg.log_bytes_used, 0),
+       isnull(lg.log_bytes_reserved, 0),
+       isnull(lg.log_record_count, 0),
+       cast(case
+                when exists (select 1
+                             from sys.dm_tran_active_snapshot_database_transactions v
+                             where v.transaction_id = at.transaction_id) then 1
+                else 0 end as bit)
+from sys.dm_tran_active_transactions at
+         inner join sys.dm_tran_session_transactions st on st.transaction_id = at.transaction_id
+         inner join sys.dm_exec_sessions s on s.session_id = st.session_id
+         outer apply (select sum(dt.database_transaction_log_bytes_used)     as log_bytes_used,
+                             sum(dt.database_transaction_log_bytes_reserved) as log_bytes_reserved,
+                             sum(dt.database_transaction_log_record_count)   as log_record_count
+                      from sys.dm_tran_database_transactions dt
+                      where dt.transaction_id = at.transaction_id
+                        and dt.database_id <> 32767) lg
+         outer apply (select top 1 db_name(dt.database_id) as database_name
+                      from sys.dm_tran_database_transactions dt
+                      where dt.transaction_id = at.transaction_id
+                        and dt.database_id <> 32767
+                      order by dt.database_transaction_log_bytes_used desc) top_db
+where s.is_user_process = 1
+  and s.session_id <> @@spid
+  and at.transaction_begin_time <= dateadd(second, -@min_age_seconds, getdate())
+order by at.transaction_begin_time
+`
+
+// versionStoreQuery reads the size of the version store in tempdb
+const versionStoreQuery = `
+select isnull(sum(version_store_reserved_page_count), 0) * 8
+from tempdb.sys.dm_db_file_space_usage
+`
+
+// readOpenTransactions returns the transactions open for at least openTransactionMinAge, transactions that did not
+// write to a database yet are kept whatever the database filter
+func (S SQLServerDataReader) readOpenTransactions(ctx context.Context, db *sqlx.DB, databases common_domain.DatabaseFilter) ([]common_domain.OpenTransaction, error) {
+	rows, err := db.QueryContext(ctx, openTransactionsQuery, sql.Named("min_age_seconds", int(openTransactionMinAge.Seconds())))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.OpenTransaction, 0)
+	for rows.Next() {
+		var t common_domain.OpenTransaction
+		var sessionID int
+		err = rows.Scan(&t.TransactionID, &t.Name, &sessionID, &t.Session.HostName, &t.Session.ProgramName,
+			&t.Session.LoginName, &t.Session.Status, &t.BeginTime, &t.DurationMs, &t.TransactionType,
+			&t.TransactionState, &t.DatabaseName, &t.LogBytesUsed, &t.LogBytesReserved, &t.LogRecordCount,
+			&t.HoldsVersionStore)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		if t.DatabaseName != "" && !databases.Matches(t.DatabaseName) {
+			continue
+		}
+		t.Session.SessionID = strconv.Itoa(sessionID)
+		ret = append(ret, t)
+	}
+	return ret, rows.Err()
+}
+
+func (S SQLServerDataReader) readVersionStoreKB(ctx context.Context, db *sqlx.DB) (int64, error) {
+	var kb int64
+	err := db.QueryRowContext(ctx, versionStoreQuery).Scan(&kb)
+	return kb, err
+}
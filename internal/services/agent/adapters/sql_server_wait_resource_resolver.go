@@ -0,0 +1,209 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+)
+
+// hobtObjectQuery finds the table and index of a heap or b-tree, %[1]s is the quoted database name
+const hobtObjectQuery = `
+exec %[1]s.sys.sp_executesql N'
+select s.name, o.name, isnull(i.name, '''')
+from sys.partitions p
+         inner join sys.objects o on o.object_id = p.object_id
+         inner join sys.schemas s on s.schema_id = o.schema_id
+         left join sys.indexes i on i.object_id = p.object_id and i.index_id = p.index_id
+where p.hobt_id = @hobt_id', N'@hobt_id bigint', @hobt_id = @id
+`
+
+// indexObjectQuery finds the table and index of an object and index id, %[1]s is the quoted database name
+const indexObjectQuery = `
+exec %[1]s.sys.sp_executesql N'
+select s.name, o.name, isnull(i.name, '''')
+from sys.objects o
+         inner join sys.schemas s on s.schema_id = o.schema_id
+         left join sys.indexes i on i.object_id = o.object_id and i.index_id = @index_id
+where o.object_id = @object_id', N'@object_id int, @index_id int', @object_id = @id, @index_id = @index
+`
+
+// pageObjectQuery finds the object and index a page is allocated to, sys.dm_db_page_info is available from SQL
+// Server 2019
+const pageObjectQuery = `
+select isnull(object_id, 0), isnull(index_id, 0)
+from sys.dm_db_page_info(@database_id, @file_id, @page_id, 'LIMITED')
+`
+
+// waitObjectKey identifies a catalog lookup, id is a hobt id for KEY and HOBT resources and an object id otherwise
+type waitObjectKey struct {
+	databaseID int64
+	hobt       bool
+	id         int64
+	indexID    int64
+}
+
+type waitObject struct {
+	schemaName string
+	tableName  string
+	indexName  string
+	// err is why the lookup failed, the failure is cached so an object the agent cannot see is not looked up on every
+	// snapshot
+	err     error
+	expires time.Time
+}
+
+const (
+	// waitObjectTTL is how long a lookup is cached, the ids of a dropped object are reused
+	waitObjectTTL = time.Hour
+	// tempdbWaitObjectTTL is for tempdb (database id 2), its temporary tables and their ids come and go all the time
+	tempdbWaitObjectTTL = time.Minute
+	// waitObjectMissTTL is how long a failed lookup is not retried
+	waitObjectMissTTL = 5 * time.Minute
+	tempdbID          = 2
+)
+
+// waitObjectCache holds the lookups of a host
+type waitObjectCache map[waitObjectKey]waitObject
+
+func (c waitObjectCache) get(key waitObjectKey, now time.Time) (waitObject, bool) {
+	object, ok := c[key]
+	if !ok || !now.Before(object.expires) {
+		return waitObject{}, false
+	}
+	return object, true
+}
+
+// put caches object until its ttl runs out and drops the expired lookups
+func (c waitObjectCache) put(key waitObjectKey, object waitObject, now time.Time) {
+	for k, o := range c {
+		if !now.Before(o.expires) {
+			delete(c, k)
+		}
+	}
+	ttl := waitObjectTTL
+	switch {
+	case object.err != nil:
+		ttl = waitObjectMissTTL
+	case key.databaseID == tempdbID:
+		ttl = tempdbWaitObjectTTL
+	}
+	object.expires = now.Add(ttl)
+	c[key] = object
+}
+
+// resolveWaitResources decodes the wait resources of the samples into the objects they belong to. The lookups, failed
+// ones included, are cached per host for a while. A resource that cannot be decoded is left raw, the lookups fail on
+// older versions (pages) and on databases the agent cannot access.
+func (S SQLServerDataReader) resolveWaitResources(ctx context.Context, db *sqlx.DB, host string, dbInfo map[string]common_domain.DataBaseMetadata, samples []*common_domain.QuerySample) {
+	for _, sample := range samples {
+		if sample.Wait.WaitResource == "" {
+			continue
+		}
+		ref, ok := common_domain.ParseWaitResource(sample.Wait.WaitResource)
+		if !ok {
+			continue
+		}
+		database, ok := dbInfo[fmt.Sprint(ref.DatabaseID)]
+		if !ok {
+			continue
+		}
+		object, err := S.resolveWaitResource(ctx, db, host, database.DatabaseName, ref)
+		if err != nil {
+			continue
+		}
+		sample.Wait.ResourceObject = object
+	}
+}
+
+func (S SQLServerDataReader) resolveWaitResource(ctx context.Context, db *sqlx.DB, host string, databaseName string, ref common_domain.WaitResourceRef) (*common_domain.WaitResourceObject, error) {
+	ret := &common_domain.WaitResourceObject{Type: ref.Type, DatabaseName: databaseName}
+	var key waitObjectKey
+	switch ref.Type {
+	case common_domain.WaitResourceTypeDatabase:
+		return ret, nil
+	case common_domain.WaitResourceTypeKey, common_domain.WaitResourceTypeHobt:
+		key = waitObjectKey{databaseID: ref.DatabaseID, hobt: true, id: ref.HobtID}
+	case common_domain.WaitResourceTypeObject, common_domain.WaitResourceTypeTab:
+		key = waitObjectKey{databaseID: ref.DatabaseID, id: ref.ObjectID, indexID: -1}
+	case common_domain.WaitResourceTypePage, common_domain.WaitResourceTypeRID:
+		// pages are reallocated, only the object they belong to is cached
+		if S.pageInfoDisabled(host, time.Now()) {
+			return nil, fmt.Errorf("page info disabled on %s", host)
+		}
+		var objectID, indexID int64
+		err := db.QueryRowContext(ctx, pageObjectQuery, sql.Named("database_id", ref.DatabaseID),
+			sql.Named("file_id", ref.FileID), sql.Named("page_id", ref.PageID)).Scan(&objectID, &indexID)
+		if err != nil {
+			if ctx.Err() == nil {
+				S.disablePageInfo(host, time.Now())
+			}
+			return nil, fmt.Errorf("page info: %w", err)
+		}
+		if objectID == 0 {
+			return nil, fmt.Errorf("page %d:%d:%d not allocated", ref.DatabaseID, ref.FileID, ref.PageID)
+		}
+		key = waitObjectKey{databaseID: ref.DatabaseID, id: objectID, indexID: indexID}
+	default:
+		return nil, fmt.Errorf("unsupported wait resource %s", ref.Type)
+	}
+	object, err := S.lookupWaitObject(ctx, db, host, databaseName, key)
+	if err != nil {
+		return nil, err
+	}
+	ret.SchemaName, ret.TableName, ret.IndexName = object.schemaName, object.tableName, object.indexName
+	return ret, nil
+}
+
+// pageInfoDisabled tells if the page lookups of host are skipped, sys.dm_db_page_info fails on every page before SQL
+// Server 2019 and without VIEW DATABASE STATE
+func (S SQLServerDataReader) pageInfoDisabled(host string, now time.Time) bool {
+	S.waitObjectsMu.Lock()
+	defer S.waitObjectsMu.Unlock()
+	return now.Before(S.pageInfoDisabledUntilByHost[host])
+}
+
+// disablePageInfo skips the page lookups of host for waitObjectMissTTL
+func (S SQLServerDataReader) disablePageInfo(host string, now time.Time) {
+	S.waitObjectsMu.Lock()
+	defer S.waitObjectsMu.Unlock()
+	S.pageInfoDisabledUntilByHost[host] = now.Add(waitObjectMissTTL)
+}
+
+func (S SQLServerDataReader) lookupWaitObject(ctx context.Context, db *sqlx.DB, host string, databaseName string, key waitObjectKey) (waitObject, error) {
+	S.waitObjectsMu.Lock()
+	object, ok := S.waitObjectsByHost[host].get(key, time.Now())
+	S.waitObjectsMu.Unlock()
+	if ok {
+		return object, object.err
+	}
+	var row *sql.Row
+	if key.hobt {
+		row = db.QueryRowContext(ctx, fmt.Sprintf(hobtObjectQuery, quoteName(databaseName)), sql.Named("id", key.id))
+	} else {
+		row = db.QueryRowContext(ctx, fmt.Sprintf(indexObjectQuery, quoteName(databaseName)), sql.Named("id", key.id),
+			sql.Named("index", key.indexID))
+	}
+	err := row.Scan(&object.schemaName, &object.tableName, &object.indexName)
+	if err != nil {
+		if ctx.Err() != nil {
+			return waitObject{}, fmt.Errorf("lookup object: %w", err)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			object = waitObject{err: fmt.Errorf("object of %+v not found in %s", key, databaseName)}
+		} else {
+			object = waitObject{err: fmt.Errorf("lookup object: %w", err)}
+		}
+	}
+	S.waitObjectsMu.Lock()
+	if _, ok := S.waitObjectsByHost[host]; !ok {
+		S.waitObjectsByHost[host] = make(waitObjectCache)
+	}
+	S.waitObjectsByHost[host].put(key, object, time.Now())
+	S.waitObjectsMu.Unlock()
+	return object, object.err
+}
@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitObjectCache(t *testing.T) {
+	now := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	table := waitObjectKey{databaseID: 5, hobt: true, id: 72057594043236352}
+	temp := waitObjectKey{databaseID: tempdbID, hobt: true, id: 72057594043301888}
+	missing := waitObjectKey{databaseID: 6, id: 245575913, indexID: -1}
+	cache := make(waitObjectCache)
+	cache.put(table, waitObject{schemaName: "dbo", tableName: "orders"}, now)
+	cache.put(temp, waitObject{schemaName: "dbo", tableName: "#orders"}, now)
+	cache.put(missing, waitObject{err: errors.New("object not found")}, now)
+
+	object, ok := cache.get(table, now.Add(30*time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, "orders", object.tableName)
+	_, ok = cache.get(temp, now.Add(2*time.Minute))
+	assert.False(t, ok)
+	object, ok = cache.get(missing, now.Add(time.Minute))
+	require.True(t, ok)
+	assert.Error(t, object.err)
+	_, ok = cache.get(missing, now.Add(10*time.Minute))
+	assert.False(t, ok)
+
+	// expired lookups are dropped on the next put
+	cache.put(missing, waitObject{schemaName: "dbo", tableName: "invoices"}, now.Add(2*time.Hour))
+	assert.Len(t, cache, 1)
+}
+
+func TestPageInfoDisabled(t *testing.T) {
+	now := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	reader := NewSQLServerDataReader(nil, nil)
+	assert.False(t, reader.pageInfoDisabled("sql2017", now))
+
+	reader.disablePageInfo("sql2017", now)
+	assert.True(t, reader.pageInfoDisabled("sql2017", now.Add(time.Minute)))
+	assert.False(t, reader.pageInfoDisabled("sql2022", now.Add(time.Minute)))
+	assert.False(t, reader.pageInfoDisabled("sql2017", now.Add(waitObjectMissTTL)))
+}
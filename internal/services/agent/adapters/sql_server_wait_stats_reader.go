@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+var _ domain.SystemMetricsReader = (*SQLServerDataReader)(nil)
+
+// waitStatsQuery leaves out the waits of idle background tasks, they grow all the time and hide the real waits
+const waitStatsQuery = `
+select wait_type, waiting_tasks_count, wait_time_ms, signal_wait_time_ms, max_wait_time_ms
+from sys.dm_os_wait_stats
+where wait_type not in (
+    N'BROKER_EVENTHANDLER', N'BROKER_RECEIVE_WAITFOR', N'BROKER_TASK_STOP', N'BROKER_TO_FLUSH', N'BROKER_TRANSMITTER',
+    N'CHECKPOINT_QUEUE', N'CHKPT', N'CLR_AUTO_EVENT', N'CLR_MANUAL_EVENT', N'CLR_SEMAPHORE', N'DBMIRROR_DBM_EVENT',
+    N'DBMIRROR_EVENTS_QUEUE', N'DBMIRROR_WORKER_QUEUE', N'DBMIRRORING_CMD', N'DIRTY_PAGE_POLL', N'DISPATCHER_QUEUE_SEMAPHORE',
+    N'EXECSYNC', N'FSAGENT', N'FT_IFTS_SCHEDULER_IDLE_WAIT', N'FT_IFTSHC_MUTEX', N'HADR_CLUSAPI_CALL',
+    N'HADR_FILESTREAM_IOMGR_IOCOMPLETION', N'HADR_LOGCAPTURE_WAIT', N'HADR_NOTIFICATION_DEQUEUE', N'HADR_TIMER_TASK',
+    N'HADR_WORK_QUEUE', N'KSOURCE_WAKEUP', N'LAZYWRITER_SLEEP', N'LOGMGR_QUEUE', N'MEMORY_ALLOCATION_EXT',
+    N'ONDEMAND_TASK_QUEUE', N'PARALLEL_REDO_DRAIN_WORKER', N'PARALLEL_REDO_LOG_CACHE', N'PARALLEL_REDO_TRAN_LIST',
+    N'PARALLEL_REDO_WORKER_SYNC', N'PARALLEL_REDO_WORKER_WAIT_WORK', N'PREEMPTIVE_XE_GETTARGETSTATE',
+    N'PWAIT_ALL_COMPONENTS_INITIALIZED', N'PWAIT_DIRECTLOGCONSUMER_GETNEXT', N'QDS_PERSIST_TASK_MAIN_LOOP_SLEEP',
+    N'QDS_ASYNC_QUEUE', N'QDS_CLEANUP_STALE_QUERIES_TASK_MAIN_LOOP_SLEEP', N'QDS_SHUTDOWN_QUEUE', N'REDO_THREAD_PENDING_WORK',
+    N'REQUEST_FOR_DEADLOCK_SEARCH', N'RESOURCE_QUEUE', N'SERVER_IDLE_CHECK', N'SLEEP_BPOOL_FLUSH', N'SLEEP_DBSTARTUP',
+    N'SLEEP_DCOMSTARTUP', N'SLEEP_MASTERDBREADY', N'SLEEP_MASTERMDREADY', N'SLEEP_MASTERUPGRADED', N'SLEEP_MSDBSTARTUP',
+    N'SLEEP_SYSTEMTASK', N'SLEEP_TASK', N'SLEEP_TEMPDBSTARTUP', N'SNI_HTTP_ACCEPT', N'SOS_WORK_DISPATCHER',
+    N'SP_SERVER_DIAGNOSTICS_SLEEP', N'SQLTRACE_BUFFER_FLUSH', N'SQLTRACE_INCREMENTAL_FLUSH_SLEEP',
+    N'SQLTRACE_WAIT_ENTRIES', N'WAIT_FOR_RESULTS', N'WAITFOR', N'WAITFOR_TASKSHUTDOWN', N'WAIT_XTP_RECOVERY',
+    N'WAIT_XTP_HOST_WAIT', N'WAIT_XTP_OFFLINE_CKPT_NEW_LOG', N'WAIT_XTP_CKPT_CLOSE', N'XE_DISPATCHER_JOIN',
+    N'XE_DISPATCHER_WAIT', N'XE_TIMER_EVENT')
+  and waiting_tasks_count > 0
+`
+
+// ReadWaitStats returns the wait stats accumulated since the previous call, the first call only takes the baseline
+func (S SQLServerDataReader) ReadWaitStats(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.WaitStat, error) {
+	ctx, span := S.tracer.Start(ctx, "ReadWaitStats")
+	defer span.End()
+	db, ok := S.dbByHost[server.Host]
+	if !ok {
+		return nil, fmt.Errorf("no db for host %s", server.Host)
+	}
+	rows, err := db.QueryContext(ctx, waitStatsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("read wait stats: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	current := make(map[string]common_domain.WaitStat)
+	for rows.Next() {
+		var w common_domain.WaitStat
+		err = rows.Scan(&w.WaitType, &w.WaitingTasks, &w.WaitTimeMs, &w.SignalWaitTimeMs, &w.MaxWaitTimeMs)
+		if err != nil {
+			return nil, fmt.Errorf("read wait stats scan: %w", err)
+		}
+		current[w.WaitType] = w
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("read wait stats rows: %w", err)
+	}
+	S.waitStatsMu.Lock()
+	last, found := S.lastWaitStatsByHost[server.Host]
+	S.lastWaitStatsByHost[server.Host] = current
+	S.waitStatsMu.Unlock()
+	if !found {
+		return nil, nil
+	}
+	return waitStatDeltas(current, last), nil
+}
+
+// waitStatDeltas returns the wait types that waited between two readings. A counter going backwards means the
+// stats were cleared (DBCC SQLPERF or a restart), the current reading is then the delta for the interval.
+func waitStatDeltas(current map[string]common_domain.WaitStat, last map[string]common_domain.WaitStat) []common_domain.WaitStat {
+	ret := make([]common_domain.WaitStat, 0)
+	for waitType, w := range current {
+		prev, ok := last[waitType]
+		delta := w
+		if ok && w.WaitingTasks >= prev.WaitingTasks && w.WaitTimeMs >= prev.WaitTimeMs {
+			delta.WaitingTasks -= prev.WaitingTasks
+			delta.WaitTimeMs -= prev.WaitTimeMs
+			delta.SignalWaitTimeMs -= prev.SignalWaitTimeMs
+		}
+		if delta.WaitingTasks == 0 && delta.WaitTimeMs == 0 {
+			continue
+		}
+		ret = append(ret, delta)
+	}
+	return ret
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitStatDeltas(t *testing.T) {
+	last := map[string]common_domain.WaitStat{
+		"PAGEIOLATCH_SH": {WaitType: "PAGEIOLATCH_SH", WaitingTasks: 100, WaitTimeMs: 5000, SignalWaitTimeMs: 50, MaxWaitTimeMs: 300},
+		"LCK_M_X":        {WaitType: "LCK_M_X", WaitingTasks: 10, WaitTimeMs: 9000, SignalWaitTimeMs: 10, MaxWaitTimeMs: 2000},
+		"CXPACKET":       {WaitType: "CXPACKET", WaitingTasks: 500, WaitTimeMs: 70000, SignalWaitTimeMs: 900, MaxWaitTimeMs: 100},
+	}
+	current := map[string]common_domain.WaitStat{
+		// grew during the interval
+		"PAGEIOLATCH_SH": {WaitType: "PAGEIOLATCH_SH", WaitingTasks: 130, WaitTimeMs: 6500, SignalWaitTimeMs: 60, MaxWaitTimeMs: 300},
+		// did not wait
+		"LCK_M_X": {WaitType: "LCK_M_X", WaitingTasks: 10, WaitTimeMs: 9000, SignalWaitTimeMs: 10, MaxWaitTimeMs: 2000},
+		// cleared, the reading is the delta
+		"CXPACKET": {WaitType: "CXPACKET", WaitingTasks: 20, WaitTimeMs: 800, SignalWaitTimeMs: 30, MaxWaitTimeMs: 90},
+		// first seen
+		"WRITELOG": {WaitType: "WRITELOG", WaitingTasks: 3, WaitTimeMs: 12, SignalWaitTimeMs: 1, MaxWaitTimeMs: 8},
+	}
+
+	deltas := make(map[string]common_domain.WaitStat)
+	for _, w := range waitStatDeltas(current, last) {
+		deltas[w.WaitType] = w
+	}
+	assert.Len(t, deltas, 3)
+	assert.Equal(t, common_domain.WaitStat{WaitType: "PAGEIOLATCH_SH", WaitingTasks: 30, WaitTimeMs: 1500, SignalWaitTimeMs: 10, MaxWaitTimeMs: 300}, deltas["PAGEIOLATCH_SH"])
+	assert.Equal(t, current["CXPACKET"], deltas["CXPACKET"])
+	assert.Equal(t, current["WRITELOG"], deltas["WRITELOG"])
+}
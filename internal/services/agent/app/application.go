@@ -14,31 +14,73 @@ type Application struct {
 }
 
 type Queries struct {
-	ReadMetrics     query.ReadMetricsHandler
-	ReadSnapshot    query.ReadSnapshotHandler
-	GetQueryPlans   query.GetQueryPlansHandler
-	GetKnownHandles query.GetKnownPlanHandlesHandler
+	ReadMetrics         query.ReadMetricsHandler
+	ReadSnapshot        query.ReadSnapshotHandler
+	GetQueryPlans       query.GetQueryPlansHandler
+	GetKnownHandles     query.GetKnownPlanHandlesHandler
+	ReadDeadlocks       query.ReadDeadlocksHandler
+	GetKnownWarnings    query.GetKnownWarningsHandler
+	GetCollectionConfig query.GetCollectionConfigHandler
+	ReadWaitStats       query.ReadWaitStatsHandler
+	ReadCounters        query.ReadPerformanceCountersHandler
+	ReadFileIOStats     query.ReadFileIOStatsHandler
+	ReadReplicaStates   query.ReadReplicaStatesHandler
+	ReadIndexStats      query.ReadIndexStatsHandler
+	ReadQueryStore      query.ReadQueryStoreHandler
+	ReadJobs            query.ReadJobsHandler
+	ReadFileSizes       query.ReadFileSizesHandler
 }
 
 type Commands struct {
-	UploadMetrics   command.UploadMetricsHandler
-	UploadSnapshot  command.UploadSnapshotHandler
-	UploadExecPlans command.UploadExecPlansHandler
+	UploadMetrics       command.UploadMetricsHandler
+	UploadSnapshot      command.UploadSnapshotHandler
+	UploadExecPlans     command.UploadExecPlansHandler
+	UploadDeadlocks     command.UploadDeadlocksHandler
+	UploadWarnings      command.UploadWarningsHandler
+	RegisterAgent       command.RegisterAgentHandler
+	SendHeartbeat       command.SendHeartbeatHandler
+	UploadSystemMetrics command.UploadSystemMetricsHandler
+	UploadIndexStats    command.UploadIndexStatsHandler
+	UploadQueryStore    command.UploadQueryStoreHandler
+	UploadJobs          command.UploadJobsHandler
+	UploadFileSizes     command.UploadFileSizesHandler
 }
 
-func NewApplication(samplesReader domain.SamplesReader, reader domain.QueryMetricsReader,
-	client domain.IngestionClient, router *events.EventRouter) *Application {
+func NewApplication(samplesReader domain.SamplesReader, reader domain.QueryMetricsReader, deadlockReader domain.DeadlockReader,
+	systemMetricsReader domain.SystemMetricsReader, indexStatsReader domain.IndexStatsReader, queryStoreReader domain.QueryStoreReader,
+	jobReader domain.JobReader, fileSizeReader domain.FileSizeReader, client domain.IngestionClient,
+	router *events.EventRouter) *Application {
 	return &Application{
 		Queries: Queries{
-			ReadMetrics:     *query.NewReadMetricsHandler(reader),
-			ReadSnapshot:    *query.NewReadSnapshotHandler(samplesReader),
-			GetQueryPlans:   *query.NewGetQueryPlansHandler(samplesReader),
-			GetKnownHandles: *query.NewGetKnownPlanHandlesHandler(client),
+			ReadMetrics:         *query.NewReadMetricsHandler(reader),
+			ReadSnapshot:        *query.NewReadSnapshotHandler(samplesReader),
+			GetQueryPlans:       *query.NewGetQueryPlansHandler(samplesReader),
+			GetKnownHandles:     *query.NewGetKnownPlanHandlesHandler(client),
+			ReadDeadlocks:       *query.NewReadDeadlocksHandler(deadlockReader),
+			GetKnownWarnings:    *query.NewGetKnownWarningsHandler(client),
+			GetCollectionConfig: *query.NewGetCollectionConfigHandler(client),
+			ReadWaitStats:       *query.NewReadWaitStatsHandler(systemMetricsReader),
+			ReadCounters:        *query.NewReadPerformanceCountersHandler(systemMetricsReader),
+			ReadFileIOStats:     *query.NewReadFileIOStatsHandler(systemMetricsReader),
+			ReadReplicaStates:   *query.NewReadReplicaStatesHandler(systemMetricsReader),
+			ReadIndexStats:      *query.NewReadIndexStatsHandler(indexStatsReader),
+			ReadQueryStore:      *query.NewReadQueryStoreHandler(queryStoreReader),
+			ReadJobs:            *query.NewReadJobsHandler(jobReader),
+			ReadFileSizes:       *query.NewReadFileSizesHandler(fileSizeReader),
 		},
 		Commands: Commands{
-			UploadMetrics:   *command.NewUploadMetricsHandler(client),
-			UploadSnapshot:  *command.NewUploadSnapshotHandler(client),
-			UploadExecPlans: *command.NewUploadExecPlansHandler(client),
+			UploadMetrics:       *command.NewUploadMetricsHandler(client),
+			UploadSnapshot:      *command.NewUploadSnapshotHandler(client),
+			UploadExecPlans:     *command.NewUploadExecPlansHandler(client),
+			UploadDeadlocks:     *command.NewUploadDeadlocksHandler(client),
+			UploadWarnings:      *command.NewUploadWarningsHandler(client),
+			RegisterAgent:       *command.NewRegisterAgentHandler(client),
+			SendHeartbeat:       *command.NewSendHeartbeatHandler(client),
+			UploadSystemMetrics: *command.NewUploadSystemMetricsHandler(client),
+			UploadIndexStats:    *command.NewUploadIndexStatsHandler(client),
+			UploadQueryStore:    *command.NewUploadQueryStoreHandler(client),
+			UploadJobs:          *command.NewUploadJobsHandler(client),
+			UploadFileSizes:     *command.NewUploadFileSizesHandler(client),
 		},
 		EventRouter: router,
 	}
@@ -0,0 +1,19 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+)
+
+type RegisterAgentHandler struct {
+	client domain.IngestionClient
+}
+
+func NewRegisterAgentHandler(client domain.IngestionClient) *RegisterAgentHandler {
+	return &RegisterAgentHandler{client: client}
+}
+
+func (h RegisterAgentHandler) Handle(ctx context.Context, registration domain.AgentRegistration) error {
+	return h.client.RegisterAgent(ctx, registration)
+}
@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type SendHeartbeatHandler struct {
+	client domain.IngestionClient
+}
+
+func NewSendHeartbeatHandler(client domain.IngestionClient) *SendHeartbeatHandler {
+	return &SendHeartbeatHandler{client: client}
+}
+
+func (h SendHeartbeatHandler) Handle(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus) error {
+	return h.client.Heartbeat(ctx, agentID, server, status)
+}
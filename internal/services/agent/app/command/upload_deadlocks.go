@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadDeadlocksHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadDeadlocksHandler(client domain.IngestionClient) *UploadDeadlocksHandler {
+	return &UploadDeadlocksHandler{client: client, tracer: otel.Tracer("UploadDeadlocks")}
+}
+
+func (h UploadDeadlocksHandler) Handle(ctx context.Context, deadlocks []*common_domain.Deadlock, server common_domain.ServerMeta) error {
+	return h.client.IngestDeadlocks(ctx, deadlocks, server)
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadFileSizesHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadFileSizesHandler(client domain.IngestionClient) *UploadFileSizesHandler {
+	return &UploadFileSizesHandler{client: client, tracer: otel.Tracer("UploadFileSizes")}
+}
+
+func (h UploadFileSizesHandler) Handle(ctx context.Context, stats *common_domain.FileSizeStats) error {
+	return h.client.IngestFileSizes(ctx, stats)
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadIndexStatsHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadIndexStatsHandler(client domain.IngestionClient) *UploadIndexStatsHandler {
+	return &UploadIndexStatsHandler{client: client, tracer: otel.Tracer("UploadIndexStats")}
+}
+
+func (h UploadIndexStatsHandler) Handle(ctx context.Context, stats *common_domain.IndexStats) error {
+	return h.client.IngestIndexStats(ctx, stats)
+}
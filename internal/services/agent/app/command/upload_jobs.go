@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadJobsHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadJobsHandler(client domain.IngestionClient) *UploadJobsHandler {
+	return &UploadJobsHandler{client: client, tracer: otel.Tracer("UploadJobs")}
+}
+
+func (h UploadJobsHandler) Handle(ctx context.Context, stats *common_domain.JobStats) error {
+	return h.client.IngestJobs(ctx, stats)
+}
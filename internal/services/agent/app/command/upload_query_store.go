@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadQueryStoreHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadQueryStoreHandler(client domain.IngestionClient) *UploadQueryStoreHandler {
+	return &UploadQueryStoreHandler{client: client, tracer: otel.Tracer("UploadQueryStore")}
+}
+
+func (h UploadQueryStoreHandler) Handle(ctx context.Context, stats *common_domain.QueryStoreStats) error {
+	return h.client.IngestQueryStore(ctx, stats)
+}
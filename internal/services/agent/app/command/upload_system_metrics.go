@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadSystemMetricsHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadSystemMetricsHandler(client domain.IngestionClient) *UploadSystemMetricsHandler {
+	return &UploadSystemMetricsHandler{client: client, tracer: otel.Tracer("UploadSystemMetrics")}
+}
+
+func (h UploadSystemMetricsHandler) Handle(ctx context.Context, metrics *common_domain.SystemMetrics) error {
+	return h.client.IngestSystemMetrics(ctx, metrics)
+}
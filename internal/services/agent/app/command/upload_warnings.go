@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UploadWarningsHandler struct {
+	client domain.IngestionClient
+	tracer trace.Tracer
+}
+
+func NewUploadWarningsHandler(client domain.IngestionClient) *UploadWarningsHandler {
+	return &UploadWarningsHandler{client: client, tracer: otel.Tracer("UploadWarnings")}
+}
+
+func (h UploadWarningsHandler) Handle(ctx context.Context, warnings []*common_domain.Warning, server common_domain.ServerMeta) error {
+	return h.client.IngestWarnings(ctx, warnings, server)
+}
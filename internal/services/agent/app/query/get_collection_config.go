@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetCollectionConfigHandler struct {
+	client domain.IngestionClient
+}
+
+func NewGetCollectionConfigHandler(client domain.IngestionClient) *GetCollectionConfigHandler {
+	return &GetCollectionConfigHandler{client: client}
+}
+
+func (h GetCollectionConfigHandler) Handle(ctx context.Context, agentID string, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error) {
+	return h.client.GetCollectionConfig(ctx, agentID, server)
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetKnownWarningsHandler struct {
+	client domain.IngestionClient
+}
+
+func NewGetKnownWarningsHandler(client domain.IngestionClient) *GetKnownWarningsHandler {
+	return &GetKnownWarningsHandler{client: client}
+}
+
+func (h GetKnownWarningsHandler) Handle(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error) {
+	return h.client.GetKnownWarnings(ctx, server)
+}
@@ -0,0 +1,24 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadDeadlocksHandler struct {
+	reader domain.DeadlockReader
+	tracer trace.Tracer
+}
+
+func NewReadDeadlocksHandler(reader domain.DeadlockReader) *ReadDeadlocksHandler {
+	return &ReadDeadlocksHandler{reader: reader, tracer: otel.Tracer("ReadDeadlocks")}
+}
+
+func (h ReadDeadlocksHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, since time.Time) ([]*common_domain.Deadlock, error) {
+	return h.reader.ReadDeadlocks(ctx, serverData, since)
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadFileIOStatsHandler struct {
+	reader domain.SystemMetricsReader
+	tracer trace.Tracer
+}
+
+func NewReadFileIOStatsHandler(reader domain.SystemMetricsReader) *ReadFileIOStatsHandler {
+	return &ReadFileIOStatsHandler{reader: reader, tracer: otel.Tracer("ReadFileIOStats")}
+}
+
+func (h ReadFileIOStatsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta) ([]common_domain.FileIOStat, error) {
+	return h.reader.ReadFileIOStats(ctx, serverData)
+}
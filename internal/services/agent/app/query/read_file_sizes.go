@@ -0,0 +1,24 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadFileSizesHandler struct {
+	reader domain.FileSizeReader
+	tracer trace.Tracer
+}
+
+func NewReadFileSizesHandler(reader domain.FileSizeReader) *ReadFileSizesHandler {
+	return &ReadFileSizesHandler{reader: reader, tracer: otel.Tracer("ReadFileSizes")}
+}
+
+func (h ReadFileSizesHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases common_domain.DatabaseFilter, since time.Time) (*common_domain.FileSizeStats, error) {
+	return h.reader.ReadFileSizes(ctx, serverData, databases, since)
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadIndexStatsHandler struct {
+	reader domain.IndexStatsReader
+	tracer trace.Tracer
+}
+
+func NewReadIndexStatsHandler(reader domain.IndexStatsReader) *ReadIndexStatsHandler {
+	return &ReadIndexStatsHandler{reader: reader, tracer: otel.Tracer("ReadIndexStats")}
+}
+
+func (h ReadIndexStatsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases common_domain.DatabaseFilter) (*common_domain.IndexStats, error) {
+	return h.reader.ReadIndexStats(ctx, serverData, databases)
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadJobsHandler struct {
+	reader domain.JobReader
+	tracer trace.Tracer
+}
+
+func NewReadJobsHandler(reader domain.JobReader) *ReadJobsHandler {
+	return &ReadJobsHandler{reader: reader, tracer: otel.Tracer("ReadJobs")}
+}
+
+func (h ReadJobsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, lastInstanceID int64) (*common_domain.JobStats, error) {
+	return h.reader.ReadJobs(ctx, serverData, lastInstanceID)
+}
@@ -18,6 +18,6 @@ func NewReadMetricsHandler(reader domain.QueryMetricsReader) *ReadMetricsHandler
 	return &ReadMetricsHandler{reader: reader, tracer: otel.Tracer("ReadMetrics")}
 }
 
-func (h ReadMetricsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases []string) ([]*common_domain.QueryMetric, error) {
+func (h ReadMetricsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error) {
 	return h.reader.CollectMetrics(ctx, serverData, databases)
 }
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadPerformanceCountersHandler struct {
+	reader domain.SystemMetricsReader
+	tracer trace.Tracer
+}
+
+func NewReadPerformanceCountersHandler(reader domain.SystemMetricsReader) *ReadPerformanceCountersHandler {
+	return &ReadPerformanceCountersHandler{reader: reader, tracer: otel.Tracer("ReadPerformanceCounters")}
+}
+
+func (h ReadPerformanceCountersHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta) ([]common_domain.PerformanceCounter, error) {
+	return h.reader.ReadPerformanceCounters(ctx, serverData)
+}
@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadQueryStoreHandler struct {
+	reader domain.QueryStoreReader
+	tracer trace.Tracer
+}
+
+func NewReadQueryStoreHandler(reader domain.QueryStoreReader) *ReadQueryStoreHandler {
+	return &ReadQueryStoreHandler{reader: reader, tracer: otel.Tracer("ReadQueryStore")}
+}
+
+func (h ReadQueryStoreHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases common_domain.DatabaseFilter, since map[string]common_domain.QueryStoreWatermark) (*common_domain.QueryStoreStats, error) {
+	return h.reader.ReadQueryStore(ctx, serverData, databases, since)
+}
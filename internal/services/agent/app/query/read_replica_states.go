@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadReplicaStatesHandler struct {
+	reader domain.SystemMetricsReader
+	tracer trace.Tracer
+}
+
+func NewReadReplicaStatesHandler(reader domain.SystemMetricsReader) *ReadReplicaStatesHandler {
+	return &ReadReplicaStatesHandler{reader: reader, tracer: otel.Tracer("ReadReplicaStates")}
+}
+
+func (h ReadReplicaStatesHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta) ([]common_domain.ReplicaState, error) {
+	return h.reader.ReadReplicaStates(ctx, serverData)
+}
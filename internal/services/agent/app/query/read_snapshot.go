@@ -18,6 +18,6 @@ func NewReadSnapshotHandler(reader domain.SamplesReader) *ReadSnapshotHandler {
 	return &ReadSnapshotHandler{reader: reader, tracer: otel.Tracer("ReadSnapshot")}
 }
 
-func (h ReadSnapshotHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases []string) ([]*common_domain.DataBaseSnapshot, error) {
+func (h ReadSnapshotHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error) {
 	return h.reader.TakeSnapshot(ctx, serverData, databases)
 }
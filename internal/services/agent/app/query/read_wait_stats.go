@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ReadWaitStatsHandler struct {
+	reader domain.SystemMetricsReader
+	tracer trace.Tracer
+}
+
+func NewReadWaitStatsHandler(reader domain.SystemMetricsReader) *ReadWaitStatsHandler {
+	return &ReadWaitStatsHandler{reader: reader, tracer: otel.Tracer("ReadWaitStats")}
+}
+
+func (h ReadWaitStatsHandler) Handle(ctx context.Context, serverData common_domain.ServerMeta) ([]common_domain.WaitStat, error) {
+	return h.reader.ReadWaitStats(ctx, serverData)
+}
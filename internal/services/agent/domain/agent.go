@@ -0,0 +1,11 @@
+package domain
+
+import "github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+
+// AgentRegistration is sent to the collector once per target when the agent starts
+type AgentRegistration struct {
+	AgentID      string
+	AgentVersion string
+	Server       common_domain.ServerMeta
+	Tags         []string
+}
@@ -2,14 +2,50 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
 )
 
 type SamplesReader interface {
-	TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases []string) ([]*common_domain.DataBaseSnapshot, error)
+	TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.DataBaseSnapshot, error)
 	GetPlanHandles(ctx context.Context, handles []string, server common_domain.ServerMeta) (map[string]*common_domain.ExecutionPlan, error)
 }
 type QueryMetricsReader interface {
-	CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases []string) ([]*common_domain.QueryMetric, error)
+	CollectMetrics(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) ([]*common_domain.QueryMetric, error)
+}
+
+type DeadlockReader interface {
+	ReadDeadlocks(ctx context.Context, server common_domain.ServerMeta, since time.Time) ([]*common_domain.Deadlock, error)
+}
+
+// IndexStatsReader reads the index usage and the missing index suggestions of the databases of a target
+type IndexStatsReader interface {
+	ReadIndexStats(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (*common_domain.IndexStats, error)
+}
+
+// QueryStoreReader reads the query store of the databases of a target since the watermark of each database, the
+// stats carry the watermarks of the next read. Databases without a watermark are read from the start of the retention.
+type QueryStoreReader interface {
+	ReadQueryStore(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, since map[string]common_domain.QueryStoreWatermark) (*common_domain.QueryStoreStats, error)
+}
+
+// JobReader reads the SQL Agent jobs of a target, the runs logged after the job history entry lastInstanceID and the
+// runs in progress. The first read, lastInstanceID 0, goes back 30 days.
+type JobReader interface {
+	ReadJobs(ctx context.Context, server common_domain.ServerMeta, lastInstanceID int64) (*common_domain.JobStats, error)
+}
+
+// FileSizeReader reads the database file sizes of a target and the autogrowth events started after since
+type FileSizeReader interface {
+	ReadFileSizes(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, since time.Time) (*common_domain.FileSizeStats, error)
+}
+
+// SystemMetricsReader reads the server wide metrics of a target, readings are deltas since the previous call but for
+// the replica states which are read as they are
+type SystemMetricsReader interface {
+	ReadWaitStats(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.WaitStat, error)
+	ReadPerformanceCounters(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.PerformanceCounter, error)
+	ReadFileIOStats(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.FileIOStat, error)
+	ReadReplicaStates(ctx context.Context, server common_domain.ServerMeta) ([]common_domain.ReplicaState, error)
 }
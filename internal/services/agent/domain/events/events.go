@@ -26,6 +26,7 @@ func (e SampleSnapshotTaken) Context() context.Context {
 
 type MetricsSnapshotTaken struct {
 	Metrics []*common_domain.QueryMetric
+	Server  common_domain.ServerMeta
 	Ctx     context.Context
 }
 
@@ -37,6 +38,20 @@ func (e MetricsSnapshotTaken) Context() context.Context {
 	return e.Ctx
 }
 
+// SystemMetricsTaken is routed once the system metrics of an interval are uploaded
+type SystemMetricsTaken struct {
+	Metrics *common_domain.SystemMetrics
+	Ctx     context.Context
+}
+
+func (e SystemMetricsTaken) EventName() string {
+	return "SystemMetricsTaken"
+}
+
+func (e SystemMetricsTaken) Context() context.Context {
+	return e.Ctx
+}
+
 type ExecutionPlanFetched struct {
 	Plan *common_domain.ExecutionPlan
 }
@@ -49,6 +64,34 @@ func (e ExecutionPlanFetched) Context() context.Context {
 	return context.Background()
 }
 
+// CollectionFailed is routed when a collector run fails, Source names the collector
+type CollectionFailed struct {
+	Server common_domain.ServerMeta
+	Source string
+	Err    error
+}
+
+func (e CollectionFailed) EventName() string {
+	return "CollectionFailed"
+}
+
+func (e CollectionFailed) Context() context.Context {
+	return context.Background()
+}
+
+// CollectionConfigApplied is routed when the collectors of a target start with a new config
+type CollectionConfigApplied struct {
+	Config common_domain.CollectionConfig
+}
+
+func (e CollectionConfigApplied) EventName() string {
+	return "CollectionConfigApplied"
+}
+
+func (e CollectionConfigApplied) Context() context.Context {
+	return context.Background()
+}
+
 type WarningDetected struct {
 	Warning *common_domain.Warning
 }
@@ -8,7 +8,18 @@ import (
 
 type IngestionClient interface {
 	IngestMetrics(ctx context.Context, metrics []*common_domain.QueryMetric, server common_domain.ServerMeta, timestamp time.Time) error
+	IngestSystemMetrics(ctx context.Context, metrics *common_domain.SystemMetrics) error
+	IngestIndexStats(ctx context.Context, stats *common_domain.IndexStats) error
+	IngestQueryStore(ctx context.Context, stats *common_domain.QueryStoreStats) error
+	IngestJobs(ctx context.Context, stats *common_domain.JobStats) error
+	IngestFileSizes(ctx context.Context, stats *common_domain.FileSizeStats) error
 	IngestSnapshot(ctx context.Context, snapshot *common_domain.DataBaseSnapshot) error
 	IngestExecPlans(ctx context.Context, executionPlans map[string]*common_domain.ExecutionPlan, server common_domain.ServerMeta) error
 	GetKnownPlanHandles(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error)
+	IngestDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, server common_domain.ServerMeta) error
+	IngestWarnings(ctx context.Context, warnings []*common_domain.Warning, server common_domain.ServerMeta) error
+	GetKnownWarnings(ctx context.Context, server common_domain.ServerMeta) (map[string]struct{}, error)
+	RegisterAgent(ctx context.Context, registration AgentRegistration) error
+	Heartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus) error
+	GetCollectionConfig(ctx context.Context, agentID string, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error)
 }
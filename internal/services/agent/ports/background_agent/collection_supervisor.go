@@ -0,0 +1,232 @@
+package background_agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReaderSupport tells which optional collectors the reader of a target supports
+type ReaderSupport struct {
+	Deadlocks bool
+	// SystemMetrics runs the system metrics collector with the query metrics
+	SystemMetrics bool
+	IndexStats    bool
+	QueryStore    bool
+	Jobs          bool
+	FileSizes     bool
+}
+
+// CollectionSupervisor runs the collectors of a target with the collection config managed on the collector.
+// The config is polled, the collectors are restarted when it changes and the local config is used when none is managed.
+type CollectionSupervisor struct {
+	app     app.Application
+	tracer  trace.Tracer
+	agentID string
+	local   common_domain.CollectionConfig
+	support ReaderSupport
+
+	snapshots     *SnapshotCollector
+	metrics       *MetricsCollector
+	deadlocks     *DeadlockCollector
+	systemMetrics *SystemMetricsCollector
+	indexStats    *IndexStatsCollector
+	queryStore    *QueryStoreCollector
+	jobs          *JobCollector
+	fileSizes     *FileSizeCollector
+
+	current common_domain.CollectionConfig
+	started bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func NewCollectionSupervisor(app app.Application, agentID string, local common_domain.CollectionConfig, support ReaderSupport) *CollectionSupervisor {
+	local.CollectDeadlocks = local.CollectDeadlocks && support.Deadlocks
+	return &CollectionSupervisor{
+		app:           app,
+		tracer:        otel.Tracer("CollectionSupervisor"),
+		agentID:       agentID,
+		local:         local,
+		support:       support,
+		snapshots:     NewSnapshotCollector(app),
+		metrics:       NewMetricsCollector(app),
+		deadlocks:     NewDeadlockCollector(app),
+		systemMetrics: NewSystemMetricsCollector(app),
+		indexStats:    NewIndexStatsCollector(app),
+		queryStore:    NewQueryStoreCollector(app),
+		jobs:          NewJobCollector(app),
+		fileSizes:     NewFileSizeCollector(app),
+	}
+}
+
+// Apply restarts the collectors when the config differs from the one they run with
+func (s *CollectionSupervisor) Apply(ctx context.Context, config common_domain.CollectionConfig) {
+	if s.started && sameCollectionConfig(s.current, config) {
+		return
+	}
+	s.stop()
+	fmt.Printf("collecting %s every %s (metrics %t every %s, deadlocks %t every %s, index stats every %s, query store every %s, jobs every %s, file sizes every %s, plans %t, lock metrics %t, databases %v)\n",
+		config.Server.Host, config.SnapshotInterval, config.CollectMetrics, config.MetricsInterval, config.CollectDeadlocks,
+		config.DeadlockInterval, config.IndexStatsInterval, config.QueryStoreInterval, config.JobInterval,
+		config.FileSizeInterval, config.FetchPlans, config.CollectLockMetrics, config.Databases)
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.current = config
+	s.started = true
+	s.app.EventRouter.Route(events.CollectionConfigApplied{Config: config})
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.snapshots.Run(runCtx, config.Server, config.Databases, config.SnapshotInterval)
+	}()
+	if config.CollectMetrics {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.metrics.Run(runCtx, config.Server, config.Databases, config.MetricsInterval)
+		}()
+	}
+	if config.CollectMetrics && s.support.SystemMetrics {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.systemMetrics.Run(runCtx, config.Server, config.MetricsInterval)
+		}()
+	}
+	if config.CollectMetrics && s.support.IndexStats {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.indexStats.Run(runCtx, config.Server, config.Databases, config.IndexStatsInterval)
+		}()
+	}
+	if config.CollectMetrics && s.support.QueryStore {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.queryStore.Run(runCtx, config.Server, config.Databases, config.QueryStoreInterval)
+		}()
+	}
+	if config.CollectMetrics && s.support.Jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.jobs.Run(runCtx, config.Server, config.JobInterval)
+		}()
+	}
+	if config.CollectMetrics && s.support.FileSizes {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.fileSizes.Run(runCtx, config.Server, config.Databases, config.FileSizeInterval)
+		}()
+	}
+	if config.CollectDeadlocks {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.deadlocks.Run(runCtx, config.Server, config.DeadlockInterval)
+		}()
+	}
+}
+
+// stop waits for the running collectors, the deadlock collector keeps its position across restarts
+func (s *CollectionSupervisor) stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// Refresh fetches the managed config and applies it, the current config is kept when the collector is unreachable
+func (s *CollectionSupervisor) Refresh(ctx context.Context) error {
+	ctx, span := s.tracer.Start(ctx, "RefreshCollectionConfig")
+	defer span.End()
+	managed, err := s.app.Queries.GetCollectionConfig.Handle(ctx, s.agentID, s.local.Server)
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			s.Apply(ctx, s.local)
+			return nil
+		}
+		span.RecordError(err)
+		if !s.started {
+			s.Apply(ctx, s.local)
+		}
+		return fmt.Errorf("getting collection config: %w", err)
+	}
+	s.Apply(ctx, mergeCollectionConfig(s.local, *managed, s.support.Deadlocks))
+	return nil
+}
+
+func (s *CollectionSupervisor) Run(ctx context.Context, pollInterval time.Duration) {
+	t := time.NewTicker(pollInterval)
+	defer s.stop()
+	for {
+		err := s.Refresh(ctx)
+		if err != nil {
+			fmt.Printf("collection config %s: %s\n", s.local.Server.Host, err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// mergeCollectionConfig fills what the managed config leaves unset with the local config
+func mergeCollectionConfig(local common_domain.CollectionConfig, managed common_domain.CollectionConfig, deadlocksSupported bool) common_domain.CollectionConfig {
+	merged := managed
+	merged.Server = local.Server
+	if merged.SnapshotInterval <= 0 {
+		merged.SnapshotInterval = local.SnapshotInterval
+	}
+	if merged.MetricsInterval <= 0 {
+		merged.MetricsInterval = local.MetricsInterval
+	}
+	if merged.DeadlockInterval <= 0 {
+		merged.DeadlockInterval = local.DeadlockInterval
+	}
+	if merged.IndexStatsInterval <= 0 {
+		merged.IndexStatsInterval = local.IndexStatsInterval
+	}
+	if merged.QueryStoreInterval <= 0 {
+		merged.QueryStoreInterval = local.QueryStoreInterval
+	}
+	if merged.JobInterval <= 0 {
+		merged.JobInterval = local.JobInterval
+	}
+	if merged.FileSizeInterval <= 0 {
+		merged.FileSizeInterval = local.FileSizeInterval
+	}
+	merged.CollectDeadlocks = merged.CollectDeadlocks && deadlocksSupported
+	return merged
+}
+
+func sameCollectionConfig(a common_domain.CollectionConfig, b common_domain.CollectionConfig) bool {
+	return a.Server == b.Server &&
+		a.SnapshotInterval == b.SnapshotInterval &&
+		a.MetricsInterval == b.MetricsInterval &&
+		a.DeadlockInterval == b.DeadlockInterval &&
+		a.IndexStatsInterval == b.IndexStatsInterval &&
+		a.QueryStoreInterval == b.QueryStoreInterval &&
+		a.JobInterval == b.JobInterval &&
+		a.FileSizeInterval == b.FileSizeInterval &&
+		slices.Equal(a.Databases.Include, b.Databases.Include) &&
+		slices.Equal(a.Databases.Exclude, b.Databases.Exclude) &&
+		a.CollectMetrics == b.CollectMetrics &&
+		a.CollectDeadlocks == b.CollectDeadlocks &&
+		a.FetchPlans == b.FetchPlans &&
+		a.CollectLockMetrics == b.CollectLockMetrics
+}
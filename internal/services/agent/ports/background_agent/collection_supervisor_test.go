@@ -0,0 +1,58 @@
+package background_agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeCollectionConfig(t *testing.T) {
+	local := common_domain.CollectionConfig{
+		Server:             common_domain.ServerMeta{Host: "sql01", Type: "mssql"},
+		SnapshotInterval:   10 * time.Second,
+		MetricsInterval:    time.Minute,
+		DeadlockInterval:   time.Minute,
+		IndexStatsInterval: time.Hour,
+		QueryStoreInterval: 15 * time.Minute,
+		JobInterval:        5 * time.Minute,
+		FileSizeInterval:   time.Hour,
+		Databases:          common_domain.DatabaseFilter{Include: []string{"local"}, Exclude: []string{"tempdb"}},
+		CollectMetrics:     true,
+		CollectDeadlocks:   true,
+	}
+	managed := common_domain.CollectionConfig{
+		Server:             common_domain.ServerMeta{Host: "sql01"},
+		SnapshotInterval:   30 * time.Second,
+		QueryStoreInterval: time.Hour,
+		Databases:          common_domain.DatabaseFilter{Include: []string{"orders_*"}},
+		CollectMetrics:     false,
+		CollectDeadlocks:   true,
+		UpdatedAt:          time.Now(),
+	}
+
+	merged := mergeCollectionConfig(local, managed, true)
+	assert.Equal(t, local.Server, merged.Server)
+	assert.Equal(t, 30*time.Second, merged.SnapshotInterval)
+	assert.Equal(t, time.Minute, merged.MetricsInterval)
+	assert.Equal(t, time.Minute, merged.DeadlockInterval)
+	assert.Equal(t, time.Hour, merged.IndexStatsInterval)
+	assert.Equal(t, time.Hour, merged.QueryStoreInterval)
+	assert.Equal(t, 5*time.Minute, merged.JobInterval)
+	assert.Equal(t, time.Hour, merged.FileSizeInterval)
+	assert.Equal(t, common_domain.DatabaseFilter{Include: []string{"orders_*"}}, merged.Databases)
+	assert.False(t, merged.CollectMetrics)
+	assert.True(t, merged.CollectDeadlocks)
+
+	assert.False(t, mergeCollectionConfig(local, managed, false).CollectDeadlocks)
+}
+
+func TestSameCollectionConfig(t *testing.T) {
+	a := common_domain.CollectionConfig{SnapshotInterval: 10 * time.Second, Databases: common_domain.DatabaseFilter{Include: []string{"orders"}}, UpdatedAt: time.Now()}
+	b := a
+	b.UpdatedAt = a.UpdatedAt.Add(time.Hour)
+	assert.True(t, sameCollectionConfig(a, b))
+	b.Databases.Exclude = []string{"tempdb"}
+	assert.False(t, sameCollectionConfig(a, b))
+}
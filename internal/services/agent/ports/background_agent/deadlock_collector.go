@@ -0,0 +1,72 @@
+package background_agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeadlockCollector ships the deadlocks reported since its last run.
+// On start it ships everything the target still holds, the collector ignores deadlocks it already has.
+type DeadlockCollector struct {
+	app    app.Application
+	tracer trace.Tracer
+	since  time.Time
+}
+
+func NewDeadlockCollector(app app.Application) *DeadlockCollector {
+	return &DeadlockCollector{app: app, tracer: otel.Tracer("DeadlockCollector")}
+}
+
+func (d *DeadlockCollector) CollectDeadlocks(ctx context.Context, server common_domain.ServerMeta) (err error) {
+	ctx, span := d.tracer.Start(ctx, "CollectDeadlocks")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	deadlocks, err := d.app.Queries.ReadDeadlocks.Handle(ctx, server, d.since)
+	if err != nil {
+		return fmt.Errorf("reading deadlocks: %w", err)
+	}
+	if len(deadlocks) == 0 {
+		return nil
+	}
+	err = d.app.Commands.UploadDeadlocks.Handle(ctx, deadlocks, server)
+	if err != nil {
+		return fmt.Errorf("uploading deadlocks: %w", err)
+	}
+	for _, deadlock := range deadlocks {
+		if deadlock.Timestamp.After(d.since) {
+			d.since = deadlock.Timestamp
+		}
+	}
+	return nil
+}
+
+func (d *DeadlockCollector) Run(ctx context.Context, server common_domain.ServerMeta, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := d.CollectDeadlocks(ctx, server)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("collecting deadlocks %s: %s\n", server.Host, err.Error())
+			d.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "deadlocks", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			break
+
+		}
+	}
+}
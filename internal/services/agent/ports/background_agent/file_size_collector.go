@@ -0,0 +1,65 @@
+package background_agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FileSizeCollector ships the database file sizes and the autogrowth events of a target on the file size interval,
+// the events are read again after a failed upload
+type FileSizeCollector struct {
+	app             app.Application
+	tracer          trace.Tracer
+	lastGrowthEvent time.Time
+}
+
+func NewFileSizeCollector(app app.Application) *FileSizeCollector {
+	return &FileSizeCollector{app: app, tracer: otel.Tracer("FileSizeCollector")}
+}
+
+func (c *FileSizeCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (err error) {
+	ctx, span := c.tracer.Start(ctx, "FileSizeSnapshot")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	stats, err := c.app.Queries.ReadFileSizes.Handle(ctx, server, databases, c.lastGrowthEvent)
+	if err != nil {
+		return fmt.Errorf("reading file sizes: %w", err)
+	}
+	if len(stats.Files) > 0 || len(stats.GrowthEvents) > 0 {
+		err = c.app.Commands.UploadFileSizes.Handle(ctx, stats)
+		if err != nil {
+			return fmt.Errorf("uploading file sizes: %w", err)
+		}
+	}
+	c.lastGrowthEvent = stats.LastGrowthEvent
+	return stats.Failed.Err()
+}
+
+func (c *FileSizeCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := c.TakeSnapshot(ctx, server, databases)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("reading file sizes %s: %s\n", server.Host, err.Error())
+			c.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "file_sizes", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
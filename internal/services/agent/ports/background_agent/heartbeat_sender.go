@@ -0,0 +1,123 @@
+package background_agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// heartbeatTimeout bounds the registration and the heartbeat of a run
+const heartbeatTimeout = 30 * time.Second
+
+// HeartbeatSender registers the agent for a target and reports the target collection status periodically.
+// The status is built from the events routed by the collectors of the target.
+type HeartbeatSender struct {
+	app          app.Application
+	tracer       trace.Tracer
+	in           chan events.Event
+	registration domain.AgentRegistration
+	registered   bool
+
+	mu     sync.Mutex
+	status common_domain.CollectionStatus
+}
+
+func NewHeartbeatSender(app app.Application, registration domain.AgentRegistration) *HeartbeatSender {
+	return &HeartbeatSender{
+		app:          app,
+		tracer:       otel.Tracer("HeartbeatSender"),
+		in:           make(chan events.Event, 200),
+		registration: registration,
+	}
+}
+
+func (h *HeartbeatSender) Register(router *events.EventRouter) {
+	router.Register(events.SampleSnapshotTaken{}.EventName(), h.in, "heartbeatSender")
+	router.Register(events.MetricsSnapshotTaken{}.EventName(), h.in, "heartbeatSender")
+	router.Register(events.CollectionFailed{}.EventName(), h.in, "heartbeatSender")
+}
+
+func (h *HeartbeatSender) track(ev events.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	switch e := ev.(type) {
+	case events.SampleSnapshotTaken:
+		h.status.LastSnapshot = e.Snap.SnapInfo.Timestamp
+	case events.MetricsSnapshotTaken:
+		h.status.LastMetrics = time.Now()
+	case events.CollectionFailed:
+		h.status.LastError = fmt.Sprintf("%s: %s", e.Source, e.Err.Error())
+		h.status.LastErrorTime = time.Now()
+	}
+}
+
+func (h *HeartbeatSender) SendHeartbeat(ctx context.Context) (err error) {
+	ctx, span := h.tracer.Start(ctx, "SendHeartbeat")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	if !h.registered {
+		err = h.app.Commands.RegisterAgent.Handle(ctx, h.registration)
+		if err != nil {
+			return fmt.Errorf("registering agent: %w", err)
+		}
+		h.registered = true
+	}
+	h.mu.Lock()
+	collectionStatus := h.status
+	h.mu.Unlock()
+	err = h.app.Commands.SendHeartbeat.Handle(ctx, h.registration.AgentID, h.registration.Server, collectionStatus)
+	if err != nil {
+		// the collector lost the registration (e.g. the database was recreated), register again on the next run
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			h.registered = false
+		}
+		return fmt.Errorf("sending heartbeat: %w", err)
+	}
+	return nil
+}
+
+func (h *HeartbeatSender) Run(ctx context.Context, interval time.Duration) {
+	// the events are tracked on their own so a collector that does not answer does not back up the router
+	go h.trackEvents(ctx)
+	t := time.NewTicker(interval)
+	for {
+		sendCtx, cancel := context.WithTimeout(ctx, heartbeatTimeout)
+		err := h.SendHeartbeat(sendCtx)
+		cancel()
+		if err != nil {
+			fmt.Printf("heartbeat %s: %s\n", h.registration.Server.Host, err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (h *HeartbeatSender) trackEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-h.in:
+			h.track(ev)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package background_agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// IndexStatsCollector ships the index usage and missing index suggestions of a target, the DMVs are read on a slow
+// schedule since they walk the catalog of every database
+type IndexStatsCollector struct {
+	app    app.Application
+	tracer trace.Tracer
+}
+
+func NewIndexStatsCollector(app app.Application) *IndexStatsCollector {
+	return &IndexStatsCollector{app: app, tracer: otel.Tracer("IndexStatsCollector")}
+}
+
+func (c IndexStatsCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (err error) {
+	ctx, span := c.tracer.Start(ctx, "IndexStatsSnapshot")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	stats, err := c.app.Queries.ReadIndexStats.Handle(ctx, server, databases)
+	if err != nil {
+		return fmt.Errorf("reading index stats: %w", err)
+	}
+	if len(stats.Indexes) > 0 || len(stats.MissingIndexes) > 0 {
+		err = c.app.Commands.UploadIndexStats.Handle(ctx, stats)
+		if err != nil {
+			return fmt.Errorf("uploading index stats: %w", err)
+		}
+	}
+	return stats.Failed.Err()
+}
+
+func (c IndexStatsCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := c.TakeSnapshot(ctx, server, databases)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("taking index stats %s: %s\n", server.Host, err.Error())
+			c.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "index_stats", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package background_agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// JobCollector ships the SQL Agent job runs finished since the previous upload and the runs in progress, the job
+// history is read again after a failed upload
+type JobCollector struct {
+	app            app.Application
+	tracer         trace.Tracer
+	lastInstanceID int64
+}
+
+func NewJobCollector(app app.Application) *JobCollector {
+	return &JobCollector{app: app, tracer: otel.Tracer("JobCollector")}
+}
+
+func (c *JobCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta) (err error) {
+	ctx, span := c.tracer.Start(ctx, "JobSnapshot")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	stats, err := c.app.Queries.ReadJobs.Handle(ctx, server, c.lastInstanceID)
+	if err != nil {
+		return fmt.Errorf("reading jobs: %w", err)
+	}
+	if len(stats.Runs) > 0 {
+		err = c.app.Commands.UploadJobs.Handle(ctx, stats)
+		if err != nil {
+			return fmt.Errorf("uploading jobs: %w", err)
+		}
+	}
+	c.lastInstanceID = stats.LastInstanceID
+	return nil
+}
+
+func (c *JobCollector) Run(ctx context.Context, server common_domain.ServerMeta, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := c.TakeSnapshot(ctx, server)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("reading jobs %s: %s\n", server.Host, err.Error())
+			c.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "jobs", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -22,7 +22,7 @@ func NewMetricsCollector(app app.Application) *MetricsCollector {
 	return &MetricsCollector{app: app, tracer: otel.Tracer("MetricsCollector")}
 }
 
-func (m MetricsCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases []string) (err error) {
+func (m MetricsCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (err error) {
 	ctx, span := m.tracer.Start(ctx, "MetricsSnapshot")
 	defer func() {
 		if err != nil {
@@ -40,16 +40,17 @@ func (m MetricsCollector) TakeSnapshot(ctx context.Context, server common_domain
 	if err != nil {
 		return fmt.Errorf("uploading metrics: %w", err)
 	}
-	m.app.EventRouter.Route(events.MetricsSnapshotTaken{Metrics: metrics, Ctx: ctx})
+	m.app.EventRouter.Route(events.MetricsSnapshotTaken{Metrics: metrics, Server: server, Ctx: ctx})
 	return nil
 }
 
-func (m MetricsCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases []string, interval time.Duration) {
+func (m MetricsCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, interval time.Duration) {
 	t := time.NewTicker(interval)
 	for {
 		err := m.TakeSnapshot(ctx, server, databases)
-		if err != nil {
+		if err != nil && ctx.Err() == nil {
 			fmt.Printf("taking snapshot %s: %s\n", server.Host, err.Error())
+			m.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "metrics", Err: err})
 		}
 		select {
 		case <-ctx.Done():
@@ -0,0 +1,74 @@
+package background_agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// queryStoreChunkBytes bounds the size of a query store upload
+const queryStoreChunkBytes = 1 << 20
+
+// QueryStoreCollector ships the plans and runtime stats the query store of each database recorded since the previous
+// upload, databases without the query store enabled are skipped by the reader. The watermarks only move once every
+// chunk of a read is uploaded, a failed upload is read and sent again.
+type QueryStoreCollector struct {
+	app        app.Application
+	tracer     trace.Tracer
+	watermarks map[string]common_domain.QueryStoreWatermark
+}
+
+func NewQueryStoreCollector(app app.Application) *QueryStoreCollector {
+	return &QueryStoreCollector{app: app, tracer: otel.Tracer("QueryStoreCollector"),
+		watermarks: make(map[string]common_domain.QueryStoreWatermark)}
+}
+
+func (c *QueryStoreCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (err error) {
+	ctx, span := c.tracer.Start(ctx, "QueryStoreSnapshot")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	stats, err := c.app.Queries.ReadQueryStore.Handle(ctx, server, databases, c.watermarks)
+	if err != nil {
+		return fmt.Errorf("reading query store: %w", err)
+	}
+	if len(stats.Plans) > 0 || len(stats.RuntimeStats) > 0 {
+		for _, chunk := range stats.Split(queryStoreChunkBytes) {
+			err = c.app.Commands.UploadQueryStore.Handle(ctx, chunk)
+			if err != nil {
+				return fmt.Errorf("uploading query store: %w", err)
+			}
+		}
+	}
+	for name, watermark := range stats.Watermarks {
+		c.watermarks[name] = watermark
+	}
+	return stats.Failed.Err()
+}
+
+func (c *QueryStoreCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := c.TakeSnapshot(ctx, server, databases)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("reading query store %s: %s\n", server.Host, err.Error())
+			c.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "query_store", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
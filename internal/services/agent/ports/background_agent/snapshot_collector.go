@@ -22,7 +22,7 @@ func NewSnapshotCollector(app app.Application) *SnapshotCollector {
 	return &SnapshotCollector{app: app, tracer: otel.Tracer("SnapshotCollector")}
 }
 
-func (m SnapshotCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases []string) (err error) {
+func (m SnapshotCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter) (err error) {
 	ctx, span := m.tracer.Start(ctx, "SamplesSnapshot")
 	defer func() {
 		if err != nil {
@@ -45,12 +45,13 @@ func (m SnapshotCollector) TakeSnapshot(ctx context.Context, server common_domai
 	}
 	return nil
 }
-func (s SnapshotCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases []string, interval time.Duration) {
+func (s SnapshotCollector) Run(ctx context.Context, server common_domain.ServerMeta, databases common_domain.DatabaseFilter, interval time.Duration) {
 	t := time.NewTicker(interval)
 	for {
 		err := s.TakeSnapshot(ctx, server, databases)
-		if err != nil {
+		if err != nil && ctx.Err() == nil {
 			fmt.Printf("taking snapshot %s: %s\n", server.Host, err.Error())
+			s.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "snapshot", Err: err})
 		}
 		select {
 		case <-ctx.Done():
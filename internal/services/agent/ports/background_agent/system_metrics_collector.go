@@ -0,0 +1,92 @@
+package background_agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SystemMetricsCollector ships the server wide metrics (wait stats, performance counters, file I/O, availability group
+// replica states) of a target on the metrics interval
+type SystemMetricsCollector struct {
+	app    app.Application
+	tracer trace.Tracer
+}
+
+func NewSystemMetricsCollector(app app.Application) *SystemMetricsCollector {
+	return &SystemMetricsCollector{app: app, tracer: otel.Tracer("SystemMetricsCollector")}
+}
+
+func (m SystemMetricsCollector) TakeSnapshot(ctx context.Context, server common_domain.ServerMeta) (err error) {
+	ctx, span := m.tracer.Start(ctx, "SystemMetricsSnapshot")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+	sampleTime := time.Now()
+	// the sources are read independently, the wait stats and counters baselines moved once read and a failing source
+	// (no permission on the file stats, no availability group) must not lose the deltas of the others
+	var readErr error
+	waitStats, err := m.app.Queries.ReadWaitStats.Handle(ctx, server)
+	if err != nil {
+		readErr = errors.Join(readErr, fmt.Errorf("reading wait stats: %w", err))
+	}
+	counters, err := m.app.Queries.ReadCounters.Handle(ctx, server)
+	if err != nil {
+		readErr = errors.Join(readErr, fmt.Errorf("reading performance counters: %w", err))
+	}
+	fileIO, err := m.app.Queries.ReadFileIOStats.Handle(ctx, server)
+	if err != nil {
+		readErr = errors.Join(readErr, fmt.Errorf("reading file io stats: %w", err))
+	}
+	replicas, err := m.app.Queries.ReadReplicaStates.Handle(ctx, server)
+	if err != nil {
+		readErr = errors.Join(readErr, fmt.Errorf("reading replica states: %w", err))
+	}
+	if len(waitStats) == 0 && len(counters) == 0 && len(fileIO) == 0 && len(replicas) == 0 {
+		return readErr
+	}
+	metrics := &common_domain.SystemMetrics{
+		Server:    server,
+		Timestamp: sampleTime,
+		WaitStats: waitStats,
+		Counters:  counters,
+		FileIO:    fileIO,
+		Replicas:  replicas,
+	}
+	err = m.app.Commands.UploadSystemMetrics.Handle(ctx, metrics)
+	if err != nil {
+		return fmt.Errorf("uploading system metrics: %w", err)
+	}
+	m.app.EventRouter.Route(events.SystemMetricsTaken{Metrics: metrics, Ctx: ctx})
+	return readErr
+}
+
+func (m SystemMetricsCollector) Run(ctx context.Context, server common_domain.ServerMeta, interval time.Duration) {
+	t := time.NewTicker(interval)
+	for {
+		err := m.TakeSnapshot(ctx, server)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("taking system metrics %s: %s\n", server.Host, err.Error())
+			m.app.EventRouter.Route(events.CollectionFailed{Server: server, Source: "system_metrics", Err: err})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			break
+
+		}
+	}
+}
@@ -33,6 +33,7 @@ type MetricsDetector struct {
 	lockThresholdSeconds float64
 	metricsCollector     MetricsCollector
 	sqlParser            SQLParser
+	enabled              bool
 }
 
 func NewMetricsDetector(app *app.Application, metricsCollector MetricsCollector, sqlParser SQLParser) *MetricsDetector {
@@ -43,13 +44,18 @@ func NewMetricsDetector(app *app.Application, metricsCollector MetricsCollector,
 		knownHandlesByServer: make(map[string]map[string]struct{}),
 		metricsCollector:     metricsCollector,
 		sqlParser:            sqlParser,
+		enabled:              true,
 	}
 }
 
-func (f MetricsDetector) Run() {
+func (f *MetricsDetector) Run() {
 	for ev := range f.in {
+		if configApplied, ok := ev.(events.CollectionConfigApplied); ok {
+			f.enabled = configApplied.Config.CollectLockMetrics
+			continue
+		}
 		snapTakenEvent, ok := ev.(events.SampleSnapshotTaken)
-		if !ok {
+		if !ok || !f.enabled {
 			continue
 		}
 		_, span := f.trace.Start(ev.Context(), "ExtractMetricsFromSnap")
@@ -59,8 +65,9 @@ func (f MetricsDetector) Run() {
 	}
 }
 
-func (f MetricsDetector) Register(router *events.EventRouter) {
+func (f *MetricsDetector) Register(router *events.EventRouter) {
 	router.Register(events.SampleSnapshotTaken{}.EventName(), f.in, "lockDetector")
+	router.Register(events.CollectionConfigApplied{}.EventName(), f.in, "lockDetector")
 }
 
 // processSnapshot extracts metrics from a database snapshot
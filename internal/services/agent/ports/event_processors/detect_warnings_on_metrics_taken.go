@@ -0,0 +1,97 @@
+package event_processors
+
+import (
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryStatWarningThresholds are checked against the counter deltas of each metrics interval, a zero threshold is disabled
+type QueryStatWarningThresholds struct {
+	SpillsPerExecution       int64
+	SpillsPerInterval        int64
+	LogicalReadsPerExecution int64
+	LogicalReadsPerInterval  int64
+}
+
+// QueryStatWarningDetector emits MemorySpill and LargeRead warnings for queries exceeding the thresholds in a metrics snapshot
+type QueryStatWarningDetector struct {
+	app        *app.Application
+	in         chan events.Event
+	trace      trace.Tracer
+	thresholds QueryStatWarningThresholds
+}
+
+func NewQueryStatWarningDetector(app *app.Application, thresholds QueryStatWarningThresholds) *QueryStatWarningDetector {
+	return &QueryStatWarningDetector{
+		app:        app,
+		in:         make(chan events.Event, 200),
+		trace:      otel.Tracer("QueryStatWarningDetector"),
+		thresholds: thresholds,
+	}
+}
+
+func (d *QueryStatWarningDetector) Run() {
+	for ev := range d.in {
+		metricsTakenEvent, ok := ev.(events.MetricsSnapshotTaken)
+		if !ok {
+			continue
+		}
+		_, span := d.trace.Start(ev.Context(), "DetectWarningsOnMetricsTaken")
+		for _, warning := range d.detect(metricsTakenEvent.Metrics, metricsTakenEvent.Server) {
+			d.app.EventRouter.Route(events.WarningDetected{Warning: warning})
+		}
+		span.End()
+	}
+}
+
+func (d *QueryStatWarningDetector) Register(router *events.EventRouter) {
+	router.Register(events.MetricsSnapshotTaken{}.EventName(), d.in, "queryStatWarningDetector")
+}
+
+func (d *QueryStatWarningDetector) detect(metrics []*common_domain.QueryMetric, server common_domain.ServerMeta) []*common_domain.Warning {
+	serverMeta := &dbmv1.ServerMetadata{Host: server.Host, Type: server.Type}
+	warnings := make([]*common_domain.Warning, 0)
+	for _, metric := range metrics {
+		if metric.QueryHash == "" {
+			continue
+		}
+		executions := metric.Counters["executionCount"]
+		if exceeds(metric.Counters["totalSpills"], executions, d.thresholds.SpillsPerExecution, d.thresholds.SpillsPerInterval) {
+			warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+				Id:     "memory_spill_" + metric.QueryHash,
+				Server: serverMeta,
+				Type: &dbmv1.Warning_Query{Query: &dbmv1.QueryStatWarning{
+					Warning: &dbmv1.QueryStatWarning_MemorySpill{MemorySpill: &dbmv1.MemorySpill{QueryHash: metric.QueryHash}},
+				}},
+			}))
+		}
+		if exceeds(metric.Counters["totalLogicalReads"], executions, d.thresholds.LogicalReadsPerExecution, d.thresholds.LogicalReadsPerInterval) {
+			warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+				Id:     "large_read_" + metric.QueryHash,
+				Server: serverMeta,
+				Type: &dbmv1.Warning_Query{Query: &dbmv1.QueryStatWarning{
+					Warning: &dbmv1.QueryStatWarning_LargeRead{LargeRead: &dbmv1.LargeRead{
+						QueryText: metric.Text,
+						QueryHash: metric.QueryHash,
+					}},
+				}},
+			}))
+		}
+	}
+	return warnings
+}
+
+// exceeds checks an interval delta against the per execution and per interval thresholds
+func exceeds(delta int64, executions int64, perExecution int64, perInterval int64) bool {
+	if delta <= 0 {
+		return false
+	}
+	if perInterval > 0 && delta >= perInterval {
+		return true
+	}
+	return perExecution > 0 && executions > 0 && delta/executions >= perExecution
+}
@@ -0,0 +1,60 @@
+package event_processors
+
+import (
+	"testing"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryStatWarningDetector_Detect(t *testing.T) {
+	thresholds := QueryStatWarningThresholds{
+		SpillsPerExecution:       100,
+		SpillsPerInterval:        10000,
+		LogicalReadsPerExecution: 1000,
+	}
+	tests := []struct {
+		name        string
+		counters    map[string]int64
+		expectedIds []string
+	}{
+		{
+			name:        "below thresholds",
+			counters:    map[string]int64{"executionCount": 10, "totalSpills": 500, "totalLogicalReads": 5000},
+			expectedIds: []string{},
+		},
+		{
+			name:        "spills per execution",
+			counters:    map[string]int64{"executionCount": 2, "totalSpills": 300},
+			expectedIds: []string{"memory_spill_hash"},
+		},
+		{
+			name:        "spills per interval",
+			counters:    map[string]int64{"executionCount": 1000, "totalSpills": 20000},
+			expectedIds: []string{"memory_spill_hash"},
+		},
+		{
+			name:        "reads per execution, no interval threshold",
+			counters:    map[string]int64{"executionCount": 3, "totalLogicalReads": 6000},
+			expectedIds: []string{"large_read_hash"},
+		},
+		{
+			name:        "no executions in the interval",
+			counters:    map[string]int64{"executionCount": 0, "totalLogicalReads": 6000},
+			expectedIds: []string{},
+		},
+	}
+	detector := NewQueryStatWarningDetector(nil, thresholds)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := detector.detect([]*common_domain.QueryMetric{{QueryHash: "hash", Counters: tt.counters}},
+				common_domain.ServerMeta{Host: "test-server", Type: "mssql"})
+			ids := make([]string, 0, len(warnings))
+			for _, w := range warnings {
+				ids = append(ids, w.Id)
+				assert.Equal(t, "test-server", w.WarningData.GetServer().GetHost())
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package event_processors
+
+import (
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PlanAnalyzer interface for finding problems in execution plans
+type PlanAnalyzer interface {
+	AnalyzePlan(xmlData string) ([]*dbmv1.ExecutionPlanWarning, error)
+}
+
+// PlanWarningDetector analyzes every fetched execution plan and emits a WarningDetected event for each problem found
+type PlanWarningDetector struct {
+	app      *app.Application
+	in       chan events.Event
+	trace    trace.Tracer
+	analyzer PlanAnalyzer
+}
+
+func NewPlanWarningDetector(app *app.Application, analyzer PlanAnalyzer) *PlanWarningDetector {
+	return &PlanWarningDetector{
+		app:      app,
+		in:       make(chan events.Event, 200),
+		trace:    otel.Tracer("PlanWarningDetector"),
+		analyzer: analyzer,
+	}
+}
+
+func (d *PlanWarningDetector) Run() {
+	for ev := range d.in {
+		planFetchedEvent, ok := ev.(events.ExecutionPlanFetched)
+		if !ok {
+			continue
+		}
+		_, span := d.trace.Start(ev.Context(), "DetectWarningsOnPlanFetched")
+		warnings, err := d.planWarnings(planFetchedEvent.Plan)
+		if err != nil {
+			fmt.Println(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+			span.RecordError(err)
+		}
+		for _, warning := range warnings {
+			d.app.EventRouter.Route(events.WarningDetected{Warning: warning})
+		}
+		span.End()
+	}
+}
+
+func (d *PlanWarningDetector) Register(router *events.EventRouter) {
+	router.Register(events.ExecutionPlanFetched{}.EventName(), d.in, "planWarningDetector")
+}
+
+func (d *PlanWarningDetector) planWarnings(plan *common_domain.ExecutionPlan) ([]*common_domain.Warning, error) {
+	planWarnings, err := d.analyzer.AnalyzePlan(plan.XmlData)
+	if err != nil {
+		return nil, fmt.Errorf("analyze plan %s: %w", plan.PlanHandle, err)
+	}
+	server := &dbmv1.ServerMetadata{Host: plan.Server.Host, Type: plan.Server.Type}
+	warnings := make([]*common_domain.Warning, 0, len(planWarnings))
+	for _, pw := range planWarnings {
+		warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+			Id:     planWarningID(pw),
+			Server: server,
+			Type:   &dbmv1.Warning_Plan{Plan: pw},
+		}))
+	}
+	return warnings, nil
+}
+
+// planWarningID is stable across agent restarts, the uploader relies on it to skip warnings the collector already has
+func planWarningID(pw *dbmv1.ExecutionPlanWarning) string {
+	switch w := pw.Warning.(type) {
+	case *dbmv1.ExecutionPlanWarning_ImplicitConversion:
+		return fmt.Sprintf("implicit_conversion_%s_%s_%s", w.ImplicitConversion.QueryHash,
+			w.ImplicitConversion.QueryPlanHash, warningIDHash(w.ImplicitConversion.TableName, w.ImplicitConversion.ColumnName))
+	case *dbmv1.ExecutionPlanWarning_MissingIndex:
+		return fmt.Sprintf("missing_index_%s_%s_%s", w.MissingIndex.QueryHash, w.MissingIndex.QueryPlanHash,
+			warningIDHash(w.MissingIndex.Index))
+	case *dbmv1.ExecutionPlanWarning_LargeTableScan:
+		return fmt.Sprintf("large_table_scan_%s_%s", w.LargeTableScan.QueryHash, w.LargeTableScan.QueryPlanHash)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,44 @@
+package event_processors
+
+import (
+	"strings"
+	"testing"
+
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanWarningID(t *testing.T) {
+	queryHash, planHash := "AAECAwQFBgc=", "CAkKCwwNDg8="
+	tests := []struct {
+		name    string
+		warning *dbmv1.ExecutionPlanWarning
+	}{
+		{
+			name: "implicit conversion",
+			warning: &dbmv1.ExecutionPlanWarning{Warning: &dbmv1.ExecutionPlanWarning_ImplicitConversion{
+				ImplicitConversion: &dbmv1.ImplicitConversion{QueryHash: queryHash, QueryPlanHash: planHash,
+					TableName: "[orders].[dbo].[" + strings.Repeat("t", 128) + "]", ColumnName: strings.Repeat("c", 128)}}},
+		},
+		{
+			name: "missing index",
+			warning: &dbmv1.ExecutionPlanWarning{Warning: &dbmv1.ExecutionPlanWarning_MissingIndex{
+				MissingIndex: &dbmv1.RecommendedIndex{QueryHash: queryHash, QueryPlanHash: planHash,
+					Index: "CREATE NONCLUSTERED INDEX [IX_orders_customer_id] ON [orders].[dbo].[orders] ([customer_id]) INCLUDE ([status], [created_at])"}}},
+		},
+		{
+			name: "large table scan",
+			warning: &dbmv1.ExecutionPlanWarning{Warning: &dbmv1.ExecutionPlanWarning_LargeTableScan{
+				LargeTableScan: &dbmv1.LargeTableScan{QueryHash: queryHash, QueryPlanHash: planHash}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := planWarningID(tt.warning)
+			// the collector stores the id in warnings.name varchar(100)
+			assert.LessOrEqual(t, len(id), 100)
+			assert.Contains(t, id, queryHash)
+			assert.Equal(t, id, planWarningID(tt.warning))
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package event_processors
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SnapshotWarningThresholds struct {
+	// Window is how far back snapshots are considered
+	Window time.Duration
+	// MinLockCount is the number of snapshots in the window a query has to be blocking others in to be a frequent lock
+	MinLockCount int
+	// MinSleepingBlockingTime is how long a sleeping session has to keep others waiting to be reported
+	MinSleepingBlockingTime time.Duration
+	// MinOpenTransactionTime is how long a transaction has to stay open to be reported, zero disables the warning
+	MinOpenTransactionTime time.Duration
+}
+
+func DefaultSnapshotWarningThresholds() SnapshotWarningThresholds {
+	return SnapshotWarningThresholds{
+		Window:                  15 * time.Minute,
+		MinLockCount:            5,
+		MinSleepingBlockingTime: 5 * time.Second,
+		MinOpenTransactionTime:  10 * time.Minute,
+	}
+}
+
+// SnapshotWarningDetector keeps a sliding window of snapshots and emits a WarningDetected event for each
+// FrequentLock and LockingSleepingSession found in it and each LongOpenTransaction of the latest snapshot.
+// A warning is emitted once per agent run.
+type SnapshotWarningDetector struct {
+	app            *app.Application
+	in             chan events.Event
+	trace          trace.Tracer
+	thresholds     SnapshotWarningThresholds
+	windowByServer map[string][]*common_domain.DataBaseSnapshot
+	emitted        map[string]struct{}
+}
+
+func NewSnapshotWarningDetector(app *app.Application, thresholds SnapshotWarningThresholds) *SnapshotWarningDetector {
+	return &SnapshotWarningDetector{
+		app:            app,
+		in:             make(chan events.Event, 200),
+		trace:          otel.Tracer("SnapshotWarningDetector"),
+		thresholds:     thresholds,
+		windowByServer: make(map[string][]*common_domain.DataBaseSnapshot),
+		emitted:        make(map[string]struct{}),
+	}
+}
+
+func (d *SnapshotWarningDetector) Run() {
+	for ev := range d.in {
+		snapTakenEvent, ok := ev.(events.SampleSnapshotTaken)
+		if !ok {
+			continue
+		}
+		_, span := d.trace.Start(ev.Context(), "DetectWarningsOnSnapTaken")
+		for _, warning := range d.addSnapshot(snapTakenEvent.Snap) {
+			if _, ok := d.emitted[warning.Id]; ok {
+				continue
+			}
+			d.emitted[warning.Id] = struct{}{}
+			d.app.EventRouter.Route(events.WarningDetected{Warning: warning})
+		}
+		span.End()
+	}
+}
+
+func (d *SnapshotWarningDetector) Register(router *events.EventRouter) {
+	router.Register(events.SampleSnapshotTaken{}.EventName(), d.in, "snapshotWarningDetector")
+}
+
+// addSnapshot slides the server window to the snapshot and returns the warnings found in the resulting window
+func (d *SnapshotWarningDetector) addSnapshot(snapshot *common_domain.DataBaseSnapshot) []*common_domain.Warning {
+	host := snapshot.SnapInfo.Server.Host
+	window := append(d.windowByServer[host], snapshot)
+	cutoff := snapshot.SnapInfo.Timestamp.Add(-d.thresholds.Window)
+	first := 0
+	for first < len(window) && window[first].SnapInfo.Timestamp.Before(cutoff) {
+		first++
+	}
+	window = window[first:]
+	d.windowByServer[host] = window
+
+	server := &dbmv1.ServerMetadata{Host: host, Type: snapshot.SnapInfo.Server.Type}
+	warnings := make([]*common_domain.Warning, 0)
+	for _, fl := range d.detectFrequentLocks(window) {
+		warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+			Id:     "frequent_lock_" + fl.BlockingQueryHash,
+			Server: server,
+			Type: &dbmv1.Warning_Snapshot{Snapshot: &dbmv1.SnapshotWarning{
+				Warning: &dbmv1.SnapshotWarning_FrequentLock{FrequentLock: fl},
+			}},
+		}))
+	}
+	for _, ls := range d.detectLockingSleepingSessions(window) {
+		warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+			Id:     "locking_sleeping_session_" + ls.BlockingQueryHash,
+			Server: server,
+			Type: &dbmv1.Warning_Snapshot{Snapshot: &dbmv1.SnapshotWarning{
+				Warning: &dbmv1.SnapshotWarning_LockingSleepingSession{LockingSleepingSession: ls},
+			}},
+		}))
+	}
+	for _, ot := range d.detectLongOpenTransactions(snapshot) {
+		warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+			Id:     fmt.Sprintf("long_open_transaction_%s_%d", ot.SessionId, ot.BeginTime.AsTime().UnixMilli()),
+			Server: server,
+			Type: &dbmv1.Warning_Snapshot{Snapshot: &dbmv1.SnapshotWarning{
+				Warning: &dbmv1.SnapshotWarning_LongOpenTransaction{LongOpenTransaction: ot},
+			}},
+		}))
+	}
+	return warnings
+}
+
+type lockStats struct {
+	lockCount     int
+	waiters       int
+	totalWaitMs   int64
+	waitTypes     map[string]int
+	waitResources map[string]int
+}
+
+// detectFrequentLocks groups the waiters of every snapshot by the query of the session they wait on
+func (d *SnapshotWarningDetector) detectFrequentLocks(window []*common_domain.DataBaseSnapshot) []*dbmv1.FrequentLock {
+	statsByHash := make(map[string]*lockStats)
+	order := make([]string, 0)
+	for _, snap := range window {
+		bySession := samplesBySession(snap)
+		seenInSnap := make(map[string]struct{})
+		for _, sample := range snap.Samples {
+			if !sample.IsBlocked || sample.Block.BlockedBy == "" {
+				continue
+			}
+			blocker, ok := bySession[sample.Block.BlockedBy]
+			if !ok {
+				continue
+			}
+			hash := blockingQueryHash(blocker)
+			if hash == "" {
+				continue
+			}
+			stats, ok := statsByHash[hash]
+			if !ok {
+				stats = &lockStats{waitTypes: make(map[string]int), waitResources: make(map[string]int)}
+				statsByHash[hash] = stats
+				order = append(order, hash)
+			}
+			if _, ok := seenInSnap[hash]; !ok {
+				seenInSnap[hash] = struct{}{}
+				stats.lockCount++
+			}
+			stats.waiters++
+			stats.totalWaitMs += int64(sample.Wait.WaitTime)
+			if sample.Wait.WaitType != nil {
+				stats.waitTypes[*sample.Wait.WaitType]++
+			}
+			if resource := sample.Wait.ResourceDescription(); resource != "" {
+				stats.waitResources[resource]++
+			}
+		}
+	}
+	ret := make([]*dbmv1.FrequentLock, 0)
+	for _, hash := range order {
+		stats := statsByHash[hash]
+		if stats.lockCount < d.thresholds.MinLockCount {
+			continue
+		}
+		ret = append(ret, &dbmv1.FrequentLock{
+			ResourceType:        mostFrequent(stats.waitTypes),
+			ResourceDescription: mostFrequent(stats.waitResources),
+			BlockingQueryHash:   hash,
+			LockCount:           int32(stats.lockCount),
+			AverageDurationMs:   float64(stats.totalWaitMs) / float64(stats.waiters),
+			AverageWaiters:      float64(stats.waiters) / float64(stats.lockCount),
+		})
+	}
+	return ret
+}
+
+// detectLockingSleepingSessions finds sessions with no running request that keep others waiting,
+// usually a transaction left open by the application
+func (d *SnapshotWarningDetector) detectLockingSleepingSessions(window []*common_domain.DataBaseSnapshot) []*dbmv1.LockingSleepingSession {
+	byHash := make(map[string]*dbmv1.LockingSleepingSession)
+	order := make([]string, 0)
+	for _, snap := range window {
+		for _, sample := range snap.Samples {
+			if !isSleeping(sample) || len(sample.Block.BlockedSessions) == 0 {
+				continue
+			}
+			hash := blockingQueryHash(sample)
+			if hash == "" {
+				continue
+			}
+			var maxWaitMs int64
+			for _, blocked := range snap.Samples {
+				if blocked.Block.BlockedBy == sample.Session.SessionID && int64(blocked.Wait.WaitTime) > maxWaitMs {
+					maxWaitMs = int64(blocked.Wait.WaitTime)
+				}
+			}
+			ls, ok := byHash[hash]
+			if !ok {
+				ls = &dbmv1.LockingSleepingSession{BlockingQueryHash: hash}
+				byHash[hash] = ls
+				order = append(order, hash)
+			}
+			ls.MaxBlockedSessionCount = max(ls.MaxBlockedSessionCount, int32(len(sample.Block.BlockedSessions)))
+			ls.MaxBlockingDurationMs = max(ls.MaxBlockingDurationMs, maxWaitMs)
+		}
+	}
+	ret := make([]*dbmv1.LockingSleepingSession, 0)
+	for _, hash := range order {
+		ls := byHash[hash]
+		if ls.MaxBlockingDurationMs < d.thresholds.MinSleepingBlockingTime.Milliseconds() {
+			continue
+		}
+		ret = append(ret, ls)
+	}
+	return ret
+}
+
+// detectLongOpenTransactions reports the transactions of the snapshot open for longer than the threshold, whether
+// they block others or not. A session and the begin time identify a transaction across snapshots.
+func (d *SnapshotWarningDetector) detectLongOpenTransactions(snapshot *common_domain.DataBaseSnapshot) []*dbmv1.LongOpenTransaction {
+	ret := make([]*dbmv1.LongOpenTransaction, 0)
+	if d.thresholds.MinOpenTransactionTime <= 0 {
+		return ret
+	}
+	for _, t := range snapshot.Transactions {
+		if t.DurationMs < d.thresholds.MinOpenTransactionTime.Milliseconds() {
+			continue
+		}
+		ret = append(ret, &dbmv1.LongOpenTransaction{
+			SessionId:         t.Session.SessionID,
+			HostName:          t.Session.HostName,
+			ProgramName:       t.Session.ProgramName,
+			LoginName:         t.Session.LoginName,
+			DatabaseName:      t.DatabaseName,
+			TransactionName:   t.Name,
+			BeginTime:         timestamppb.New(t.BeginTime),
+			DurationMs:        t.DurationMs,
+			LogBytesUsed:      t.LogBytesUsed,
+			HoldsVersionStore: t.HoldsVersionStore,
+		})
+	}
+	return ret
+}
+
+func samplesBySession(snap *common_domain.DataBaseSnapshot) map[string]*common_domain.QuerySample {
+	ret := make(map[string]*common_domain.QuerySample, len(snap.Samples))
+	for _, sample := range snap.Samples {
+		ret[sample.Session.SessionID] = sample
+	}
+	return ret
+}
+
+// blockingQueryHash identifies the query of a blocking session. Sleeping sessions are sampled without
+// a query hash (it is reported as zeroes), those fall back to the sql handle of their last request.
+func blockingQueryHash(sample *common_domain.QuerySample) string {
+	if b, err := base64.StdEncoding.DecodeString(sample.QueryHash); err == nil && strings.Trim(string(b), "\x00") != "" {
+		return sample.QueryHash
+	}
+	if b, err := base64.StdEncoding.DecodeString(sample.SqlHandle); err == nil && strings.Trim(string(b), "\x00") != "" {
+		return sample.SqlHandle
+	}
+	return ""
+}
+
+// isSleeping covers sql server and mysql sleeping sessions and postgres idle transactions
+func isSleeping(sample *common_domain.QuerySample) bool {
+	return strings.EqualFold(sample.Status, "sleeping") || strings.HasPrefix(sample.Status, "idle in transaction")
+}
+
+func mostFrequent(counts map[string]int) string {
+	var ret string
+	var retCount int
+	for k, c := range counts {
+		if c > retCount || (c == retCount && k < ret) {
+			ret = k
+			retCount = c
+		}
+	}
+	return ret
+}
@@ -0,0 +1,137 @@
+package event_processors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func blockingSnapshot(ts time.Time, blockerStatus string, waitMs int) *common_domain.DataBaseSnapshot {
+	return &common_domain.DataBaseSnapshot{
+		SnapInfo: common_domain.SnapInfo{
+			Timestamp: ts,
+			Server:    common_domain.ServerMeta{Host: "test-server", Type: "mssql"},
+		},
+		Samples: []*common_domain.QuerySample{
+			{
+				Status:    blockerStatus,
+				QueryHash: "AQIDBAUGBwg=",
+				IsBlocker: true,
+				Session:   common_domain.SessionMetadata{SessionID: "51"},
+				Block:     common_domain.BlockMetadata{BlockedSessions: []string{"52", "53"}},
+			},
+			{
+				Status:    "suspended",
+				QueryHash: "CAcGBQQDAgE=",
+				IsBlocked: true,
+				Session:   common_domain.SessionMetadata{SessionID: "52"},
+				Block:     common_domain.BlockMetadata{BlockedBy: "51"},
+				Wait:      common_domain.WaitMetadata{WaitType: stringPtr("LCK_M_X"), WaitTime: waitMs, WaitResource: "KEY: 5:72057594043105280"},
+			},
+			{
+				Status:    "suspended",
+				QueryHash: "CAcGBQQDAgE=",
+				IsBlocked: true,
+				Session:   common_domain.SessionMetadata{SessionID: "53"},
+				Block:     common_domain.BlockMetadata{BlockedBy: "51"},
+				Wait:      common_domain.WaitMetadata{WaitType: stringPtr("LCK_M_X"), WaitTime: waitMs, WaitResource: "KEY: 5:72057594043105280"},
+			},
+		},
+	}
+}
+
+func TestSnapshotWarningDetector_FrequentLock(t *testing.T) {
+	detector := NewSnapshotWarningDetector(nil, SnapshotWarningThresholds{
+		Window:                  time.Minute,
+		MinLockCount:            3,
+		MinSleepingBlockingTime: time.Hour,
+	})
+	start := time.Date(2025, 10, 5, 10, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, detector.addSnapshot(blockingSnapshot(start, "running", 1000)))
+	assert.Empty(t, detector.addSnapshot(blockingSnapshot(start.Add(10*time.Second), "running", 2000)))
+	warnings := detector.addSnapshot(blockingSnapshot(start.Add(20*time.Second), "running", 3000))
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "frequent_lock_AQIDBAUGBwg=", warnings[0].Id)
+	fl := warnings[0].WarningData.GetSnapshot().GetFrequentLock()
+	require.NotNil(t, fl)
+	assert.Equal(t, "AQIDBAUGBwg=", fl.BlockingQueryHash)
+	assert.Equal(t, int32(3), fl.LockCount)
+	assert.Equal(t, 2.0, fl.AverageWaiters)
+	assert.Equal(t, 2000.0, fl.AverageDurationMs)
+	assert.Equal(t, "LCK_M_X", fl.ResourceType)
+	assert.Equal(t, "test-server", warnings[0].WarningData.GetServer().GetHost())
+
+	// the first two snapshots fall out of the window
+	assert.Empty(t, detector.addSnapshot(blockingSnapshot(start.Add(90*time.Second), "running", 1000)))
+}
+
+func TestSnapshotWarningDetector_LockingSleepingSession(t *testing.T) {
+	detector := NewSnapshotWarningDetector(nil, SnapshotWarningThresholds{
+		Window:                  time.Minute,
+		MinLockCount:            100,
+		MinSleepingBlockingTime: 5 * time.Second,
+	})
+	start := time.Date(2025, 10, 5, 10, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, detector.addSnapshot(blockingSnapshot(start, "sleeping", 1000)))
+	warnings := detector.addSnapshot(blockingSnapshot(start.Add(10*time.Second), "sleeping", 6000))
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "locking_sleeping_session_AQIDBAUGBwg=", warnings[0].Id)
+	ls := warnings[0].WarningData.GetSnapshot().GetLockingSleepingSession()
+	require.NotNil(t, ls)
+	assert.Equal(t, int32(2), ls.MaxBlockedSessionCount)
+	assert.Equal(t, int64(6000), ls.MaxBlockingDurationMs)
+
+	// running blockers are not sleeping sessions
+	assert.Empty(t, NewSnapshotWarningDetector(nil, DefaultSnapshotWarningThresholds()).addSnapshot(blockingSnapshot(start, "running", 60000)))
+}
+
+func TestSnapshotWarningDetector_LongOpenTransaction(t *testing.T) {
+	detector := NewSnapshotWarningDetector(nil, SnapshotWarningThresholds{
+		Window:                 time.Minute,
+		MinLockCount:           100,
+		MinOpenTransactionTime: 10 * time.Minute,
+	})
+	start := time.Date(2025, 10, 5, 10, 0, 0, 0, time.UTC)
+	snap := &common_domain.DataBaseSnapshot{
+		SnapInfo: common_domain.SnapInfo{
+			Timestamp: start,
+			Server:    common_domain.ServerMeta{Host: "test-server", Type: "mssql"},
+		},
+		Transactions: []common_domain.OpenTransaction{
+			{
+				TransactionID: 1001,
+				Name:          "user_transaction",
+				Session:       common_domain.SessionMetadata{SessionID: "61", HostName: "app-1", ProgramName: "billing"},
+				BeginTime:     start.Add(-15 * time.Minute),
+				DurationMs:    (15 * time.Minute).Milliseconds(),
+				DatabaseName:  "orders",
+				LogBytesUsed:  4096,
+			},
+			{
+				TransactionID: 1002,
+				Session:       common_domain.SessionMetadata{SessionID: "62"},
+				BeginTime:     start.Add(-time.Minute),
+				DurationMs:    time.Minute.Milliseconds(),
+			},
+		},
+	}
+
+	warnings := detector.addSnapshot(snap)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, fmt.Sprintf("long_open_transaction_61_%d", start.Add(-15*time.Minute).UnixMilli()), warnings[0].Id)
+	ot := warnings[0].WarningData.GetSnapshot().GetLongOpenTransaction()
+	require.NotNil(t, ot)
+	assert.Equal(t, "app-1", ot.HostName)
+	assert.Equal(t, "billing", ot.ProgramName)
+	assert.Equal(t, "orders", ot.DatabaseName)
+	assert.Equal(t, int64(4096), ot.LogBytesUsed)
+
+	// a zero threshold disables the warning
+	assert.Empty(t, NewSnapshotWarningDetector(nil, SnapshotWarningThresholds{Window: time.Minute, MinLockCount: 100}).addSnapshot(snap))
+}
@@ -0,0 +1,104 @@
+package event_processors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// replicaLagWarningPeriod is how often a lagging replica database is reported again while it keeps lagging
+const replicaLagWarningPeriod = time.Hour
+
+// ReplicaLagWarningThresholds are checked against each secondary replica database, a zero threshold is disabled
+type ReplicaLagWarningThresholds struct {
+	DataLoss       time.Duration
+	RecoveryTime   time.Duration
+	LogSendQueueKB int64
+	RedoQueueKB    int64
+}
+
+func DefaultReplicaLagWarningThresholds() ReplicaLagWarningThresholds {
+	return ReplicaLagWarningThresholds{
+		DataLoss:     30 * time.Second,
+		RecoveryTime: 5 * time.Minute,
+	}
+}
+
+// ReplicaLagWarningDetector emits ReplicaLag warnings for the availability group replicas lagging behind the primary
+// in a system metrics reading
+type ReplicaLagWarningDetector struct {
+	app        *app.Application
+	in         chan events.Event
+	trace      trace.Tracer
+	thresholds ReplicaLagWarningThresholds
+}
+
+func NewReplicaLagWarningDetector(app *app.Application, thresholds ReplicaLagWarningThresholds) *ReplicaLagWarningDetector {
+	return &ReplicaLagWarningDetector{
+		app:        app,
+		in:         make(chan events.Event, 200),
+		trace:      otel.Tracer("ReplicaLagWarningDetector"),
+		thresholds: thresholds,
+	}
+}
+
+func (d *ReplicaLagWarningDetector) Run() {
+	for ev := range d.in {
+		metricsTakenEvent, ok := ev.(events.SystemMetricsTaken)
+		if !ok {
+			continue
+		}
+		_, span := d.trace.Start(ev.Context(), "DetectWarningsOnSystemMetricsTaken")
+		for _, warning := range d.detect(metricsTakenEvent.Metrics) {
+			d.app.EventRouter.Route(events.WarningDetected{Warning: warning})
+		}
+		span.End()
+	}
+}
+
+func (d *ReplicaLagWarningDetector) Register(router *events.EventRouter) {
+	router.Register(events.SystemMetricsTaken{}.EventName(), d.in, "replicaLagWarningDetector")
+}
+
+// detect reports each lagging replica database once per replicaLagWarningPeriod
+func (d *ReplicaLagWarningDetector) detect(metrics *common_domain.SystemMetrics) []*common_domain.Warning {
+	serverMeta := &dbmv1.ServerMetadata{Host: metrics.Server.Host, Type: metrics.Server.Type}
+	period := metrics.Timestamp.Truncate(replicaLagWarningPeriod).Unix()
+	warnings := make([]*common_domain.Warning, 0)
+	for _, r := range metrics.Replicas {
+		if r.Role == common_domain.ReplicaRolePrimary || !d.lagging(r) {
+			continue
+		}
+		warnings = append(warnings, common_domain.NewWarning(&dbmv1.Warning{
+			Id:     fmt.Sprintf("replica_lag_%s_%d", warningIDHash(r.AGName, r.ReplicaServerName, r.DatabaseName), period),
+			Server: serverMeta,
+			Type: &dbmv1.Warning_AvailabilityGroup{AvailabilityGroup: &dbmv1.AvailabilityGroupWarning{
+				Warning: &dbmv1.AvailabilityGroupWarning_ReplicaLag{ReplicaLag: &dbmv1.ReplicaLag{
+					AgName:                 r.AGName,
+					ReplicaServerName:      r.ReplicaServerName,
+					DatabaseName:           r.DatabaseName,
+					SynchronizationState:   r.SynchronizationState,
+					LogSendQueueKb:         r.LogSendQueueKB,
+					RedoQueueKb:            r.RedoQueueKB,
+					EstimatedDataLossS:     r.EstimatedDataLossS,
+					EstimatedRecoveryTimeS: r.EstimatedRecoveryTimeS,
+				}},
+			}},
+		}))
+	}
+	return warnings
+}
+
+func (d *ReplicaLagWarningDetector) lagging(r common_domain.ReplicaState) bool {
+	t := d.thresholds
+	return (t.DataLoss > 0 && r.EstimatedDataLossS >= int64(t.DataLoss.Seconds())) ||
+		(t.RecoveryTime > 0 && r.EstimatedRecoveryTimeS >= int64(t.RecoveryTime.Seconds())) ||
+		(t.LogSendQueueKB > 0 && r.LogSendQueueKB >= t.LogSendQueueKB) ||
+		(t.RedoQueueKB > 0 && r.RedoQueueKB >= t.RedoQueueKB)
+}
@@ -0,0 +1,64 @@
+package event_processors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicaLagWarningDetector_Detect(t *testing.T) {
+	thresholds := ReplicaLagWarningThresholds{DataLoss: 30 * time.Second, RedoQueueKB: 1024}
+	timestamp := time.Date(2025, 12, 20, 10, 42, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		replica     common_domain.ReplicaState
+		expectedIds []string
+	}{
+		{
+			name:        "below thresholds",
+			replica:     common_domain.ReplicaState{Role: "SECONDARY", EstimatedDataLossS: 5, RedoQueueKB: 512},
+			expectedIds: []string{},
+		},
+		{
+			name:        "data loss",
+			replica:     common_domain.ReplicaState{Role: "SECONDARY", EstimatedDataLossS: 45},
+			expectedIds: []string{"replica_lag_a3ccb1f2ac589467_1766224800"},
+		},
+		{
+			name:        "redo queue",
+			replica:     common_domain.ReplicaState{Role: "SECONDARY", RedoQueueKB: 4096, EstimatedRecoveryTimeS: 4096},
+			expectedIds: []string{"replica_lag_a3ccb1f2ac589467_1766224800"},
+		},
+		{
+			name:        "recovery time threshold disabled",
+			replica:     common_domain.ReplicaState{Role: "SECONDARY", EstimatedRecoveryTimeS: 3600},
+			expectedIds: []string{},
+		},
+		{
+			name:        "primary",
+			replica:     common_domain.ReplicaState{Role: "PRIMARY", LogSendQueueKB: 1 << 20, EstimatedDataLossS: 120},
+			expectedIds: []string{},
+		},
+	}
+	detector := NewReplicaLagWarningDetector(nil, thresholds)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replica := tt.replica
+			replica.AGName, replica.ReplicaServerName, replica.DatabaseName = "ag1", "node2", "orders"
+			warnings := detector.detect(&common_domain.SystemMetrics{
+				Server:    common_domain.ServerMeta{Host: "test-server", Type: "mssql"},
+				Timestamp: timestamp,
+				Replicas:  []common_domain.ReplicaState{replica},
+			})
+			ids := make([]string, 0, len(warnings))
+			for _, w := range warnings {
+				ids = append(ids, w.Id)
+				assert.Equal(t, "test-server", w.WarningData.GetServer().GetHost())
+				assert.Equal(t, "orders", w.WarningData.GetAvailabilityGroup().GetReplicaLag().GetDatabaseName())
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
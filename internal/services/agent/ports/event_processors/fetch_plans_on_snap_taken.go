@@ -17,6 +17,7 @@ type PlanFetcher struct {
 	in                   chan events.Event
 	trace                trace.Tracer
 	knownHandlesByServer map[string]map[string]struct{}
+	enabled              bool
 }
 
 func NewPlanFetcher(app app.Application) *PlanFetcher {
@@ -25,13 +26,18 @@ func NewPlanFetcher(app app.Application) *PlanFetcher {
 		in:                   make(chan events.Event, 200),
 		trace:                otel.Tracer("PlanFetcher"),
 		knownHandlesByServer: make(map[string]map[string]struct{}),
+		enabled:              true,
 	}
 }
 
 func (f *PlanFetcher) Run() {
 	for ev := range f.in {
+		if configApplied, ok := ev.(events.CollectionConfigApplied); ok {
+			f.enabled = configApplied.Config.FetchPlans
+			continue
+		}
 		snapTakenEvent, ok := ev.(events.SampleSnapshotTaken)
-		if !ok {
+		if !ok || !f.enabled {
 			continue
 		}
 		ctx, span := f.trace.Start(ev.Context(), "FetchPlansOnSnapTaken")
@@ -82,4 +88,5 @@ func (f *PlanFetcher) Run() {
 
 func (f *PlanFetcher) Register(router *events.EventRouter) {
 	router.Register(events.SampleSnapshotTaken{}.EventName(), f.in, "planFetcher")
+	router.Register(events.CollectionConfigApplied{}.EventName(), f.in, "planFetcher")
 }
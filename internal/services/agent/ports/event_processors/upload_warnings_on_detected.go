@@ -0,0 +1,72 @@
+package event_processors
+
+import (
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/app"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/agent/domain/events"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WarningUploader uploads detected warnings the collector does not know about yet
+type WarningUploader struct {
+	app                   app.Application
+	in                    chan events.Event
+	trace                 trace.Tracer
+	knownWarningsByServer map[string]map[string]struct{}
+}
+
+func NewWarningUploader(app app.Application) *WarningUploader {
+	return &WarningUploader{
+		app:                   app,
+		in:                    make(chan events.Event, 200),
+		trace:                 otel.Tracer("WarningUploader"),
+		knownWarningsByServer: make(map[string]map[string]struct{}),
+	}
+}
+
+func (u *WarningUploader) Run() {
+	for ev := range u.in {
+		warningEvent, ok := ev.(events.WarningDetected)
+		if !ok {
+			continue
+		}
+		ctx, span := u.trace.Start(ev.Context(), "UploadWarningOnDetected")
+		warning := warningEvent.Warning
+		server := common_domain.ServerMeta{
+			Host: warning.WarningData.GetServer().GetHost(),
+			Type: warning.WarningData.GetServer().GetType(),
+		}
+		if m, found := u.knownWarningsByServer[server.Host]; !found || len(m) == 0 {
+			known, err := u.app.Queries.GetKnownWarnings.Handle(ctx, server)
+			if err != nil {
+				fmt.Println(err)
+				span.SetStatus(otelcodes.Error, err.Error())
+				span.RecordError(err)
+				known = make(map[string]struct{})
+			}
+			u.knownWarningsByServer[server.Host] = known
+		}
+		if _, found := u.knownWarningsByServer[server.Host][warning.Id]; found {
+			span.End()
+			continue
+		}
+		err := u.app.Commands.UploadWarnings.Handle(ctx, []*common_domain.Warning{warning}, server)
+		if err != nil {
+			fmt.Println(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+			span.RecordError(err)
+			span.End()
+			continue
+		}
+		u.knownWarningsByServer[server.Host][warning.Id] = struct{}{}
+		span.End()
+	}
+}
+
+func (u *WarningUploader) Register(router *events.EventRouter) {
+	router.Register(events.WarningDetected{}.EventName(), u.in, "warningUploader")
+}
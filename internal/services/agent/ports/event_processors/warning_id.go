@@ -0,0 +1,14 @@
+package event_processors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// warningIDHash shortens the variable part of a warning id, object and statement names are too long for the
+// collector warnings.name column (100 characters)
+func warningIDHash(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
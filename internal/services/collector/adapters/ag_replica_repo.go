@@ -0,0 +1,239 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// replicaStateColumns are the columns of ag_replica_states scanned by scanReplicaState
+const replicaStateColumns = `ag_name,
+       replica_server_name,
+       database_name,
+       role,
+       availability_mode,
+       failover_mode,
+       connected_state,
+       is_local,
+       synchronization_state,
+       synchronization_health,
+       is_suspended,
+       suspend_reason,
+       log_send_queue_kb,
+       log_send_rate_kbps,
+       redo_queue_kb,
+       redo_rate_kbps,
+       last_commit_time,
+       estimated_data_loss_s,
+       estimated_recovery_time_s,
+       collected_at`
+
+func (p *PostgresRepo) StoreReplicaStates(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, states []common_domain.ReplicaState) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreReplicaStates")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", server.Host), attribute.Int("replica_states", len(states)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	n := len(states)
+	agNames, replicas, databases, roles := make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	availabilityModes, failoverModes, connectedStates := make([]string, n), make([]string, n), make([]string, n)
+	syncStates, syncHealths, suspendReasons := make([]string, n), make([]string, n), make([]string, n)
+	isLocal, isSuspended := make([]bool, n), make([]bool, n)
+	logSendQueues, logSendRates, redoQueues, redoRates := make([]int64, n), make([]int64, n), make([]int64, n), make([]int64, n)
+	dataLoss, recoveryTimes := make([]int64, n), make([]int64, n)
+	lastCommits := make([]sql.NullTime, n)
+	for i, r := range states {
+		agNames[i] = r.AGName
+		replicas[i] = r.ReplicaServerName
+		databases[i] = r.DatabaseName
+		roles[i] = r.Role
+		availabilityModes[i] = r.AvailabilityMode
+		failoverModes[i] = r.FailoverMode
+		connectedStates[i] = r.ConnectedState
+		isLocal[i] = r.IsLocal
+		syncStates[i] = r.SynchronizationState
+		syncHealths[i] = r.SynchronizationHealth
+		isSuspended[i] = r.IsSuspended
+		suspendReasons[i] = r.SuspendReason
+		logSendQueues[i] = r.LogSendQueueKB
+		logSendRates[i] = r.LogSendRateKBps
+		redoQueues[i] = r.RedoQueueKB
+		redoRates[i] = r.RedoRateKBps
+		lastCommits[i] = sql.NullTime{Time: r.LastCommitTime.In(time.UTC), Valid: !r.LastCommitTime.IsZero()}
+		dataLoss[i] = r.EstimatedDataLossS
+		recoveryTimes[i] = r.EstimatedRecoveryTimeS
+	}
+	// a metrics interval resent from the agent outbox replaces the stored one
+	_, err = tx.ExecContext(ctx, `insert into ag_replica_states (target_id, collected_at, ag_name, replica_server_name,
+                               database_name, role, availability_mode, failover_mode, connected_state,
+                               is_local, synchronization_state, synchronization_health, is_suspended,
+                               suspend_reason, log_send_queue_kb, log_send_rate_kbps, redo_queue_kb,
+                               redo_rate_kbps, last_commit_time, estimated_data_loss_s,
+                               estimated_recovery_time_s)
+select $1, $2, r.*
+from unnest($3::text[], $4::text[], $5::text[], $6::text[], $7::text[], $8::text[], $9::text[], $10::boolean[],
+            $11::text[], $12::text[], $13::boolean[], $14::text[], $15::bigint[], $16::bigint[], $17::bigint[],
+            $18::bigint[], $19::timestamp[], $20::bigint[], $21::bigint[]) r
+on conflict (target_id, collected_at, ag_name, replica_server_name, database_name) do update set role                      = excluded.role,
+                                                                                               availability_mode         = excluded.availability_mode,
+                                                                                               failover_mode             = excluded.failover_mode,
+                                                                                               connected_state           = excluded.connected_state,
+                                                                                               is_local                  = excluded.is_local,
+                                                                                               synchronization_state     = excluded.synchronization_state,
+                                                                                               synchronization_health    = excluded.synchronization_health,
+                                                                                               is_suspended              = excluded.is_suspended,
+                                                                                               suspend_reason            = excluded.suspend_reason,
+                                                                                               log_send_queue_kb         = excluded.log_send_queue_kb,
+                                                                                               log_send_rate_kbps        = excluded.log_send_rate_kbps,
+                                                                                               redo_queue_kb             = excluded.redo_queue_kb,
+                                                                                               redo_rate_kbps            = excluded.redo_rate_kbps,
+                                                                                               last_commit_time          = excluded.last_commit_time,
+                                                                                               estimated_data_loss_s     = excluded.estimated_data_loss_s,
+                                                                                               estimated_recovery_time_s = excluded.estimated_recovery_time_s`,
+		targetID, timestamp.In(time.UTC), pq.Array(agNames), pq.Array(replicas), pq.Array(databases), pq.Array(roles),
+		pq.Array(availabilityModes), pq.Array(failoverModes), pq.Array(connectedStates), pq.Array(isLocal),
+		pq.Array(syncStates), pq.Array(syncHealths), pq.Array(isSuspended), pq.Array(suspendReasons),
+		pq.Array(logSendQueues), pq.Array(logSendRates), pq.Array(redoQueues), pq.Array(redoRates),
+		pq.Array(lastCommits), pq.Array(dataLoss), pq.Array(recoveryTimes))
+	if err != nil {
+		return fmt.Errorf("insert replica states: %w", err)
+	}
+	return nil
+}
+
+// GetReplicaStates returns one series per availability database and replica, an empty agName selects every
+// availability group
+func (p *PostgresRepo) GetReplicaStates(ctx context.Context, serverID string, start time.Time, end time.Time, agName string) ([]*common_domain.ReplicaStateSeries, error) {
+	ctx, span := p.tracer.Start(ctx, "GetReplicaStates")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.ReplicaStateSeries{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select ` + replicaStateColumns + `
+from ag_replica_states
+where target_id = $1
+  and collected_at between $2 and $3
+  and ($4 = '' or ag_name = $4)
+order by ag_name, replica_server_name, database_name, collected_at`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), agName)
+	if err != nil {
+		return nil, fmt.Errorf("get replica states: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.ReplicaStateSeries, 0)
+	var current *common_domain.ReplicaStateSeries
+	for rows.Next() {
+		var point common_domain.ReplicaStatePoint
+		err = scanReplicaState(rows, &point.ReplicaState, &point.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("get replica states scan: %w", err)
+		}
+		if current == nil || current.AGName != point.AGName || current.ReplicaServerName != point.ReplicaServerName ||
+			current.DatabaseName != point.DatabaseName {
+			current = &common_domain.ReplicaStateSeries{AGName: point.AGName, ReplicaServerName: point.ReplicaServerName,
+				DatabaseName: point.DatabaseName}
+			ret = append(ret, current)
+		}
+		current.Points = append(current.Points, point)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get replica states rows: %w", err)
+	}
+	return ret, nil
+}
+
+// GetLatestReplicaStates returns the replica states of the latest reading of the target and its time, grouped by
+// availability group with the primary replica first
+func (p *PostgresRepo) GetLatestReplicaStates(ctx context.Context, serverID string, agName string) ([]common_domain.ReplicaState, time.Time, error) {
+	ctx, span := p.tracer.Start(ctx, "GetLatestReplicaStates")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []common_domain.ReplicaState{}, time.Time{}, nil
+		}
+		return nil, time.Time{}, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select ` + replicaStateColumns + `
+from ag_replica_states
+where target_id = $1
+  and ($2 = '' or ag_name = $2)
+  and collected_at = (select max(collected_at)
+                      from ag_replica_states
+                      where target_id = $1
+                        and ($2 = '' or ag_name = $2))
+order by ag_name, role <> 'PRIMARY', replica_server_name, database_name`
+	rows, err := p.db.QueryContext(ctx, q, targetID, agName)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("get latest replica states: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.ReplicaState, 0)
+	var collectedAt time.Time
+	for rows.Next() {
+		var state common_domain.ReplicaState
+		err = scanReplicaState(rows, &state, &collectedAt)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("get latest replica states scan: %w", err)
+		}
+		ret = append(ret, state)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("get latest replica states rows: %w", err)
+	}
+	return ret, collectedAt, nil
+}
+
+func scanReplicaState(rows *sql.Rows, r *common_domain.ReplicaState, collectedAt *time.Time) error {
+	var lastCommit sql.NullTime
+	err := rows.Scan(&r.AGName, &r.ReplicaServerName, &r.DatabaseName, &r.Role, &r.AvailabilityMode, &r.FailoverMode,
+		&r.ConnectedState, &r.IsLocal, &r.SynchronizationState, &r.SynchronizationHealth, &r.IsSuspended,
+		&r.SuspendReason, &r.LogSendQueueKB, &r.LogSendRateKBps, &r.RedoQueueKB, &r.RedoRateKBps, &lastCommit,
+		&r.EstimatedDataLossS, &r.EstimatedRecoveryTimeS, collectedAt)
+	if err != nil {
+		return err
+	}
+	if lastCommit.Valid {
+		r.LastCommitTime = lastCommit.Time
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ domain.AgentsRepository = (*PostgresRepo)(nil)
+
+func (p *PostgresRepo) RegisterAgent(ctx context.Context, registration domain.AgentRegistration) (err error) {
+	ctx, span := p.tracer.Start(ctx, "RegisterAgent")
+	defer span.End()
+	span.SetAttributes(attribute.String("agent_id", registration.AgentID), attribute.String("target_host", registration.Server.Host))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, registration.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	timestamp := registration.Timestamp.In(time.UTC)
+	_, err = tx.ExecContext(ctx, `insert into agents (id, version, registered_at, last_seen)
+values ($1, $2, $3, $3)
+on conflict (id) do update set version = excluded.version, last_seen = excluded.last_seen`,
+		registration.AgentID, registration.AgentVersion, timestamp)
+	if err != nil {
+		return fmt.Errorf("upsert agent: %w", err)
+	}
+	tags := registration.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	// a restarted agent starts its status over, what was collected before the restart is in the samples
+	_, err = tx.ExecContext(ctx, `insert into agent_targets (agent_id, target_id, tags, registered_at, last_seen)
+values ($1, $2, $3, $4, $4)
+on conflict (agent_id, target_id) do update set tags            = excluded.tags,
+                                                registered_at   = excluded.registered_at,
+                                                last_seen       = excluded.last_seen,
+                                                last_snapshot   = null,
+                                                last_metrics    = null,
+                                                last_error      = '',
+                                                last_error_time = null`,
+		registration.AgentID, targetID, pq.Array(tags), timestamp)
+	if err != nil {
+		return fmt.Errorf("upsert agent target: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `update target set agent_version = $2 where id = $1`, targetID, registration.AgentVersion)
+	if err != nil {
+		return fmt.Errorf("update target agent version: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) RecordHeartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus, timestamp time.Time) (err error) {
+	ctx, span := p.tracer.Start(ctx, "RecordHeartbeat")
+	defer span.End()
+	span.SetAttributes(attribute.String("agent_id", agentID), attribute.String("target_host", server.Host))
+	targetID, err := p.getTargetID(ctx, p.db, server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	res, err := tx.ExecContext(ctx, `update agent_targets
+set last_seen       = $3,
+    last_snapshot   = $4,
+    last_metrics    = $5,
+    last_error      = $6,
+    last_error_time = $7
+where agent_id = $1
+  and target_id = $2`,
+		agentID, targetID, timestamp.In(time.UTC), nullTime(status.LastSnapshot), nullTime(status.LastMetrics),
+		status.LastError, nullTime(status.LastErrorTime))
+	if err != nil {
+		return fmt.Errorf("update agent target: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return custom_errors.NotFoundErr{Message: fmt.Sprintf("agent %s is not registered for %s", agentID, server.Host)}
+	}
+	_, err = tx.ExecContext(ctx, `update agents set last_seen = $2 where id = $1`, agentID, timestamp.In(time.UTC))
+	if err != nil {
+		return fmt.Errorf("update agent: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) ListAgents(ctx context.Context) ([]*common_domain.Agent, error) {
+	ctx, span := p.tracer.Start(ctx, "ListAgents")
+	defer span.End()
+	return p.queryAgents(ctx, "")
+}
+
+func (p *PostgresRepo) GetAgent(ctx context.Context, id string) (*common_domain.Agent, error) {
+	ctx, span := p.tracer.Start(ctx, "GetAgent")
+	defer span.End()
+	agents, err := p.queryAgents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(agents) == 0 {
+		return nil, custom_errors.NotFoundErr{Message: fmt.Sprintf("agent %s not found", id)}
+	}
+	return agents[0], nil
+}
+
+// queryAgents reads every agent with its targets, or only the agent with the given id
+func (p *PostgresRepo) queryAgents(ctx context.Context, id string) ([]*common_domain.Agent, error) {
+	q := `select a.id,
+       a.version,
+       a.registered_at,
+       a.last_seen,
+       t.host,
+       tt.dsc_type,
+       at.tags,
+       at.registered_at,
+       at.last_seen,
+       at.last_snapshot,
+       at.last_metrics,
+       at.last_error,
+       at.last_error_time
+from agents a
+         inner join agent_targets at on at.agent_id = a.id
+         inner join target t on t.id = at.target_id
+         inner join target_type tt on tt.id = t.type_id
+where ($1 = '' or a.id = $1)
+order by a.id, t.host`
+	rows, err := p.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("list agents: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.Agent, 0)
+	var current *common_domain.Agent
+	for rows.Next() {
+		var agent common_domain.Agent
+		var target common_domain.AgentTarget
+		var lastSnapshot, lastMetrics, lastErrorTime sql.NullTime
+		err = rows.Scan(&agent.ID, &agent.Version, &agent.RegisteredAt, &agent.LastSeen, &target.Server.Host,
+			&target.Server.Type, pq.Array(&target.Tags), &target.RegisteredAt, &target.LastSeen, &lastSnapshot,
+			&lastMetrics, &target.Status.LastError, &lastErrorTime)
+		if err != nil {
+			return nil, fmt.Errorf("list agents scan: %w", err)
+		}
+		target.Status.LastSnapshot = lastSnapshot.Time
+		target.Status.LastMetrics = lastMetrics.Time
+		target.Status.LastErrorTime = lastErrorTime.Time
+		if current == nil || current.ID != agent.ID {
+			current = &agent
+			ret = append(ret, current)
+		}
+		current.Targets = append(current.Targets, target)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("list agents rows: %w", err)
+	}
+	return ret, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.In(time.UTC), Valid: true}
+}
+
+func (p *PostgresRepo) GetCollectionConfig(ctx context.Context, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error) {
+	ctx, span := p.tracer.Start(ctx, "GetCollectionConfig")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", server.Host))
+	q := `select t.host,
+       tt.dsc_type,
+       c.snapshot_interval_ms,
+       c.metrics_interval_ms,
+       c.deadlock_interval_ms,
+       c.index_stats_interval_ms,
+       c.query_store_interval_ms,
+       c.job_interval_ms,
+       c.file_size_interval_ms,
+       c.databases,
+       c.exclude_databases,
+       c.collect_metrics,
+       c.collect_deadlocks,
+       c.fetch_plans,
+       c.collect_lock_metrics,
+       c.updated_at
+from target_collection_config c
+         inner join target t on t.id = c.target_id
+         inner join target_type tt on tt.id = t.type_id
+where t.host = $1
+  and ($2 = '' or tt.dsc_type = $2)`
+	var config common_domain.CollectionConfig
+	var snapshotInterval, metricsInterval, deadlockInterval, indexStatsInterval, queryStoreInterval, jobInterval,
+		fileSizeInterval int64
+	err := p.db.QueryRowContext(ctx, q, server.Host, server.Type).Scan(&config.Server.Host, &config.Server.Type,
+		&snapshotInterval, &metricsInterval, &deadlockInterval, &indexStatsInterval, &queryStoreInterval, &jobInterval,
+		&fileSizeInterval, pq.Array(&config.Databases.Include), pq.Array(&config.Databases.Exclude),
+		&config.CollectMetrics, &config.CollectDeadlocks, &config.FetchPlans, &config.CollectLockMetrics, &config.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, custom_errors.NotFoundErr{Message: fmt.Sprintf("no collection config for %s", server.Host)}
+		}
+		return nil, fmt.Errorf("get collection config: %w", err)
+	}
+	config.SnapshotInterval = time.Duration(snapshotInterval) * time.Millisecond
+	config.MetricsInterval = time.Duration(metricsInterval) * time.Millisecond
+	config.DeadlockInterval = time.Duration(deadlockInterval) * time.Millisecond
+	config.IndexStatsInterval = time.Duration(indexStatsInterval) * time.Millisecond
+	config.QueryStoreInterval = time.Duration(queryStoreInterval) * time.Millisecond
+	config.JobInterval = time.Duration(jobInterval) * time.Millisecond
+	config.FileSizeInterval = time.Duration(fileSizeInterval) * time.Millisecond
+	return &config, nil
+}
+
+func (p *PostgresRepo) StoreCollectionConfig(ctx context.Context, config common_domain.CollectionConfig) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreCollectionConfig")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", config.Server.Host))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, config.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	include := config.Databases.Include
+	if include == nil {
+		include = []string{}
+	}
+	exclude := config.Databases.Exclude
+	if exclude == nil {
+		exclude = []string{}
+	}
+	_, err = tx.ExecContext(ctx, `insert into target_collection_config (target_id, snapshot_interval_ms, metrics_interval_ms,
+                                      deadlock_interval_ms, databases, exclude_databases,
+                                      collect_metrics, collect_deadlocks, fetch_plans,
+                                      collect_lock_metrics, updated_at, index_stats_interval_ms,
+                                      query_store_interval_ms, job_interval_ms, file_size_interval_ms)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+on conflict (target_id) do update set snapshot_interval_ms    = excluded.snapshot_interval_ms,
+                                      metrics_interval_ms     = excluded.metrics_interval_ms,
+                                      deadlock_interval_ms    = excluded.deadlock_interval_ms,
+                                      index_stats_interval_ms = excluded.index_stats_interval_ms,
+                                      query_store_interval_ms = excluded.query_store_interval_ms,
+                                      job_interval_ms         = excluded.job_interval_ms,
+                                      file_size_interval_ms   = excluded.file_size_interval_ms,
+                                      databases               = excluded.databases,
+                                      exclude_databases       = excluded.exclude_databases,
+                                      collect_metrics         = excluded.collect_metrics,
+                                      collect_deadlocks       = excluded.collect_deadlocks,
+                                      fetch_plans             = excluded.fetch_plans,
+                                      collect_lock_metrics    = excluded.collect_lock_metrics,
+                                      updated_at              = excluded.updated_at`,
+		targetID, config.SnapshotInterval.Milliseconds(), config.MetricsInterval.Milliseconds(),
+		config.DeadlockInterval.Milliseconds(), pq.Array(include), pq.Array(exclude), config.CollectMetrics,
+		config.CollectDeadlocks, config.FetchPlans, config.CollectLockMetrics, config.UpdatedAt.In(time.UTC),
+		config.IndexStatsInterval.Milliseconds(), config.QueryStoreInterval.Milliseconds(), config.JobInterval.Milliseconds(),
+		config.FileSizeInterval.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("upsert collection config: %w", err)
+	}
+	return nil
+}
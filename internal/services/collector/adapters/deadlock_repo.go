@@ -0,0 +1,171 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain/converters"
+	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var _ domain.DeadlocksRepository = (*PostgresRepo)(nil)
+
+func (p *PostgresRepo) StoreDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, serverMeta common_domain.ServerMeta) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreDeadlocks")
+	defer span.End()
+	span.SetAttributes(attribute.Int("num_deadlocks", len(deadlocks)))
+	if len(deadlocks) == 0 {
+		return nil
+	}
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, serverMeta)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	// agents resend deadlocks still held by the target after a restart
+	stmt, err := tx.PrepareContext(ctx, `insert into deadlocks (f_id, target_id, event_time, data)
+values ($1, $2, $3, $4)
+on conflict (f_id) do nothing`)
+	if err != nil {
+		return fmt.Errorf("prepare statement: %w", err)
+	}
+	defer stmt.Close()
+	for _, deadlock := range deadlocks {
+		var data []byte
+		data, err = converters.DeadlockToProto(deadlock).MarshalVT()
+		if err != nil {
+			return fmt.Errorf("marshal deadlock %s: %w", deadlock.ID, err)
+		}
+		_, err = stmt.ExecContext(ctx, deadlock.ID, targetID, deadlock.Timestamp.In(time.UTC), data)
+		if err != nil {
+			return fmt.Errorf("insert deadlock %s: %w", deadlock.ID, err)
+		}
+	}
+	return nil
+}
+
+func (p *PostgresRepo) ListDeadlocks(ctx context.Context, serverID string, start time.Time, end time.Time, pageNumber int, pageSize int) ([]*common_domain.Deadlock, int, error) {
+	ctx, span := p.tracer.Start(ctx, "ListDeadlocks")
+	defer span.End()
+	q := `select d.data, count(*) over () as full_count
+from deadlocks d
+         inner join target t on t.id = d.target_id
+where d.event_time between $1 and $2
+  and ($3 = '' or t.host = $3)
+order by d.event_time desc
+offset $4 rows limit $5`
+	rows, err := p.db.QueryContext(ctx, q, start.In(time.UTC), end.In(time.UTC), serverID, pageSize*(pageNumber-1), pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list deadlocks: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.Deadlock, 0)
+	var totalCount int
+	for rows.Next() {
+		var data []byte
+		err = rows.Scan(&data, &totalCount)
+		if err != nil {
+			return nil, 0, fmt.Errorf("list deadlocks scan: %w", err)
+		}
+		var protoDeadlock dbmv1.Deadlock
+		err = protoDeadlock.UnmarshalVT(data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal deadlock: %w", err)
+		}
+		ret = append(ret, converters.DeadlockToDomain(&protoDeadlock))
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, fmt.Errorf("list deadlocks rows: %w", err)
+	}
+	return ret, totalCount, nil
+}
+
+func (p *PostgresRepo) GetDeadlock(ctx context.Context, id string) (*common_domain.Deadlock, error) {
+	ctx, span := p.tracer.Start(ctx, "GetDeadlock")
+	defer span.End()
+	var data []byte
+	err := p.db.QueryRowContext(ctx, `select data from deadlocks where f_id = $1`, id).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, custom_errors.NotFoundErr{Message: fmt.Sprintf("deadlock %s not found", id)}
+		}
+		return nil, fmt.Errorf("get deadlock %s: %w", id, err)
+	}
+	var protoDeadlock dbmv1.Deadlock
+	err = protoDeadlock.UnmarshalVT(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal deadlock %s: %w", id, err)
+	}
+	return converters.DeadlockToDomain(&protoDeadlock), nil
+}
+
+func (p *PostgresRepo) GetStatementHandles(ctx context.Context, server common_domain.ServerMeta, sqlHandles []string) (map[string]domain.StatementHandles, error) {
+	ctx, span := p.tracer.Start(ctx, "GetStatementHandles")
+	defer span.End()
+	ret := make(map[string]domain.StatementHandles, len(sqlHandles))
+	if len(sqlHandles) == 0 {
+		return ret, nil
+	}
+	targetID, err := p.getTargetID(ctx, p.db, server)
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return ret, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select distinct on (qs.sql_handle) qs.sql_handle, coalesce(qs.plan_handle, ''), coalesce(qs.query_hash, '')
+from query_samples qs
+         inner join snapshot s on s.id = qs.snap_id
+where s.target_id = $1
+  and qs.sql_handle = any ($2)
+order by qs.sql_handle, s.snap_time desc`
+	rows, err := p.db.QueryContext(ctx, q, targetID, pq.Array(sqlHandles))
+	if err != nil {
+		return nil, fmt.Errorf("get statement handles: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	for rows.Next() {
+		var sqlHandle string
+		var handles domain.StatementHandles
+		err = rows.Scan(&sqlHandle, &handles.PlanHandle, &handles.QueryHash)
+		if err != nil {
+			return nil, fmt.Errorf("get statement handles scan: %w", err)
+		}
+		ret[sqlHandle] = handles
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get statement handles rows: %w", err)
+	}
+	return ret, nil
+}
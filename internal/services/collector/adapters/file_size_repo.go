@@ -0,0 +1,211 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *PostgresRepo) StoreFileSizeStats(ctx context.Context, stats common_domain.FileSizeStats) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreFileSizeStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", stats.Server.Host), attribute.Int("files", len(stats.Files)),
+		attribute.Int("growth_events", len(stats.GrowthEvents)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, stats.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	n := len(stats.Files)
+	databases, logicalNames, physicalNames, fileTypes := make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	fileIDs, sizes, used, maxSizes, growths, volumeFree := make([]int64, n), make([]int64, n), make([]int64, n), make([]int64, n), make([]int64, n), make([]int64, n)
+	percentGrowth := make([]bool, n)
+	for i, f := range stats.Files {
+		databases[i] = f.DatabaseName
+		fileIDs[i] = f.FileID
+		logicalNames[i] = f.LogicalName
+		physicalNames[i] = f.PhysicalName
+		fileTypes[i] = f.FileType
+		sizes[i] = f.SizeKB
+		used[i] = f.UsedKB
+		maxSizes[i] = f.MaxSizeKB
+		growths[i] = f.Growth
+		percentGrowth[i] = f.IsPercentGrowth
+		volumeFree[i] = f.VolumeFreeKB
+	}
+	// a reading resent from the agent outbox replaces the stored one
+	_, err = tx.ExecContext(ctx, `insert into database_file_size (target_id, collected_at, database_name, file_id,
+                                logical_name, physical_name, file_type, size_kb, used_kb, max_size_kb,
+                                growth, is_percent_growth, volume_free_kb)
+select $1, $2, f.*
+from unnest($3::text[], $4::int[], $5::text[], $6::text[], $7::text[], $8::bigint[], $9::bigint[], $10::bigint[],
+            $11::bigint[], $12::boolean[], $13::bigint[]) f
+on conflict (target_id, collected_at, database_name, file_id) do update set logical_name      = excluded.logical_name,
+                                                                           physical_name     = excluded.physical_name,
+                                                                           file_type         = excluded.file_type,
+                                                                           size_kb           = excluded.size_kb,
+                                                                           used_kb           = excluded.used_kb,
+                                                                           max_size_kb       = excluded.max_size_kb,
+                                                                           growth            = excluded.growth,
+                                                                           is_percent_growth = excluded.is_percent_growth,
+                                                                           volume_free_kb    = excluded.volume_free_kb`,
+		targetID, stats.Timestamp.In(time.UTC), pq.Array(databases), pq.Array(fileIDs), pq.Array(logicalNames),
+		pq.Array(physicalNames), pq.Array(fileTypes), pq.Array(sizes), pq.Array(used), pq.Array(maxSizes),
+		pq.Array(growths), pq.Array(percentGrowth), pq.Array(volumeFree))
+	if err != nil {
+		return fmt.Errorf("insert file sizes: %w", err)
+	}
+	m := len(stats.GrowthEvents)
+	eventDatabases, eventNames, eventTypes := make([]string, m), make([]string, m), make([]string, m)
+	starts := make([]time.Time, m)
+	durations, growthKBs := make([]int64, m), make([]int64, m)
+	for i, e := range stats.GrowthEvents {
+		eventDatabases[i] = e.DatabaseName
+		eventNames[i] = e.LogicalName
+		starts[i] = e.StartTime.In(time.UTC)
+		eventTypes[i] = e.FileType
+		durations[i] = e.DurationMs
+		growthKBs[i] = e.GrowthKB
+	}
+	// an agent restart reads the default trace again, the events already stored are skipped
+	_, err = tx.ExecContext(ctx, `insert into autogrowth_event (target_id, database_name, logical_name, start_time,
+                              file_type, duration_ms, growth_kb)
+select $1, e.*
+from unnest($2::text[], $3::text[], $4::timestamp[], $5::text[], $6::bigint[], $7::bigint[]) e
+on conflict do nothing`,
+		targetID, pq.Array(eventDatabases), pq.Array(eventNames), pq.Array(starts), pq.Array(eventTypes),
+		pq.Array(durations), pq.Array(growthKBs))
+	if err != nil {
+		return fmt.Errorf("insert autogrowth events: %w", err)
+	}
+	return nil
+}
+
+// GetFileSizes returns the size history of each file in the range, the capacity and names are the ones of the latest
+// reading. An empty database selects every database.
+func (p *PostgresRepo) GetFileSizes(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]*common_domain.FileGrowthTrend, error) {
+	ctx, span := p.tracer.Start(ctx, "GetFileSizes")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.FileGrowthTrend{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select database_name,
+       file_id,
+       logical_name,
+       physical_name,
+       file_type,
+       collected_at,
+       size_kb,
+       used_kb,
+       max_size_kb,
+       growth,
+       is_percent_growth,
+       volume_free_kb
+from database_file_size
+where target_id = $1
+  and collected_at between $2 and $3
+  and ($4 = '' or database_name = $4)
+order by database_name, file_id, collected_at`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), database)
+	if err != nil {
+		return nil, fmt.Errorf("get file sizes: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.FileGrowthTrend, 0)
+	var current *common_domain.FileGrowthTrend
+	for rows.Next() {
+		var f common_domain.DatabaseFileSize
+		var collectedAt time.Time
+		err = rows.Scan(&f.DatabaseName, &f.FileID, &f.LogicalName, &f.PhysicalName, &f.FileType, &collectedAt,
+			&f.SizeKB, &f.UsedKB, &f.MaxSizeKB, &f.Growth, &f.IsPercentGrowth, &f.VolumeFreeKB)
+		if err != nil {
+			return nil, fmt.Errorf("get file sizes scan: %w", err)
+		}
+		if current == nil || current.DatabaseName != f.DatabaseName || current.FileID != f.FileID {
+			current = &common_domain.FileGrowthTrend{DatabaseName: f.DatabaseName, FileID: f.FileID}
+			ret = append(ret, current)
+		}
+		current.LogicalName, current.PhysicalName, current.FileType = f.LogicalName, f.PhysicalName, f.FileType
+		current.CapacityKB = f.CapacityKB()
+		current.Points = append(current.Points, common_domain.FileSizePoint{Timestamp: collectedAt, SizeKB: f.SizeKB,
+			UsedKB: f.UsedKB})
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get file sizes rows: %w", err)
+	}
+	return ret, nil
+}
+
+// GetAutogrowthEvents returns the autogrowth events started in the range ordered by start time, an empty database
+// selects every database
+func (p *PostgresRepo) GetAutogrowthEvents(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.AutogrowthEvent, error) {
+	ctx, span := p.tracer.Start(ctx, "GetAutogrowthEvents")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []common_domain.AutogrowthEvent{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select database_name, logical_name, file_type, start_time, duration_ms, growth_kb
+from autogrowth_event
+where target_id = $1
+  and start_time between $2 and $3
+  and ($4 = '' or database_name = $4)
+order by start_time`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), database)
+	if err != nil {
+		return nil, fmt.Errorf("get autogrowth events: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.AutogrowthEvent, 0)
+	for rows.Next() {
+		var e common_domain.AutogrowthEvent
+		err = rows.Scan(&e.DatabaseName, &e.LogicalName, &e.FileType, &e.StartTime, &e.DurationMs, &e.GrowthKB)
+		if err != nil {
+			return nil, fmt.Errorf("get autogrowth events scan: %w", err)
+		}
+		ret = append(ret, e)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get autogrowth events rows: %w", err)
+	}
+	return ret, nil
+}
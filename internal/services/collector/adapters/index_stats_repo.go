@@ -0,0 +1,288 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *PostgresRepo) StoreIndexStats(ctx context.Context, stats common_domain.IndexStats) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreIndexStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", stats.Server.Host), attribute.Int("indexes", len(stats.Indexes)),
+		attribute.Int("missing_indexes", len(stats.MissingIndexes)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, stats.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	collectedAt := stats.Timestamp.In(time.UTC)
+	err = p.storeIndexUsage(ctx, tx, targetID, collectedAt, stats.Indexes)
+	if err != nil {
+		return fmt.Errorf("insert index usage: %w", err)
+	}
+	err = p.storeMissingIndexes(ctx, tx, targetID, collectedAt, stats.MissingIndexes)
+	if err != nil {
+		return fmt.Errorf("insert missing indexes: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) storeIndexUsage(ctx context.Context, tx *sqlx.Tx, targetID int, collectedAt time.Time, indexes []common_domain.IndexUsage) error {
+	n := len(indexes)
+	databases, schemas, tables, names, types := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	indexIDs, sizes, rowCounts := make([]int64, n), make([]int64, n), make([]int64, n)
+	isUnique, isPrimaryKey := make([]bool, n), make([]bool, n)
+	seeks, scans, lookups, updates := make([]int64, n), make([]int64, n), make([]int64, n), make([]int64, n)
+	lastReads, lastUpdates := make([]sql.NullTime, n), make([]sql.NullTime, n)
+	for i, index := range indexes {
+		databases[i] = index.DatabaseName
+		schemas[i] = index.SchemaName
+		tables[i] = index.TableName
+		names[i] = index.IndexName
+		indexIDs[i] = index.IndexID
+		types[i] = index.IndexType
+		isUnique[i] = index.IsUnique
+		isPrimaryKey[i] = index.IsPrimaryKey
+		seeks[i] = index.UserSeeks
+		scans[i] = index.UserScans
+		lookups[i] = index.UserLookups
+		updates[i] = index.UserUpdates
+		lastReads[i] = nullTime(index.LastUserRead)
+		lastUpdates[i] = nullTime(index.LastUserUpdate)
+		sizes[i] = index.SizeKB
+		rowCounts[i] = index.RowCount
+	}
+	_, err := tx.ExecContext(ctx, `insert into index_usage_stats (target_id, collected_at, database_name, schema_name,
+                               table_name, index_name, index_id, index_type, is_unique, is_primary_key,
+                               user_seeks, user_scans, user_lookups, user_updates, last_user_read,
+                               last_user_update, size_kb, row_count)
+select $1, $2, i.*
+from unnest($3::text[], $4::text[], $5::text[], $6::text[], $7::int[], $8::text[], $9::boolean[], $10::boolean[],
+            $11::bigint[], $12::bigint[], $13::bigint[], $14::bigint[], $15::timestamp[], $16::timestamp[],
+            $17::bigint[], $18::bigint[]) i
+on conflict (target_id, collected_at, database_name, schema_name, table_name, index_name) do update set index_id         = excluded.index_id,
+                                                                                                     index_type       = excluded.index_type,
+                                                                                                     is_unique        = excluded.is_unique,
+                                                                                                     is_primary_key   = excluded.is_primary_key,
+                                                                                                     user_seeks       = excluded.user_seeks,
+                                                                                                     user_scans       = excluded.user_scans,
+                                                                                                     user_lookups     = excluded.user_lookups,
+                                                                                                     user_updates     = excluded.user_updates,
+                                                                                                     last_user_read   = excluded.last_user_read,
+                                                                                                     last_user_update = excluded.last_user_update,
+                                                                                                     size_kb          = excluded.size_kb,
+                                                                                                     row_count        = excluded.row_count`,
+		targetID, collectedAt, pq.Array(databases), pq.Array(schemas), pq.Array(tables), pq.Array(names),
+		pq.Array(indexIDs), pq.Array(types), pq.Array(isUnique), pq.Array(isPrimaryKey), pq.Array(seeks),
+		pq.Array(scans), pq.Array(lookups), pq.Array(updates), pq.Array(lastReads), pq.Array(lastUpdates),
+		pq.Array(sizes), pq.Array(rowCounts))
+	return err
+}
+
+// storeMissingIndexes replaces the suggestions of the collection, they have no natural key
+func (p *PostgresRepo) storeMissingIndexes(ctx context.Context, tx *sqlx.Tx, targetID int, collectedAt time.Time, missing []common_domain.MissingIndex) error {
+	_, err := tx.ExecContext(ctx, `delete from missing_index_stats where target_id = $1 and collected_at = $2`,
+		targetID, collectedAt)
+	if err != nil {
+		return err
+	}
+	n := len(missing)
+	databases, tables, equality, inequality, included := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	compiles, seeks, scans := make([]int64, n), make([]int64, n), make([]int64, n)
+	costs, impacts := make([]float64, n), make([]float64, n)
+	lastSeeks := make([]sql.NullTime, n)
+	for i, m := range missing {
+		databases[i] = m.DatabaseName
+		tables[i] = m.TableName
+		equality[i] = m.EqualityColumns
+		inequality[i] = m.InequalityColumns
+		included[i] = m.IncludedColumns
+		compiles[i] = m.UniqueCompiles
+		seeks[i] = m.UserSeeks
+		scans[i] = m.UserScans
+		costs[i] = m.AvgTotalUserCost
+		impacts[i] = m.AvgUserImpact
+		lastSeeks[i] = nullTime(m.LastUserSeek)
+	}
+	_, err = tx.ExecContext(ctx, `insert into missing_index_stats (target_id, collected_at, database_name, table_name,
+                                 equality_columns, inequality_columns, included_columns, unique_compiles,
+                                 user_seeks, user_scans, avg_total_user_cost, avg_user_impact, last_user_seek)
+select $1, $2, m.*
+from unnest($3::text[], $4::text[], $5::text[], $6::text[], $7::text[], $8::bigint[], $9::bigint[], $10::bigint[],
+            $11::float8[], $12::float8[], $13::timestamp[]) m`,
+		targetID, collectedAt, pq.Array(databases), pq.Array(tables), pq.Array(equality), pq.Array(inequality),
+		pq.Array(included), pq.Array(compiles), pq.Array(seeks), pq.Array(scans), pq.Array(costs), pq.Array(impacts),
+		pq.Array(lastSeeks))
+	return err
+}
+
+// GetIndexUsage returns the first and the last index usage collections of the range, first is nil when the range
+// holds a single collection
+func (p *PostgresRepo) GetIndexUsage(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.IndexUsage, []common_domain.IndexUsage, error) {
+	ctx, span := p.tracer.Start(ctx, "GetIndexUsage")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID), attribute.String("database", database))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, []common_domain.IndexUsage{}, nil
+		}
+		return nil, nil, fmt.Errorf("get target id: %w", err)
+	}
+	var first, last sql.NullTime
+	err = p.db.QueryRowContext(ctx, `select min(collected_at), max(collected_at)
+from index_usage_stats
+where target_id = $1
+  and collected_at between $2 and $3`, targetID, start.In(time.UTC), end.In(time.UTC)).Scan(&first, &last)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get index usage collections: %w", err)
+	}
+	if !last.Valid {
+		return nil, []common_domain.IndexUsage{}, nil
+	}
+	lastUsage, err := p.getIndexUsageAt(ctx, targetID, database, last.Time)
+	if err != nil {
+		return nil, nil, err
+	}
+	if first.Time.Equal(last.Time) {
+		return nil, lastUsage, nil
+	}
+	firstUsage, err := p.getIndexUsageAt(ctx, targetID, database, first.Time)
+	if err != nil {
+		return nil, nil, err
+	}
+	return firstUsage, lastUsage, nil
+}
+
+func (p *PostgresRepo) getIndexUsageAt(ctx context.Context, targetID int, database string, collectedAt time.Time) ([]common_domain.IndexUsage, error) {
+	q := `select database_name,
+       schema_name,
+       table_name,
+       index_name,
+       index_id,
+       index_type,
+       is_unique,
+       is_primary_key,
+       user_seeks,
+       user_scans,
+       user_lookups,
+       user_updates,
+       last_user_read,
+       last_user_update,
+       size_kb,
+       row_count
+from index_usage_stats
+where target_id = $1
+  and collected_at = $2
+  and ($3 = '' or database_name = $3)
+order by database_name, schema_name, table_name, index_name`
+	rows, err := p.db.QueryContext(ctx, q, targetID, collectedAt, database)
+	if err != nil {
+		return nil, fmt.Errorf("get index usage: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.IndexUsage, 0)
+	for rows.Next() {
+		var index common_domain.IndexUsage
+		var lastRead, lastUpdate sql.NullTime
+		err = rows.Scan(&index.DatabaseName, &index.SchemaName, &index.TableName, &index.IndexName, &index.IndexID,
+			&index.IndexType, &index.IsUnique, &index.IsPrimaryKey, &index.UserSeeks, &index.UserScans,
+			&index.UserLookups, &index.UserUpdates, &lastRead, &lastUpdate, &index.SizeKB, &index.RowCount)
+		if err != nil {
+			return nil, fmt.Errorf("get index usage scan: %w", err)
+		}
+		index.LastUserRead = lastRead.Time
+		index.LastUserUpdate = lastUpdate.Time
+		ret = append(ret, index)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get index usage rows: %w", err)
+	}
+	return ret, nil
+}
+
+// GetMissingIndexes returns the suggestions of the last collection of the range, they are cumulative since the
+// server started
+func (p *PostgresRepo) GetMissingIndexes(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.MissingIndex, error) {
+	ctx, span := p.tracer.Start(ctx, "GetMissingIndexes")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID), attribute.String("database", database))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []common_domain.MissingIndex{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select database_name,
+       table_name,
+       equality_columns,
+       inequality_columns,
+       included_columns,
+       unique_compiles,
+       user_seeks,
+       user_scans,
+       avg_total_user_cost,
+       avg_user_impact,
+       last_user_seek
+from missing_index_stats
+where target_id = $1
+  and collected_at = (select max(collected_at)
+                      from missing_index_stats
+                      where target_id = $1
+                        and collected_at between $2 and $3)
+  and ($4 = '' or database_name = $4)`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), database)
+	if err != nil {
+		return nil, fmt.Errorf("get missing indexes: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.MissingIndex, 0)
+	for rows.Next() {
+		var m common_domain.MissingIndex
+		var lastSeek sql.NullTime
+		err = rows.Scan(&m.DatabaseName, &m.TableName, &m.EqualityColumns, &m.InequalityColumns, &m.IncludedColumns,
+			&m.UniqueCompiles, &m.UserSeeks, &m.UserScans, &m.AvgTotalUserCost, &m.AvgUserImpact, &lastSeek)
+		if err != nil {
+			return nil, fmt.Errorf("get missing indexes scan: %w", err)
+		}
+		m.LastUserSeek = lastSeek.Time
+		ret = append(ret, m)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get missing indexes rows: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,135 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StoreJobRuns upserts the job runs of a target, a running run is replaced by its outcome. Every read carries all the
+// runs in progress, the running runs missing from it stopped without an outcome (history purged, agent restarted)
+// and are removed.
+func (p *PostgresRepo) StoreJobRuns(ctx context.Context, stats common_domain.JobStats) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreJobRuns")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", stats.Server.Host), attribute.Int("runs", len(stats.Runs)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, stats.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	n := len(stats.Runs)
+	jobIDs, names, statuses, messages, steps := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	starts, ends := make([]time.Time, n), make([]time.Time, n)
+	durations := make([]int64, n)
+	runningJobIDs, runningStarts := make([]string, 0), make([]time.Time, 0)
+	for i, run := range stats.Runs {
+		jobIDs[i] = run.JobID
+		names[i] = run.JobName
+		statuses[i] = run.Status
+		starts[i] = run.StartTime.In(time.UTC)
+		durations[i] = run.DurationMs
+		ends[i] = run.EndTime().In(time.UTC)
+		messages[i] = run.Message
+		steps[i] = run.CurrentStep
+		if run.Status == common_domain.JobRunRunning {
+			runningJobIDs = append(runningJobIDs, run.JobID)
+			runningStarts = append(runningStarts, starts[i])
+		}
+	}
+	_, err = tx.ExecContext(ctx, `insert into job_run (target_id, job_id, job_name, status, start_time, duration_ms, end_time,
+                     message, current_step)
+select $1, jr.*
+from unnest($2::uuid[], $3::text[], $4::text[], $5::timestamp[], $6::bigint[], $7::timestamp[], $8::text[],
+            $9::text[]) jr
+on conflict (target_id, job_id, start_time) do update set job_name     = excluded.job_name,
+                                                          status       = excluded.status,
+                                                          duration_ms  = excluded.duration_ms,
+                                                          end_time     = excluded.end_time,
+                                                          message      = excluded.message,
+                                                          current_step = excluded.current_step`,
+		targetID, pq.Array(jobIDs), pq.Array(names), pq.Array(statuses), pq.Array(starts), pq.Array(durations),
+		pq.Array(ends), pq.Array(messages), pq.Array(steps))
+	if err != nil {
+		return fmt.Errorf("upsert job runs: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `delete
+from job_run jr
+where jr.target_id = $1
+  and jr.status = $2
+  and not exists (select 1
+                  from unnest($3::uuid[], $4::timestamp[]) r(job_id, start_time)
+                  where r.job_id = jr.job_id
+                    and r.start_time = jr.start_time)`,
+		targetID, common_domain.JobRunRunning, pq.Array(runningJobIDs), pq.Array(runningStarts))
+	if err != nil {
+		return fmt.Errorf("delete stopped job runs: %w", err)
+	}
+	return nil
+}
+
+// ListJobRuns returns the job runs overlapping the range ordered by start time
+func (p *PostgresRepo) ListJobRuns(ctx context.Context, serverID string, start time.Time, end time.Time) ([]common_domain.JobRun, error) {
+	ctx, span := p.tracer.Start(ctx, "ListJobRuns")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []common_domain.JobRun{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select job_id::text, job_name, status, start_time, duration_ms, message, current_step
+from job_run
+where target_id = $1
+  and start_time <= $3
+  and end_time >= $2
+order by start_time, job_name`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("list job runs: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.JobRun, 0)
+	for rows.Next() {
+		var run common_domain.JobRun
+		err = rows.Scan(&run.JobID, &run.JobName, &run.Status, &run.StartTime, &run.DurationMs, &run.Message,
+			&run.CurrentStep)
+		if err != nil {
+			return nil, fmt.Errorf("list job runs scan: %w", err)
+		}
+		ret = append(ret, run)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("list job runs rows: %w", err)
+	}
+	return ret, nil
+}
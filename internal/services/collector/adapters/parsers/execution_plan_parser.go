@@ -3,8 +3,11 @@ package parsers
 import (
 	"encoding/xml"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain/converters"
 	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
 )
 
@@ -483,6 +486,7 @@ func PlanToProto(handle string, server common_domain.ServerMeta, plan *ParsedExe
 				NodeCost:      stmt.StatementSubTreeCost,
 				Header:        &dbmv1.PlanNode_Header{},
 				Nodes:         []*dbmv1.PlanNode{relOpToProtoNode(stmt.QueryPlan.RelOp)},
+				NodeId:        -1,
 			}
 			nodes = append(nodes, &baseNode)
 
@@ -519,6 +523,9 @@ func relOpToProtoNode(n RelOp) *dbmv1.PlanNode {
 		},
 		Nodes: make([]*dbmv1.PlanNode, 0),
 	}
+	if nodeID, err := strconv.ParseInt(n.NodeId, 10, 64); err == nil {
+		baseNode.NodeId = nodeID
+	}
 
 	for _, c := range n.GetAllChildren() {
 		baseNode.Nodes = append(baseNode.Nodes, relOpToProtoNode(c))
@@ -526,3 +533,39 @@ func relOpToProtoNode(n RelOp) *dbmv1.PlanNode {
 
 	return baseNode
 }
+
+// OverlayLiveProgress attaches the live progress of a running request to the operators of the statement it runs.
+// Node ids restart on every statement of a batch, the statement is the first one having every node of the progress
+// with the same physical operator. It returns false when no statement matches.
+func OverlayLiveProgress(plan *dbmv1.ParsedExecutionPlan, progress []common_domain.OperatorProgress) bool {
+	if plan == nil || len(progress) == 0 {
+		return false
+	}
+	for _, statement := range plan.Nodes {
+		byID := make(map[int64]*dbmv1.PlanNode)
+		indexPlanNodes(statement.Nodes, byID)
+		matches := true
+		for _, p := range progress {
+			node, ok := byID[p.NodeID]
+			if !ok || !strings.EqualFold(node.GetHeader().GetPhysicalOp(), p.PhysicalOperator) {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		for _, p := range converters.OperatorProgressToProto(progress) {
+			byID[p.NodeId].LiveProgress = p
+		}
+		return true
+	}
+	return false
+}
+
+func indexPlanNodes(nodes []*dbmv1.PlanNode, byID map[int64]*dbmv1.PlanNode) {
+	for _, node := range nodes {
+		byID[node.NodeId] = node
+		indexPlanNodes(node.Nodes, byID)
+	}
+}
@@ -111,3 +111,29 @@ func TestParseExecutionPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestOverlayLiveProgress(t *testing.T) {
+	data, err := testData.ReadFile("testdata/nested_loops_plan.xml")
+	require.NoError(t, err)
+	parsed, err := parsers.ParseExecutionPlan(string(data))
+	require.NoError(t, err)
+	protoParsed, err := parsers.PlanToProto("aaa", common_domain.ServerMeta{Host: "server"}, parsed)
+	require.NoError(t, err)
+
+	progress := []common_domain.OperatorProgress{
+		{NodeID: 0, PhysicalOperator: "Filter", RowCount: 10, Active: true},
+		{NodeID: 3, PhysicalOperator: "Hash Match", RowCount: 5000, EstimatedRowCount: 100, Active: true},
+	}
+	require.True(t, parsers.OverlayLiveProgress(protoParsed, progress))
+	byID := make(map[int64]*dbmv1.PlanNode)
+	for _, node := range fetchChildNodes(protoParsed.Nodes[0]) {
+		byID[node.NodeId] = node
+	}
+	require.Equal(t, int64(10), byID[0].GetLiveProgress().GetRowCount())
+	require.Equal(t, int64(5000), byID[3].GetLiveProgress().GetRowCount())
+	require.Nil(t, byID[1].GetLiveProgress())
+
+	require.False(t, parsers.OverlayLiveProgress(protoParsed, []common_domain.OperatorProgress{
+		{NodeID: 3, PhysicalOperator: "Sort"},
+	}))
+}
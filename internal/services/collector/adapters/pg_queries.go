@@ -17,8 +17,23 @@ import (
 )
 
 func (p *PostgresRepo) ListServers(ctx context.Context, start time.Time, end time.Time) ([]domain.ServerSummary, error) {
-	q := `select distinct t.host, t.type_id  from snapshot s 
-    inner join public.target t on t.id = s.target_id where snap_time between $1 and $2`
+	// the request rate is the average of batch requests/sec in the range, the connections the last reading
+	q := `select t.host, tt.dsc_type, coalesce(rr.rate, 0), coalesce(uc.value, 0)
+from target t
+         inner join target_type tt on tt.id = t.type_id
+         left join lateral (select avg(pc.rate) as rate
+                            from performance_counters pc
+                            where pc.target_id = t.id
+                              and pc.counter_name = 'Batch Requests/sec'
+                              and pc.collected_at between $1 and $2) rr on true
+         left join lateral (select pc.value
+                            from performance_counters pc
+                            where pc.target_id = t.id
+                              and pc.counter_name = 'User Connections'
+                              and pc.collected_at between $1 and $2
+                            order by pc.collected_at desc
+                            limit 1) uc on true
+where exists (select 1 from snapshot s where s.target_id = t.id and s.snap_time between $1 and $2)`
 	rows, err := p.db.QueryContext(ctx, q, start, end)
 	if err != nil {
 		return nil, fmt.Errorf("listing servers: %w", err)
@@ -27,16 +42,18 @@ func (p *PostgresRepo) ListServers(ctx context.Context, start time.Time, end tim
 	servers := make([]domain.ServerSummary, 0)
 	for rows.Next() {
 		var name string
-		var typeID int
-		err = rows.Scan(&name, &typeID)
+		var targetType string
+		var requestRate float64
+		var connections int
+		err = rows.Scan(&name, &targetType, &requestRate, &connections)
 		if err != nil {
 			return nil, fmt.Errorf("listing servers scan: %w", err)
 		}
 		servers = append(servers, domain.ServerSummary{
 			Name:             name,
-			Type:             "mssql",
-			Connections:      0,
-			RequestRate:      0,
+			Type:             targetType,
+			Connections:      connections,
+			RequestRate:      requestRate,
 			ConnsByWaitGroup: make(map[string]int32),
 		})
 	}
@@ -50,14 +67,15 @@ func (p *PostgresRepo) ListSnapshots(ctx context.Context, databaseID string, sta
 	//language=SQL
 	q := fmt.Sprintf(`
 with snapinfos as (
-	select s.id, s.f_id, s.snap_time, t.host, t.type_id, count(*) OVER() AS full_count from snapshot s
+	select s.id, s.f_id, s.snap_time, t.host, tt.dsc_type, count(*) OVER() AS full_count from snapshot s
 	inner join public.target t on t.id = s.target_id
+	inner join public.target_type tt on tt.id = t.type_id
 	where s.snap_time between $1 and $2 and t.host = $3
 	order by s.snap_time desc
 	offset %d rows limit %d
 )
 
-select si.f_id, si.snap_time, si.host, si.type_id, qs.f_id as qfid, qs.data, full_count from snapinfos si
+select si.f_id, si.snap_time, si.host, si.dsc_type, qs.f_id as qfid, qs.data, full_count from snapinfos si
 inner join query_samples qs on qs.snap_id = si.id
 
 
@@ -91,12 +109,12 @@ func parseSnapshotRows(rows *sql.Rows) (int, []common_domain.DataBaseSnapshot, e
 	var fullCount int
 	for rows.Next() {
 		var sId string
-		var qId string
+		var qId sql.NullString
 		var snapTime time.Time
 		var host string
-		var typeID int
+		var targetType string
 		var queryData []byte
-		err = rows.Scan(&sId, &snapTime, &host, &typeID, &qId, &queryData, &fullCount)
+		err = rows.Scan(&sId, &snapTime, &host, &targetType, &qId, &queryData, &fullCount)
 		if err != nil {
 			return 0, nil, fmt.Errorf("listing snapshots: %w", err)
 		}
@@ -105,15 +123,19 @@ func parseSnapshotRows(rows *sql.Rows) (int, []common_domain.DataBaseSnapshot, e
 			Timestamp: snapTime,
 			Server: common_domain.ServerMeta{
 				Host: host,
-				Type: "mssql",
+				Type: targetType,
 			},
 		}
+		if !qId.Valid {
+			// snapshot without samples, only its transactions were taken
+			continue
+		}
 		proto := dbmv1.QuerySample{}
 		err = proto.UnmarshalVT(queryData)
 		if err != nil {
 			return 0, nil, fmt.Errorf("listing snapshots unmarshal proto: %w", err)
 		}
-		proto.Id = qId
+		proto.Id = qId.String
 		toDomain := converters.SampleToDomain(&proto)
 		_, ok := queriesBySnapId[sId]
 		if !ok {
@@ -139,9 +161,10 @@ func parseSnapshotRows(rows *sql.Rows) (int, []common_domain.DataBaseSnapshot, e
 }
 
 func (p *PostgresRepo) GetSnapshot(ctx context.Context, id string) (common_domain.DataBaseSnapshot, error) {
-	q := `select s.f_id, s.snap_time, t.host, t.type_id, qs.f_id as sid, qs.data, count(*) OVER() AS full_count from snapshot s
+	q := `select s.f_id, s.snap_time, t.host, tt.dsc_type, qs.f_id as sid, qs.data, count(*) OVER() AS full_count from snapshot s
 inner join public.target t on t.id = s.target_id
-inner join public.query_samples qs on s.id = qs.snap_id
+inner join public.target_type tt on tt.id = t.type_id
+left join public.query_samples qs on s.id = qs.snap_id
 where s.f_id = $1`
 	rows, err := p.db.QueryContext(ctx, q, id)
 	if err != nil {
@@ -158,7 +181,12 @@ where s.f_id = $1`
 	if len(snapshots) == 0 {
 		return common_domain.DataBaseSnapshot{}, custom_errors.NotFoundErr{Message: fmt.Sprintf("snapshot %s not found", id)}
 	}
-	return snapshots[0], nil
+	snapshot := snapshots[0]
+	snapshot.Transactions, snapshot.VersionStoreKB, err = p.getSnapshotTransactions(ctx, id)
+	if err != nil {
+		return common_domain.DataBaseSnapshot{}, fmt.Errorf("getting snapshot %s transactions: %w", id, err)
+	}
+	return snapshot, nil
 }
 
 func (p *PostgresRepo) GetExecutionPlan(ctx context.Context, planHandle string, server *common_domain.ServerMeta) (*common_domain.ExecutionPlan, error) {
@@ -274,15 +302,16 @@ func (p *PostgresRepo) GetQuerySample(ctx context.Context, snapID string, sample
 }
 
 func (p *PostgresRepo) ListSnapshotSummaries(ctx context.Context, serverID string, start time.Time, end time.Time) ([]common_domain.SnapshotSummary, error) {
-	q := `select s.snap_time, s.f_id, t.host, t.type_id, qs.wait_event, count(qs.id), sum(qs.wait_time),
+	q := `select s.snap_time, s.f_id, t.host, tt.dsc_type, qs.wait_event, count(qs.id), sum(qs.wait_time),
        sum(case when blocked=true then 1 else 0 end) as waiters,
        sum(case when blocker=true then 1 else 0 end) as blockers ,
        sum(case when blocked=true then wait_time else 0 end) as waiter_time,
        sum(case when blocker=true then block_ms else 0 end) as blocker_time from snapshot s
 inner join public.query_samples qs on s.id = qs.snap_id
          inner join target t on s.target_id = t.id
+         inner join target_type tt on tt.id = t.type_id
 where t.host = $1 and snap_time between $2 and $3
-group by s.snap_time, s.f_id, t.host, t.type_id, qs.wait_event`
+group by s.snap_time, s.f_id, t.host, tt.dsc_type, qs.wait_event`
 	rows, err := p.db.QueryContext(ctx, q, serverID, start, end)
 	if err != nil {
 		return nil, fmt.Errorf("listing snapshot summaries: %w", err)
@@ -307,7 +336,7 @@ group by s.snap_time, s.f_id, t.host, t.type_id, qs.wait_event`
 		var snapTime time.Time
 		var snapID string
 		var host string
-		var typeID int
+		var targetType string
 		var waitEvent string
 		var count int64
 		var waitTime int64
@@ -315,7 +344,7 @@ group by s.snap_time, s.f_id, t.host, t.type_id, qs.wait_event`
 		var blockers int64
 		var waiterTime int64
 		var blockerTime int64
-		err = rows.Scan(&snapTime, &snapID, &host, &typeID, &waitEvent, &count, &waitTime,
+		err = rows.Scan(&snapTime, &snapID, &host, &targetType, &waitEvent, &count, &waitTime,
 			&waiters, &blockers, &waiterTime, &blockerTime)
 		if err != nil {
 			return nil, fmt.Errorf("listing snapshot summaries scan: %w", err)
@@ -330,7 +359,7 @@ group by s.snap_time, s.f_id, t.host, t.type_id, qs.wait_event`
 				timestamp: snapTime,
 				server: common_domain.ServerMeta{
 					Host: host,
-					Type: "mssql",
+					Type: targetType,
 				},
 			}
 		}
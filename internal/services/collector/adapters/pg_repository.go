@@ -97,7 +97,7 @@ func (p *PostgresRepo) StoreSnapshot(ctx context.Context, snapshot common_domain
 		}
 	}()
 	var snapId int
-	snapId, err = p.insertSnapshot(ctx, tx, &snapshot.SnapInfo)
+	snapId, err = p.insertSnapshot(ctx, tx, &snapshot.SnapInfo, snapshot.VersionStoreKB)
 	if err != nil {
 		return fmt.Errorf("insert snapshot: %w", err)
 	}
@@ -105,17 +105,21 @@ func (p *PostgresRepo) StoreSnapshot(ctx context.Context, snapshot common_domain
 	if err != nil {
 		return fmt.Errorf("insert samples: %w", err)
 	}
+	err = p.insertSnapshotTransactions(ctx, tx, snapshot.Transactions, snapId)
+	if err != nil {
+		return fmt.Errorf("insert transactions: %w", err)
+	}
 	return nil
 
 }
 
 // insertSnapshot inserts a single snapshot and returns the generated ID
-func (p *PostgresRepo) insertSnapshot(ctx context.Context, tx *sqlx.Tx, snapshot *common_domain.SnapInfo) (int, error) {
+func (p *PostgresRepo) insertSnapshot(ctx context.Context, tx *sqlx.Tx, snapshot *common_domain.SnapInfo, versionStoreKB int64) (int, error) {
 	ctx, span := p.tracer.Start(ctx, "insertSnapshot")
 	defer span.End()
 	query := `
-		INSERT INTO snapshot (f_id, snap_time, target_id)
-		VALUES ($1, $2, $3)
+		INSERT INTO snapshot (f_id, snap_time, target_id, version_store_kb)
+		VALUES ($1, $2, $3, $4)
 		RETURNING id
 	`
 	targetId, err := p.getOrCreateTargetID(ctx, tx, snapshot.Server)
@@ -123,7 +127,7 @@ func (p *PostgresRepo) insertSnapshot(ctx context.Context, tx *sqlx.Tx, snapshot
 		return 0, fmt.Errorf("get target id: %w", err)
 	}
 	var id int
-	err = tx.QueryRowxContext(ctx, query, snapshot.ID, snapshot.Timestamp.In(time.UTC), targetId).Scan(&id)
+	err = tx.QueryRowxContext(ctx, query, snapshot.ID, snapshot.Timestamp.In(time.UTC), targetId, versionStoreKB).Scan(&id)
 	if err != nil {
 		return 0, err
 	}
@@ -296,8 +300,11 @@ func (p *PostgresRepo) getOrCreateTargetID(ctx context.Context, tx *sqlx.Tx, ser
 func (p *PostgresRepo) getTargetID(ctx context.Context, tx sqlx.QueryerContext, server common_domain.ServerMeta) (int, error) {
 	ctx, span := p.tracer.Start(ctx, "getTargetID")
 	defer span.End()
-	q := "select id from target where host = $1 and type_id = 1;"
-	rows := tx.QueryRowxContext(ctx, q, server.Host)
+	// callers that only know the host (warnings, plan lookups) leave the type empty
+	q := `select t.id from target t
+    inner join target_type tt on tt.id = t.type_id
+where t.host = $1 and ($2 = '' or tt.dsc_type = $2);`
+	rows := tx.QueryRowxContext(ctx, q, server.Host, server.Type)
 	err := rows.Err()
 	if err != nil {
 		return 0, fmt.Errorf("getTargetID: %w", err)
@@ -317,11 +324,18 @@ func (p *PostgresRepo) getTargetID(ctx context.Context, tx sqlx.QueryerContext,
 func (p *PostgresRepo) createTargetID(ctx context.Context, tx *sqlx.Tx, server common_domain.ServerMeta) (int, error) {
 	ctx, span := p.tracer.Start(ctx, "createTargetID")
 	defer span.End()
-	q := "insert into target (host, type_id, agent_version) values ($1, $2, $3);"
-	_, err := tx.ExecContext(ctx, q, server.Host, 1, "-")
+	if server.Type == "" {
+		server.Type = "mssql"
+	}
+	q := `insert into target (host, type_id, agent_version)
+select $1, tt.id, $3 from target_type tt where tt.dsc_type = $2;`
+	res, err := tx.ExecContext(ctx, q, server.Host, server.Type, "-")
 	if err != nil {
 		return 0, fmt.Errorf("createTargetID: %w", err)
 	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return 0, fmt.Errorf("createTargetID: unknown target type %s", server.Type)
+	}
 	return p.getTargetID(ctx, tx, server)
 
 }
@@ -464,6 +478,189 @@ delete from query_stat_snapshot using rows_to_delete where query_stat_snapshot.C
 		}
 		rowsAffected, _ = r.RowsAffected()
 	}
+	// language=SQL
+	queryWaitStats := `
+with rows_to_delete as (
+    select CTID from wait_stats
+where collected_at between  $1 and $2
+limit $3
+)
+delete from wait_stats using rows_to_delete where wait_stats.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryWaitStats, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics wait stats: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryCounters := `
+with rows_to_delete as (
+    select CTID from performance_counters
+where collected_at between  $1 and $2
+limit $3
+)
+delete from performance_counters using rows_to_delete where performance_counters.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryCounters, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics performance counters: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryFileIO := `
+with rows_to_delete as (
+    select CTID from file_io_stats
+where collected_at between  $1 and $2
+limit $3
+)
+delete from file_io_stats using rows_to_delete where file_io_stats.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryFileIO, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics file io stats: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryReplicaStates := `
+with rows_to_delete as (
+    select CTID from ag_replica_states
+where collected_at between  $1 and $2
+limit $3
+)
+delete from ag_replica_states using rows_to_delete where ag_replica_states.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryReplicaStates, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics replica states: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	for _, table := range []string{"index_usage_stats", "missing_index_stats"} {
+		// language=SQL
+		queryIndexStats := fmt.Sprintf(`
+with rows_to_delete as (
+    select CTID from %[1]s
+where collected_at between  $1 and $2
+limit $3
+)
+delete from %[1]s using rows_to_delete where %[1]s.CTID = rows_to_delete.CTID`, table)
+		rowsAffected = int64(1)
+		for rowsAffected > 0 {
+			r, err := p.db.ExecContext(ctx, queryIndexStats, start, end, batchSize)
+			if err != nil {
+				return fmt.Errorf("purgeQueryMetrics %s: %w", table, err)
+			}
+			rowsAffected, _ = r.RowsAffected()
+		}
+	}
+	// language=SQL
+	queryRuntimeStats := `
+with rows_to_delete as (
+    select CTID from query_store_runtime_stats
+where interval_start between  $1 and $2
+limit $3
+)
+delete from query_store_runtime_stats using rows_to_delete where query_store_runtime_stats.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryRuntimeStats, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics query store runtime stats: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// plans are kept while they have runtime stats
+	// language=SQL
+	queryStorePlans := `
+with rows_to_delete as (
+    select qp.CTID from query_store_plan qp
+where not exists (select 1
+                  from query_store_runtime_stats rs
+                  where rs.target_id = qp.target_id
+                    and rs.database_name = qp.database_name
+                    and rs.plan_id = qp.plan_id)
+limit $1
+)
+delete from query_store_plan using rows_to_delete where query_store_plan.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryStorePlans, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics query store plans: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryJobRuns := `
+with rows_to_delete as (
+    select CTID from job_run
+where end_time between  $1 and $2
+limit $3
+)
+delete from job_run using rows_to_delete where job_run.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryJobRuns, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics job runs: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryFileSizes := `
+with rows_to_delete as (
+    select CTID from database_file_size
+where collected_at between  $1 and $2
+limit $3
+)
+delete from database_file_size using rows_to_delete where database_file_size.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryFileSizes, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics file sizes: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryAutogrowth := `
+with rows_to_delete as (
+    select CTID from autogrowth_event
+where start_time between  $1 and $2
+limit $3
+)
+delete from autogrowth_event using rows_to_delete where autogrowth_event.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryAutogrowth, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics autogrowth events: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
+	// language=SQL
+	queryDeadlocks := `
+with rows_to_delete as (
+    select CTID from deadlocks
+where event_time between  $1 and $2
+limit $3
+)
+delete from deadlocks using rows_to_delete where deadlocks.CTID = rows_to_delete.CTID`
+	rowsAffected = int64(1)
+	for rowsAffected > 0 {
+		r, err := p.db.ExecContext(ctx, queryDeadlocks, start, end, batchSize)
+		if err != nil {
+			return fmt.Errorf("purgeQueryMetrics deadlocks: %w", err)
+		}
+		rowsAffected, _ = r.RowsAffected()
+	}
 	return nil
 }
 func (p *PostgresRepo) PurgeAllQueryMetrics(ctx context.Context) error {
@@ -471,7 +668,9 @@ func (p *PostgresRepo) PurgeAllQueryMetrics(ctx context.Context) error {
 	defer span.End()
 	// language=SQL
 	query := `
-truncate table query_stat_snapshot cascade`
+truncate table query_stat_snapshot, wait_stats, performance_counters, file_io_stats, ag_replica_states,
+    index_usage_stats, missing_index_stats, query_store_runtime_stats, query_store_plan, job_run, database_file_size,
+    autogrowth_event, deadlocks cascade`
 	r, err := p.db.ExecContext(ctx, query)
 	if err != nil {
 		return fmt.Errorf("purgeQueryMetrics: %w", err)
@@ -0,0 +1,220 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *PostgresRepo) StoreQueryStoreStats(ctx context.Context, stats common_domain.QueryStoreStats) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreQueryStoreStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", stats.Server.Host), attribute.Int("plans", len(stats.Plans)),
+		attribute.Int("runtime_stats", len(stats.RuntimeStats)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, stats.Server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	err = p.storeQueryStorePlans(ctx, tx, targetID, stats.Plans)
+	if err != nil {
+		return fmt.Errorf("insert query store plans: %w", err)
+	}
+	err = p.storeQueryStoreRuntimeStats(ctx, tx, targetID, stats.RuntimeStats)
+	if err != nil {
+		return fmt.Errorf("insert query store runtime stats: %w", err)
+	}
+	return nil
+}
+
+// storeQueryStorePlans upserts the plans, the plan xml is kept when the agent did not send it again
+func (p *PostgresRepo) storeQueryStorePlans(ctx context.Context, tx *sqlx.Tx, targetID int, plans []common_domain.QueryStorePlan) error {
+	n := len(plans)
+	databases, queryHashes, planHashes, texts, xmls := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	planIDs, queryIDs := make([]int64, n), make([]int64, n)
+	forced := make([]bool, n)
+	firstCompiles, lastExecutions := make([]sql.NullTime, n), make([]sql.NullTime, n)
+	for i, plan := range plans {
+		databases[i] = plan.DatabaseName
+		planIDs[i] = plan.PlanID
+		queryIDs[i] = plan.QueryID
+		queryHashes[i] = plan.QueryHash
+		planHashes[i] = plan.QueryPlanHash
+		texts[i] = plan.QueryText
+		xmls[i] = plan.PlanXML
+		forced[i] = plan.IsForcedPlan
+		firstCompiles[i] = nullTime(plan.FirstCompileTime)
+		lastExecutions[i] = nullTime(plan.LastExecutionTime)
+	}
+	_, err := tx.ExecContext(ctx, `insert into query_store_plan (target_id, database_name, plan_id, query_id, query_hash,
+                              query_plan_hash, query_text, plan_xml, is_forced_plan, first_compile_time,
+                              last_execution_time)
+select $1, qp.*
+from unnest($2::text[], $3::bigint[], $4::bigint[], $5::text[], $6::text[], $7::text[], $8::text[], $9::boolean[],
+            $10::timestamp[], $11::timestamp[]) qp
+on conflict (target_id, database_name, plan_id) do update set query_id            = excluded.query_id,
+                                                              query_hash          = excluded.query_hash,
+                                                              query_plan_hash     = excluded.query_plan_hash,
+                                                              query_text          = excluded.query_text,
+                                                              plan_xml            = coalesce(nullif(excluded.plan_xml, ''),
+                                                                                             query_store_plan.plan_xml),
+                                                              is_forced_plan      = excluded.is_forced_plan,
+                                                              first_compile_time  = excluded.first_compile_time,
+                                                              last_execution_time = excluded.last_execution_time`,
+		targetID, pq.Array(databases), pq.Array(planIDs), pq.Array(queryIDs), pq.Array(queryHashes),
+		pq.Array(planHashes), pq.Array(texts), pq.Array(xmls), pq.Array(forced), pq.Array(firstCompiles),
+		pq.Array(lastExecutions))
+	return err
+}
+
+// storeQueryStoreRuntimeStats upserts the runtime stats, the interval open at the previous read replaces its
+// partial numbers
+func (p *PostgresRepo) storeQueryStoreRuntimeStats(ctx context.Context, tx *sqlx.Tx, targetID int, runtimeStats []common_domain.QueryStoreRuntimeStats) error {
+	n := len(runtimeStats)
+	databases := make([]string, n)
+	planIDs, executions, aborted := make([]int64, n), make([]int64, n), make([]int64, n)
+	starts, ends, lastExecutions := make([]time.Time, n), make([]time.Time, n), make([]sql.NullTime, n)
+	durations, maxDurations, cpu := make([]float64, n), make([]float64, n), make([]float64, n)
+	logicalReads, physicalReads, rowCounts := make([]float64, n), make([]float64, n), make([]float64, n)
+	for i, rs := range runtimeStats {
+		databases[i] = rs.DatabaseName
+		planIDs[i] = rs.PlanID
+		starts[i] = rs.IntervalStart.In(time.UTC)
+		ends[i] = rs.IntervalEnd.In(time.UTC)
+		executions[i] = rs.Executions
+		aborted[i] = rs.AbortedExecutions
+		durations[i] = rs.AvgDurationUs
+		maxDurations[i] = rs.MaxDurationUs
+		cpu[i] = rs.AvgCPUTimeUs
+		logicalReads[i] = rs.AvgLogicalReads
+		physicalReads[i] = rs.AvgPhysicalReads
+		rowCounts[i] = rs.AvgRowCount
+		lastExecutions[i] = nullTime(rs.LastExecutionTime)
+	}
+	_, err := tx.ExecContext(ctx, `insert into query_store_runtime_stats (target_id, database_name, plan_id, interval_start,
+                                       interval_end, executions, aborted_executions, avg_duration_us,
+                                       max_duration_us, avg_cpu_time_us, avg_logical_reads,
+                                       avg_physical_reads, avg_rowcount, last_execution_time)
+select $1, rs.*
+from unnest($2::text[], $3::bigint[], $4::timestamp[], $5::timestamp[], $6::bigint[], $7::bigint[], $8::float8[],
+            $9::float8[], $10::float8[], $11::float8[], $12::float8[], $13::float8[], $14::timestamp[]) rs
+on conflict (target_id, database_name, plan_id, interval_start) do update set interval_end        = excluded.interval_end,
+                                                                              executions          = excluded.executions,
+                                                                              aborted_executions  = excluded.aborted_executions,
+                                                                              avg_duration_us     = excluded.avg_duration_us,
+                                                                              max_duration_us     = excluded.max_duration_us,
+                                                                              avg_cpu_time_us     = excluded.avg_cpu_time_us,
+                                                                              avg_logical_reads   = excluded.avg_logical_reads,
+                                                                              avg_physical_reads  = excluded.avg_physical_reads,
+                                                                              avg_rowcount        = excluded.avg_rowcount,
+                                                                              last_execution_time = excluded.last_execution_time`,
+		targetID, pq.Array(databases), pq.Array(planIDs), pq.Array(starts), pq.Array(ends), pq.Array(executions),
+		pq.Array(aborted), pq.Array(durations), pq.Array(maxDurations), pq.Array(cpu), pq.Array(logicalReads),
+		pq.Array(physicalReads), pq.Array(rowCounts), pq.Array(lastExecutions))
+	return err
+}
+
+// GetQueryPlanHistory returns the plans of a query hash with their runtime stats in the range, plans without
+// executions in the range are left out
+func (p *PostgresRepo) GetQueryPlanHistory(ctx context.Context, serverID string, database string, queryHash string, start time.Time, end time.Time) ([]*common_domain.QueryPlanHistory, error) {
+	ctx, span := p.tracer.Start(ctx, "GetQueryPlanHistory")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID), attribute.String("query_hash", queryHash))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.QueryPlanHistory{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	q := `select qp.database_name,
+       qp.plan_id,
+       qp.query_id,
+       qp.query_hash,
+       qp.query_plan_hash,
+       qp.query_text,
+       qp.plan_xml,
+       qp.is_forced_plan,
+       qp.first_compile_time,
+       qp.last_execution_time,
+       rs.interval_start,
+       rs.interval_end,
+       rs.executions,
+       rs.aborted_executions,
+       rs.avg_duration_us,
+       rs.max_duration_us,
+       rs.avg_cpu_time_us,
+       rs.avg_logical_reads,
+       rs.avg_physical_reads,
+       rs.avg_rowcount,
+       rs.last_execution_time
+from query_store_plan qp
+         inner join query_store_runtime_stats rs
+                    on rs.target_id = qp.target_id and rs.database_name = qp.database_name and rs.plan_id = qp.plan_id
+where qp.target_id = $1
+  and qp.query_hash = $2
+  and ($3 = '' or qp.database_name = $3)
+  and rs.interval_start between $4 and $5
+order by qp.database_name, qp.plan_id, rs.interval_start`
+	rows, err := p.db.QueryContext(ctx, q, targetID, queryHash, database, start.In(time.UTC), end.In(time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("get query plan history: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.QueryPlanHistory, 0)
+	var current *common_domain.QueryPlanHistory
+	for rows.Next() {
+		var plan common_domain.QueryStorePlan
+		var rs common_domain.QueryStoreRuntimeStats
+		var firstCompile, planLastExecution, lastExecution sql.NullTime
+		err = rows.Scan(&plan.DatabaseName, &plan.PlanID, &plan.QueryID, &plan.QueryHash, &plan.QueryPlanHash,
+			&plan.QueryText, &plan.PlanXML, &plan.IsForcedPlan, &firstCompile, &planLastExecution, &rs.IntervalStart,
+			&rs.IntervalEnd, &rs.Executions, &rs.AbortedExecutions, &rs.AvgDurationUs, &rs.MaxDurationUs,
+			&rs.AvgCPUTimeUs, &rs.AvgLogicalReads, &rs.AvgPhysicalReads, &rs.AvgRowCount, &lastExecution)
+		if err != nil {
+			return nil, fmt.Errorf("get query plan history scan: %w", err)
+		}
+		if current == nil || current.Plan.DatabaseName != plan.DatabaseName || current.Plan.PlanID != plan.PlanID {
+			plan.FirstCompileTime = firstCompile.Time
+			plan.LastExecutionTime = planLastExecution.Time
+			current = &common_domain.QueryPlanHistory{Plan: plan}
+			ret = append(ret, current)
+		}
+		rs.DatabaseName = plan.DatabaseName
+		rs.PlanID = plan.PlanID
+		rs.LastExecutionTime = lastExecution.Time
+		current.RuntimeStats = append(current.RuntimeStats, rs)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get query plan history rows: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,114 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func (p *PostgresRepo) insertSnapshotTransactions(ctx context.Context, tx *sqlx.Tx, transactions []common_domain.OpenTransaction, snapID int) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+	ctx, span := p.tracer.Start(ctx, "insertSnapshotTransactions")
+	defer span.End()
+	n := len(transactions)
+	names, sessionIDs, hosts, programs, logins := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	statuses, types, states, databases := make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	transactionIDs, durations := make([]int64, n), make([]int64, n)
+	logUsed, logReserved, logRecords := make([]int64, n), make([]int64, n), make([]int64, n)
+	beginTimes := make([]time.Time, n)
+	versionStore := make([]bool, n)
+	for i, t := range transactions {
+		transactionIDs[i] = t.TransactionID
+		names[i] = t.Name
+		sessionIDs[i] = t.Session.SessionID
+		hosts[i] = t.Session.HostName
+		programs[i] = t.Session.ProgramName
+		logins[i] = t.Session.LoginName
+		statuses[i] = t.Session.Status
+		beginTimes[i] = t.BeginTime.In(time.UTC)
+		durations[i] = t.DurationMs
+		types[i] = t.TransactionType
+		states[i] = t.TransactionState
+		databases[i] = t.DatabaseName
+		logUsed[i] = t.LogBytesUsed
+		logReserved[i] = t.LogBytesReserved
+		logRecords[i] = t.LogRecordCount
+		versionStore[i] = t.HoldsVersionStore
+	}
+	_, err := tx.ExecContext(ctx, `insert into snapshot_transaction (snap_id, transaction_id, name, session_id, host_name,
+                                  program_name, login_name, session_status, begin_time, duration_ms,
+                                  transaction_type, transaction_state, database_name, log_bytes_used,
+                                  log_bytes_reserved, log_record_count, holds_version_store)
+select $1, st.*
+from unnest($2::bigint[], $3::text[], $4::text[], $5::text[], $6::text[], $7::text[], $8::text[], $9::timestamp[],
+            $10::bigint[], $11::text[], $12::text[], $13::text[], $14::bigint[], $15::bigint[], $16::bigint[],
+            $17::boolean[]) st
+on conflict do nothing`,
+		snapID, pq.Array(transactionIDs), pq.Array(names), pq.Array(sessionIDs), pq.Array(hosts), pq.Array(programs),
+		pq.Array(logins), pq.Array(statuses), pq.Array(beginTimes), pq.Array(durations), pq.Array(types),
+		pq.Array(states), pq.Array(databases), pq.Array(logUsed), pq.Array(logReserved), pq.Array(logRecords),
+		pq.Array(versionStore))
+	return err
+}
+
+// getSnapshotTransactions returns the open transactions and the version store size of a snapshot, longest open first
+func (p *PostgresRepo) getSnapshotTransactions(ctx context.Context, snapID string) ([]common_domain.OpenTransaction, int64, error) {
+	ctx, span := p.tracer.Start(ctx, "getSnapshotTransactions")
+	defer span.End()
+	var versionStoreKB int64
+	err := p.db.QueryRowContext(ctx, `select version_store_kb from snapshot where f_id = $1`, snapID).Scan(&versionStoreKB)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get version store: %w", err)
+	}
+	q := `select st.transaction_id,
+       st.name,
+       st.session_id,
+       st.host_name,
+       st.program_name,
+       st.login_name,
+       st.session_status,
+       st.begin_time,
+       st.duration_ms,
+       st.transaction_type,
+       st.transaction_state,
+       st.database_name,
+       st.log_bytes_used,
+       st.log_bytes_reserved,
+       st.log_record_count,
+       st.holds_version_store
+from snapshot_transaction st
+         inner join snapshot s on s.id = st.snap_id
+where s.f_id = $1
+order by st.begin_time`
+	rows, err := p.db.QueryContext(ctx, q, snapID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get transactions: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]common_domain.OpenTransaction, 0)
+	for rows.Next() {
+		var t common_domain.OpenTransaction
+		err = rows.Scan(&t.TransactionID, &t.Name, &t.Session.SessionID, &t.Session.HostName, &t.Session.ProgramName,
+			&t.Session.LoginName, &t.Session.Status, &t.BeginTime, &t.DurationMs, &t.TransactionType,
+			&t.TransactionState, &t.DatabaseName, &t.LogBytesUsed, &t.LogBytesReserved, &t.LogRecordCount,
+			&t.HoldsVersionStore)
+		if err != nil {
+			return nil, 0, fmt.Errorf("get transactions scan: %w", err)
+		}
+		ret = append(ret, t)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, 0, fmt.Errorf("get transactions rows: %w", err)
+	}
+	return ret, versionStoreKB, nil
+}
@@ -0,0 +1,355 @@
+package adapters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *PostgresRepo) StoreWaitStats(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, waitStats []common_domain.WaitStat) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreWaitStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", server.Host), attribute.Int("wait_types", len(waitStats)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	waitTypes := make([]string, len(waitStats))
+	waitingTasks := make([]int64, len(waitStats))
+	waitTimes := make([]int64, len(waitStats))
+	signalWaitTimes := make([]int64, len(waitStats))
+	maxWaitTimes := make([]int64, len(waitStats))
+	for i, w := range waitStats {
+		waitTypes[i] = w.WaitType
+		waitingTasks[i] = w.WaitingTasks
+		waitTimes[i] = w.WaitTimeMs
+		signalWaitTimes[i] = w.SignalWaitTimeMs
+		maxWaitTimes[i] = w.MaxWaitTimeMs
+	}
+	// a metrics interval resent from the agent outbox replaces the stored one
+	_, err = tx.ExecContext(ctx, `insert into wait_stats (target_id, collected_at, wait_type, waiting_tasks, wait_time_ms,
+                        signal_wait_time_ms, max_wait_time_ms)
+select $1, $2, w.*
+from unnest($3::text[], $4::bigint[], $5::bigint[], $6::bigint[], $7::bigint[]) w
+on conflict (target_id, collected_at, wait_type) do update set waiting_tasks       = excluded.waiting_tasks,
+                                                              wait_time_ms        = excluded.wait_time_ms,
+                                                              signal_wait_time_ms = excluded.signal_wait_time_ms,
+                                                              max_wait_time_ms    = excluded.max_wait_time_ms`,
+		targetID, timestamp.In(time.UTC), pq.Array(waitTypes), pq.Array(waitingTasks), pq.Array(waitTimes),
+		pq.Array(signalWaitTimes), pq.Array(maxWaitTimes))
+	if err != nil {
+		return fmt.Errorf("insert wait stats: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) GetWaitStats(ctx context.Context, serverID string, start time.Time, end time.Time, waitTypes []string, top int) ([]*common_domain.WaitStatSeries, error) {
+	ctx, span := p.tracer.Start(ctx, "GetWaitStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.WaitStatSeries{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	if waitTypes == nil {
+		waitTypes = []string{}
+	}
+	// the series come ordered by the wait time of the wait type in the range
+	q := `with top_waits as (select wait_type, row_number() over (order by sum(wait_time_ms) desc) as rank
+                   from wait_stats
+                   where target_id = $1
+                     and collected_at between $2 and $3
+                     and (cardinality($4::text[]) = 0 or wait_type = any ($4::text[]))
+                   group by wait_type
+                   order by sum(wait_time_ms) desc
+                   limit $5)
+select w.wait_type, w.collected_at, w.waiting_tasks, w.wait_time_ms, w.signal_wait_time_ms, w.max_wait_time_ms
+from wait_stats w
+         inner join top_waits t on t.wait_type = w.wait_type
+where w.target_id = $1
+  and w.collected_at between $2 and $3
+order by t.rank, w.collected_at`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), pq.Array(waitTypes), top)
+	if err != nil {
+		return nil, fmt.Errorf("get wait stats: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.WaitStatSeries, 0)
+	var current *common_domain.WaitStatSeries
+	for rows.Next() {
+		var point common_domain.WaitStatPoint
+		err = rows.Scan(&point.WaitType, &point.Timestamp, &point.WaitingTasks, &point.WaitTimeMs, &point.SignalWaitTimeMs,
+			&point.MaxWaitTimeMs)
+		if err != nil {
+			return nil, fmt.Errorf("get wait stats scan: %w", err)
+		}
+		if current == nil || current.WaitType != point.WaitType {
+			current = &common_domain.WaitStatSeries{WaitType: point.WaitType}
+			ret = append(ret, current)
+		}
+		current.Points = append(current.Points, point)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get wait stats rows: %w", err)
+	}
+	return ret, nil
+}
+
+func (p *PostgresRepo) StorePerformanceCounters(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, counters []common_domain.PerformanceCounter) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StorePerformanceCounters")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", server.Host), attribute.Int("counters", len(counters)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	names := make([]string, len(counters))
+	values := make([]int64, len(counters))
+	rates := make([]float64, len(counters))
+	for i, c := range counters {
+		names[i] = c.Name
+		values[i] = c.Value
+		rates[i] = c.Rate
+	}
+	_, err = tx.ExecContext(ctx, `insert into performance_counters (target_id, collected_at, counter_name, value, rate)
+select $1, $2, c.*
+from unnest($3::text[], $4::bigint[], $5::float8[]) c
+on conflict (target_id, collected_at, counter_name) do update set value = excluded.value,
+                                                                 rate  = excluded.rate`,
+		targetID, timestamp.In(time.UTC), pq.Array(names), pq.Array(values), pq.Array(rates))
+	if err != nil {
+		return fmt.Errorf("insert performance counters: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) GetPerformanceCounters(ctx context.Context, serverID string, start time.Time, end time.Time, names []string) ([]*common_domain.PerformanceCounterSeries, error) {
+	ctx, span := p.tracer.Start(ctx, "GetPerformanceCounters")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.PerformanceCounterSeries{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	if names == nil {
+		names = []string{}
+	}
+	q := `select counter_name, collected_at, value, rate
+from performance_counters
+where target_id = $1
+  and collected_at between $2 and $3
+  and (cardinality($4::text[]) = 0 or counter_name = any ($4::text[]))
+order by counter_name, collected_at`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("get performance counters: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.PerformanceCounterSeries, 0)
+	var current *common_domain.PerformanceCounterSeries
+	for rows.Next() {
+		var name string
+		var point common_domain.PerformanceCounterPoint
+		err = rows.Scan(&name, &point.Timestamp, &point.Value, &point.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("get performance counters scan: %w", err)
+		}
+		if current == nil || current.Name != name {
+			current = &common_domain.PerformanceCounterSeries{Name: name}
+			ret = append(ret, current)
+		}
+		current.Points = append(current.Points, point)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get performance counters rows: %w", err)
+	}
+	return ret, nil
+}
+
+func (p *PostgresRepo) StoreFileIOStats(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, stats []common_domain.FileIOStat) (err error) {
+	ctx, span := p.tracer.Start(ctx, "StoreFileIOStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", server.Host), attribute.Int("files", len(stats)))
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			err2 := tx.Rollback()
+			if err2 != nil {
+				err = errors.Join(err, err2)
+			}
+			return
+		}
+		err2 := tx.Commit()
+		if err2 != nil {
+			err = errors.Join(err, err2)
+			return
+		}
+	}()
+	targetID, err := p.getOrCreateTargetID(ctx, tx, server)
+	if err != nil {
+		return fmt.Errorf("get target id: %w", err)
+	}
+	n := len(stats)
+	databases, logicalNames, physicalNames, fileTypes := make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	fileIDs, intervals := make([]int64, n), make([]int64, n)
+	reads, bytesRead, readStalls := make([]int64, n), make([]int64, n), make([]int64, n)
+	writes, bytesWritten, writeStalls := make([]int64, n), make([]int64, n), make([]int64, n)
+	for i, f := range stats {
+		databases[i] = f.DatabaseName
+		fileIDs[i] = f.FileID
+		logicalNames[i] = f.LogicalName
+		physicalNames[i] = f.PhysicalName
+		fileTypes[i] = f.FileType
+		intervals[i] = f.IntervalMs
+		reads[i] = f.Reads
+		bytesRead[i] = f.BytesRead
+		readStalls[i] = f.ReadStallMs
+		writes[i] = f.Writes
+		bytesWritten[i] = f.BytesWritten
+		writeStalls[i] = f.WriteStallMs
+	}
+	_, err = tx.ExecContext(ctx, `insert into file_io_stats (target_id, collected_at, database_name, file_id, logical_name,
+                           physical_name, file_type, interval_ms, reads, bytes_read, read_stall_ms, writes,
+                           bytes_written, write_stall_ms)
+select $1, $2, f.*
+from unnest($3::text[], $4::int[], $5::text[], $6::text[], $7::text[], $8::bigint[], $9::bigint[], $10::bigint[],
+            $11::bigint[], $12::bigint[], $13::bigint[], $14::bigint[]) f
+on conflict (target_id, collected_at, database_name, file_id) do update set logical_name   = excluded.logical_name,
+                                                                           physical_name  = excluded.physical_name,
+                                                                           file_type      = excluded.file_type,
+                                                                           interval_ms    = excluded.interval_ms,
+                                                                           reads          = excluded.reads,
+                                                                           bytes_read     = excluded.bytes_read,
+                                                                           read_stall_ms  = excluded.read_stall_ms,
+                                                                           writes         = excluded.writes,
+                                                                           bytes_written  = excluded.bytes_written,
+                                                                           write_stall_ms = excluded.write_stall_ms`,
+		targetID, timestamp.In(time.UTC), pq.Array(databases), pq.Array(fileIDs), pq.Array(logicalNames),
+		pq.Array(physicalNames), pq.Array(fileTypes), pq.Array(intervals), pq.Array(reads), pq.Array(bytesRead),
+		pq.Array(readStalls), pq.Array(writes), pq.Array(bytesWritten), pq.Array(writeStalls))
+	if err != nil {
+		return fmt.Errorf("insert file io stats: %w", err)
+	}
+	return nil
+}
+
+func (p *PostgresRepo) GetFileIOStats(ctx context.Context, serverID string, start time.Time, end time.Time, databases []string) ([]*common_domain.FileIOSeries, error) {
+	ctx, span := p.tracer.Start(ctx, "GetFileIOStats")
+	defer span.End()
+	span.SetAttributes(attribute.String("target_host", serverID))
+	targetID, err := p.getTargetID(ctx, p.db, common_domain.ServerMeta{Host: serverID})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return []*common_domain.FileIOSeries{}, nil
+		}
+		return nil, fmt.Errorf("get target id: %w", err)
+	}
+	if databases == nil {
+		databases = []string{}
+	}
+	q := `select database_name,
+       file_id,
+       logical_name,
+       physical_name,
+       file_type,
+       collected_at,
+       interval_ms,
+       reads,
+       bytes_read,
+       read_stall_ms,
+       writes,
+       bytes_written,
+       write_stall_ms
+from file_io_stats
+where target_id = $1
+  and collected_at between $2 and $3
+  and (cardinality($4::text[]) = 0 or database_name = any ($4::text[]))
+order by database_name, file_id, collected_at`
+	rows, err := p.db.QueryContext(ctx, q, targetID, start.In(time.UTC), end.In(time.UTC), pq.Array(databases))
+	if err != nil {
+		return nil, fmt.Errorf("get file io stats: %w", err)
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+	ret := make([]*common_domain.FileIOSeries, 0)
+	var current *common_domain.FileIOSeries
+	for rows.Next() {
+		var point common_domain.FileIOPoint
+		err = rows.Scan(&point.DatabaseName, &point.FileID, &point.LogicalName, &point.PhysicalName, &point.FileType,
+			&point.Timestamp, &point.IntervalMs, &point.Reads, &point.BytesRead, &point.ReadStallMs, &point.Writes,
+			&point.BytesWritten, &point.WriteStallMs)
+		if err != nil {
+			return nil, fmt.Errorf("get file io stats scan: %w", err)
+		}
+		if current == nil || current.DatabaseName != point.DatabaseName || current.FileID != point.FileID {
+			current = &common_domain.FileIOSeries{DatabaseName: point.DatabaseName, FileID: point.FileID,
+				LogicalName: point.LogicalName, PhysicalName: point.PhysicalName, FileType: point.FileType}
+			ret = append(ret, current)
+		}
+		current.Points = append(current.Points, point)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("get file io stats rows: %w", err)
+	}
+	return ret, nil
+}
@@ -22,29 +22,62 @@ type Queries struct {
 	GetQueryMetrics            query.GetQueryMetricsHandler
 	GetKnownWarnings           query.GetKnownWarningsHandler
 	GetQueryMetricsSlice       query.GetQueryMetricsSliceHandler
+	ListDeadlocks              query.ListDeadlocksHandler
+	GetDeadlock                query.GetDeadlockHandler
+	ListAgents                 query.ListAgentsHandler
+	GetAgent                   query.GetAgentHandler
+	GetCollectionConfig        query.GetCollectionConfigHandler
+	GetWaitStatsTimeSeries     query.GetWaitStatsTimeSeriesHandler
+	GetCountersTimeSeries      query.GetPerformanceCountersTimeSeriesHandler
+	GetFileIOTimeSeries        query.GetFileIOTimeSeriesHandler
+	GetIndexReview             query.GetIndexReviewHandler
+	GetQueryPlanHistory        query.GetQueryPlanHistoryHandler
+	ListJobRuns                query.ListJobRunsHandler
+	GetReplicaStatesTimeSeries query.GetReplicaStatesTimeSeriesHandler
+	GetAGTopology              query.GetAvailabilityGroupTopologyHandler
+	GetFileGrowthTrend         query.GetFileGrowthTrendHandler
 }
 
 type Commands struct {
-	StoreSnapshot        command.StoreSnapShotHandler
-	StoreQueryMetrics    command.StoreQueryMetricsHandler
-	StoreExecutionPlans  command.StoreExecutionPlansHandler
-	PurgeQueryMetrics    command.PurgeQueryMetricsHandler
-	StoreSnapshotSamples command.StoreSnapShotSamplesHandler
-	PurgeSnapshots       command.PurgeSnapshotsHandler
-	PurgeQueryPlans      command.PurgeQueryPlansHandler
-	StoreWarnings        command.StoreWarningsHandler
+	StoreSnapshot         command.StoreSnapShotHandler
+	StoreQueryMetrics     command.StoreQueryMetricsHandler
+	StoreExecutionPlans   command.StoreExecutionPlansHandler
+	PurgeQueryMetrics     command.PurgeQueryMetricsHandler
+	StoreSnapshotSamples  command.StoreSnapShotSamplesHandler
+	PurgeSnapshots        command.PurgeSnapshotsHandler
+	PurgeQueryPlans       command.PurgeQueryPlansHandler
+	StoreWarnings         command.StoreWarningsHandler
+	StoreDeadlocks        command.StoreDeadlocksHandler
+	RegisterAgent         command.RegisterAgentHandler
+	RecordHeartbeat       command.RecordHeartbeatHandler
+	StoreCollectionConfig command.StoreCollectionConfigHandler
+	StoreSystemMetrics    command.StoreSystemMetricsHandler
+	StoreIndexStats       command.StoreIndexStatsHandler
+	StoreQueryStore       command.StoreQueryStoreHandler
+	StoreJobRuns          command.StoreJobRunsHandler
+	StoreFileSizeStats    command.StoreFileSizeStatsHandler
 }
 
-func NewApplication(repo domain.SampleRepository, queryMetricsRepo domain.QueryMetricsRepository, warnRepo domain.WarningsRepository) *Application {
+func NewApplication(repo domain.SampleRepository, queryMetricsRepo domain.QueryMetricsRepository, warnRepo domain.WarningsRepository,
+	deadlockRepo domain.DeadlocksRepository, agentsRepo domain.AgentsRepository) *Application {
 	return &Application{
 		Commands: Commands{StoreSnapshot: command.NewStoreSnapShotHandler(repo),
-			StoreQueryMetrics:    command.NewStoreQueryMetricsHandler(queryMetricsRepo),
-			StoreExecutionPlans:  command.NewStoreExecutionPlansHandler(repo),
-			PurgeQueryMetrics:    command.NewPurgeQueryMetricsHandler(queryMetricsRepo),
-			StoreSnapshotSamples: command.NewStoreSnapShotSamplesHandler(repo),
-			PurgeSnapshots:       command.NewPurgeSnapshotsHandler(repo),
-			PurgeQueryPlans:      command.NewPurgeQueryPlansHandler(repo),
-			StoreWarnings:        command.NewStoreWarningsHandler(warnRepo),
+			StoreQueryMetrics:     command.NewStoreQueryMetricsHandler(queryMetricsRepo),
+			StoreExecutionPlans:   command.NewStoreExecutionPlansHandler(repo),
+			PurgeQueryMetrics:     command.NewPurgeQueryMetricsHandler(queryMetricsRepo),
+			StoreSnapshotSamples:  command.NewStoreSnapShotSamplesHandler(repo),
+			PurgeSnapshots:        command.NewPurgeSnapshotsHandler(repo),
+			PurgeQueryPlans:       command.NewPurgeQueryPlansHandler(repo),
+			StoreWarnings:         command.NewStoreWarningsHandler(warnRepo),
+			StoreDeadlocks:        command.NewStoreDeadlocksHandler(deadlockRepo),
+			RegisterAgent:         command.NewRegisterAgentHandler(agentsRepo),
+			RecordHeartbeat:       command.NewRecordHeartbeatHandler(agentsRepo),
+			StoreCollectionConfig: command.NewStoreCollectionConfigHandler(agentsRepo),
+			StoreSystemMetrics:    command.NewStoreSystemMetricsHandler(queryMetricsRepo),
+			StoreIndexStats:       command.NewStoreIndexStatsHandler(queryMetricsRepo),
+			StoreQueryStore:       command.NewStoreQueryStoreHandler(queryMetricsRepo),
+			StoreJobRuns:          command.NewStoreJobRunsHandler(queryMetricsRepo),
+			StoreFileSizeStats:    command.NewStoreFileSizeStatsHandler(queryMetricsRepo),
 		},
 		Queries: Queries{
 			GetKnownPlanHandlesHandler: query.NewGetKnownPlanHandlesHandler(repo),
@@ -57,6 +90,20 @@ func NewApplication(repo domain.SampleRepository, queryMetricsRepo domain.QueryM
 			GetQueryMetrics:            query.NewGetQueryMetricsHandler(queryMetricsRepo),
 			GetKnownWarnings:           query.NewGetKnownWarningsHandler(warnRepo),
 			GetQueryMetricsSlice:       query.NewGetQueryMetricsSliceHandler(queryMetricsRepo),
+			ListDeadlocks:              query.NewListDeadlocksHandler(deadlockRepo),
+			GetDeadlock:                query.NewGetDeadlockHandler(deadlockRepo),
+			ListAgents:                 query.NewListAgentsHandler(agentsRepo),
+			GetAgent:                   query.NewGetAgentHandler(agentsRepo),
+			GetCollectionConfig:        query.NewGetCollectionConfigHandler(agentsRepo),
+			GetWaitStatsTimeSeries:     query.NewGetWaitStatsTimeSeriesHandler(queryMetricsRepo),
+			GetCountersTimeSeries:      query.NewGetPerformanceCountersTimeSeriesHandler(queryMetricsRepo),
+			GetFileIOTimeSeries:        query.NewGetFileIOTimeSeriesHandler(queryMetricsRepo),
+			GetIndexReview:             query.NewGetIndexReviewHandler(queryMetricsRepo),
+			GetQueryPlanHistory:        query.NewGetQueryPlanHistoryHandler(queryMetricsRepo),
+			ListJobRuns:                query.NewListJobRunsHandler(queryMetricsRepo),
+			GetReplicaStatesTimeSeries: query.NewGetReplicaStatesTimeSeriesHandler(queryMetricsRepo),
+			GetAGTopology:              query.NewGetAvailabilityGroupTopologyHandler(queryMetricsRepo),
+			GetFileGrowthTrend:         query.NewGetFileGrowthTrendHandler(queryMetricsRepo),
 		},
 	}
 }
@@ -0,0 +1,28 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type RecordHeartbeat struct {
+	AgentID   string
+	Server    common_domain.ServerMeta
+	Status    common_domain.CollectionStatus
+	Timestamp time.Time
+}
+
+type RecordHeartbeatHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewRecordHeartbeatHandler(repo domain.AgentsRepository) RecordHeartbeatHandler {
+	return RecordHeartbeatHandler{repo: repo}
+}
+
+func (h RecordHeartbeatHandler) Handle(ctx context.Context, cmd RecordHeartbeat) error {
+	return h.repo.RecordHeartbeat(ctx, cmd.AgentID, cmd.Server, cmd.Status, cmd.Timestamp)
+}
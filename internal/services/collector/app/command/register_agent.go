@@ -0,0 +1,19 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+)
+
+type RegisterAgentHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewRegisterAgentHandler(repo domain.AgentsRepository) RegisterAgentHandler {
+	return RegisterAgentHandler{repo: repo}
+}
+
+func (h RegisterAgentHandler) Handle(ctx context.Context, registration domain.AgentRegistration) error {
+	return h.repo.RegisterAgent(ctx, registration)
+}
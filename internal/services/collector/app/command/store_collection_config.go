@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreCollectionConfigHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewStoreCollectionConfigHandler(repo domain.AgentsRepository) StoreCollectionConfigHandler {
+	return StoreCollectionConfigHandler{repo: repo}
+}
+
+func (h StoreCollectionConfigHandler) Handle(ctx context.Context, config common_domain.CollectionConfig) error {
+	return h.repo.StoreCollectionConfig(ctx, config)
+}
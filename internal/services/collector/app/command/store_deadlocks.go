@@ -0,0 +1,25 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreDeadlocks struct {
+	Deadlocks  []*common_domain.Deadlock
+	ServerMeta common_domain.ServerMeta
+}
+
+type StoreDeadlocksHandler struct {
+	repo domain.DeadlocksRepository
+}
+
+func NewStoreDeadlocksHandler(repo domain.DeadlocksRepository) StoreDeadlocksHandler {
+	return StoreDeadlocksHandler{repo: repo}
+}
+
+func (h StoreDeadlocksHandler) Handle(ctx context.Context, cmd StoreDeadlocks) error {
+	return h.repo.StoreDeadlocks(ctx, cmd.Deadlocks, cmd.ServerMeta)
+}
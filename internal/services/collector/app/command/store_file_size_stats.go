@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreFileSizeStatsHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewStoreFileSizeStatsHandler(repo domain.QueryMetricsRepository) StoreFileSizeStatsHandler {
+	return StoreFileSizeStatsHandler{repo: repo}
+}
+
+func (h StoreFileSizeStatsHandler) Handle(ctx context.Context, stats common_domain.FileSizeStats) error {
+	return h.repo.StoreFileSizeStats(ctx, stats)
+}
@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreIndexStatsHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewStoreIndexStatsHandler(repo domain.QueryMetricsRepository) StoreIndexStatsHandler {
+	return StoreIndexStatsHandler{repo: repo}
+}
+
+func (h StoreIndexStatsHandler) Handle(ctx context.Context, stats common_domain.IndexStats) error {
+	return h.repo.StoreIndexStats(ctx, stats)
+}
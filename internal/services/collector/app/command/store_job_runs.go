@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreJobRunsHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewStoreJobRunsHandler(repo domain.QueryMetricsRepository) StoreJobRunsHandler {
+	return StoreJobRunsHandler{repo: repo}
+}
+
+func (h StoreJobRunsHandler) Handle(ctx context.Context, stats common_domain.JobStats) error {
+	return h.repo.StoreJobRuns(ctx, stats)
+}
@@ -0,0 +1,20 @@
+package command
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreQueryStoreHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewStoreQueryStoreHandler(repo domain.QueryMetricsRepository) StoreQueryStoreHandler {
+	return StoreQueryStoreHandler{repo: repo}
+}
+
+func (h StoreQueryStoreHandler) Handle(ctx context.Context, stats common_domain.QueryStoreStats) error {
+	return h.repo.StoreQueryStoreStats(ctx, stats)
+}
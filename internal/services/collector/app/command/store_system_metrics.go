@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type StoreSystemMetricsHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewStoreSystemMetricsHandler(repo domain.QueryMetricsRepository) StoreSystemMetricsHandler {
+	return StoreSystemMetricsHandler{repo: repo}
+}
+
+func (h StoreSystemMetricsHandler) Handle(ctx context.Context, metrics common_domain.SystemMetrics) error {
+	if len(metrics.WaitStats) > 0 {
+		err := h.repo.StoreWaitStats(ctx, metrics.Server, metrics.Timestamp, metrics.WaitStats)
+		if err != nil {
+			return fmt.Errorf("store wait stats: %w", err)
+		}
+	}
+	if len(metrics.Counters) > 0 {
+		err := h.repo.StorePerformanceCounters(ctx, metrics.Server, metrics.Timestamp, metrics.Counters)
+		if err != nil {
+			return fmt.Errorf("store performance counters: %w", err)
+		}
+	}
+	if len(metrics.FileIO) > 0 {
+		err := h.repo.StoreFileIOStats(ctx, metrics.Server, metrics.Timestamp, metrics.FileIO)
+		if err != nil {
+			return fmt.Errorf("store file io stats: %w", err)
+		}
+	}
+	if len(metrics.Replicas) > 0 {
+		err := h.repo.StoreReplicaStates(ctx, metrics.Server, metrics.Timestamp, metrics.Replicas)
+		if err != nil {
+			return fmt.Errorf("store replica states: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetAgentHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewGetAgentHandler(repo domain.AgentsRepository) GetAgentHandler {
+	return GetAgentHandler{repo: repo}
+}
+
+func (h GetAgentHandler) Handle(ctx context.Context, id string) (*common_domain.Agent, error) {
+	return h.repo.GetAgent(ctx, id)
+}
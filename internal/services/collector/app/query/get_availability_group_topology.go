@@ -0,0 +1,26 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetAvailabilityGroupTopologyHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetAvailabilityGroupTopologyHandler(repo domain.QueryMetricsRepository) GetAvailabilityGroupTopologyHandler {
+	return GetAvailabilityGroupTopologyHandler{repo: repo}
+}
+
+// Handle returns the availability groups of the host as of the latest replica states reading
+func (h GetAvailabilityGroupTopologyHandler) Handle(ctx context.Context, serverID string, agName string) ([]common_domain.AvailabilityGroupTopology, error) {
+	states, collectedAt, err := h.repo.GetLatestReplicaStates(ctx, serverID, agName)
+	if err != nil {
+		return nil, fmt.Errorf("get latest replica states: %w", err)
+	}
+	return common_domain.BuildAvailabilityGroupTopologies(states, collectedAt), nil
+}
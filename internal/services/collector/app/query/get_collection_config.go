@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetCollectionConfigHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewGetCollectionConfigHandler(repo domain.AgentsRepository) GetCollectionConfigHandler {
+	return GetCollectionConfigHandler{repo: repo}
+}
+
+func (h GetCollectionConfigHandler) Handle(ctx context.Context, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error) {
+	return h.repo.GetCollectionConfig(ctx, server)
+}
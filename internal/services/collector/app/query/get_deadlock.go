@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type GetDeadlockHandler struct {
+	repo   domain.DeadlocksRepository
+	tracer trace.Tracer
+}
+
+func NewGetDeadlockHandler(repo domain.DeadlocksRepository) GetDeadlockHandler {
+	return GetDeadlockHandler{repo: repo, tracer: otel.Tracer("GetDeadlockHandler")}
+}
+
+// Handle returns the deadlock with each stack frame linked to the plan handle and query hash its statement was last
+// sampled with. Linking happens on read so frames sampled after the deadlock was stored are linked too.
+func (h GetDeadlockHandler) Handle(ctx context.Context, id string) (*common_domain.Deadlock, error) {
+	ctx, span := h.tracer.Start(ctx, "GetDeadlock")
+	defer span.End()
+	deadlock, err := h.repo.GetDeadlock(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get deadlock %s: %w", id, err)
+	}
+	sqlHandles := make([]string, 0)
+	for _, process := range deadlock.Processes {
+		for _, frame := range process.Frames {
+			if frame.SqlHandle != "" {
+				sqlHandles = append(sqlHandles, frame.SqlHandle)
+			}
+		}
+	}
+	handles, err := h.repo.GetStatementHandles(ctx, deadlock.Server, sqlHandles)
+	if err != nil {
+		return nil, fmt.Errorf("get statement handles: %w", err)
+	}
+	for i := range deadlock.Processes {
+		frames := deadlock.Processes[i].Frames
+		for j := range frames {
+			sampled, ok := handles[frames[j].SqlHandle]
+			if !ok {
+				continue
+			}
+			frames[j].PlanHandle = sampled.PlanHandle
+			if frames[j].QueryHash == "" {
+				frames[j].QueryHash = sampled.QueryHash
+			}
+		}
+	}
+	return deadlock, nil
+}
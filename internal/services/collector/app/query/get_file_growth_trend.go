@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetFileGrowthTrendHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetFileGrowthTrendHandler(repo domain.QueryMetricsRepository) GetFileGrowthTrendHandler {
+	return GetFileGrowthTrendHandler{repo: repo}
+}
+
+// Handle returns the size history of the files with their autogrowth events and forecast, the files filling up first
+// come first and the files not growing last
+func (h GetFileGrowthTrendHandler) Handle(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]*common_domain.FileGrowthTrend, error) {
+	trends, err := h.repo.GetFileSizes(ctx, serverID, database, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("get file sizes: %w", err)
+	}
+	events, err := h.repo.GetAutogrowthEvents(ctx, serverID, database, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("get autogrowth events: %w", err)
+	}
+	type fileKey struct {
+		database string
+		name     string
+	}
+	byFile := make(map[fileKey]*common_domain.FileGrowthTrend, len(trends))
+	for _, trend := range trends {
+		trend.Forecast()
+		byFile[fileKey{database: trend.DatabaseName, name: trend.LogicalName}] = trend
+	}
+	for _, e := range events {
+		if trend, ok := byFile[fileKey{database: e.DatabaseName, name: e.LogicalName}]; ok {
+			trend.GrowthEvents = append(trend.GrowthEvents, e)
+		}
+	}
+	slices.SortStableFunc(trends, func(a, b *common_domain.FileGrowthTrend) int {
+		switch {
+		case a.DaysUntilFull < 0 && b.DaysUntilFull < 0:
+			return 0
+		case a.DaysUntilFull < 0:
+			return 1
+		case b.DaysUntilFull < 0:
+			return -1
+		case a.DaysUntilFull < b.DaysUntilFull:
+			return -1
+		case a.DaysUntilFull > b.DaysUntilFull:
+			return 1
+		}
+		return 0
+	})
+	return trends, nil
+}
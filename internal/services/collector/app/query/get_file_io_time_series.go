@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetFileIOTimeSeriesHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetFileIOTimeSeriesHandler(repo domain.QueryMetricsRepository) GetFileIOTimeSeriesHandler {
+	return GetFileIOTimeSeriesHandler{repo: repo}
+}
+
+func (h GetFileIOTimeSeriesHandler) Handle(ctx context.Context, serverID string, start time.Time, end time.Time, databases []string) ([]*common_domain.FileIOSeries, error) {
+	return h.repo.GetFileIOStats(ctx, serverID, start, end, databases)
+}
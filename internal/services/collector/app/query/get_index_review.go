@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+const (
+	defaultIndexWriteRatio = 10
+	defaultTopMissing      = 25
+)
+
+type GetIndexReviewHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetIndexReviewHandler(repo domain.QueryMetricsRepository) GetIndexReviewHandler {
+	return GetIndexReviewHandler{repo: repo}
+}
+
+// Handle reviews the index activity between the first and the last collections of the range, a range with a
+// single collection is reviewed with the activity since the server started
+func (h GetIndexReviewHandler) Handle(ctx context.Context, serverID string, database string, start time.Time, end time.Time, writeRatio float64, topMissing int) (common_domain.IndexReview, error) {
+	if writeRatio <= 0 {
+		writeRatio = defaultIndexWriteRatio
+	}
+	if topMissing <= 0 {
+		topMissing = defaultTopMissing
+	}
+	first, last, err := h.repo.GetIndexUsage(ctx, serverID, database, start, end)
+	if err != nil {
+		return common_domain.IndexReview{}, fmt.Errorf("get index usage: %w", err)
+	}
+	missing, err := h.repo.GetMissingIndexes(ctx, serverID, database, start, end)
+	if err != nil {
+		return common_domain.IndexReview{}, fmt.Errorf("get missing indexes: %w", err)
+	}
+	return common_domain.ReviewIndexes(common_domain.IndexUsageBetween(first, last), missing, writeRatio, topMissing), nil
+}
@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetPerformanceCountersTimeSeriesHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetPerformanceCountersTimeSeriesHandler(repo domain.QueryMetricsRepository) GetPerformanceCountersTimeSeriesHandler {
+	return GetPerformanceCountersTimeSeriesHandler{repo: repo}
+}
+
+func (h GetPerformanceCountersTimeSeriesHandler) Handle(ctx context.Context, serverID string, start time.Time, end time.Time, names []string) ([]*common_domain.PerformanceCounterSeries, error) {
+	return h.repo.GetPerformanceCounters(ctx, serverID, start, end, names)
+}
@@ -0,0 +1,26 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetQueryPlanHistoryHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetQueryPlanHistoryHandler(repo domain.QueryMetricsRepository) GetQueryPlanHistoryHandler {
+	return GetQueryPlanHistoryHandler{repo: repo}
+}
+
+func (h GetQueryPlanHistoryHandler) Handle(ctx context.Context, serverID string, database string, queryHash string, start time.Time, end time.Time) ([]*common_domain.QueryPlanHistory, error) {
+	plans, err := h.repo.GetQueryPlanHistory(ctx, serverID, database, queryHash, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("get query plan history: %w", err)
+	}
+	return common_domain.SummarizePlanHistory(plans), nil
+}
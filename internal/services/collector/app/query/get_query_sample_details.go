@@ -55,10 +55,14 @@ func (h *GetQuerySampleDetailsHandler) Handle(ctx context.Context, snapID string
 	for i, root := range roots {
 		participants[i] = h.buildblockNode(sampleMap, root, traveled)
 	}
+	var lockConflict *common_domain.LockConflict
+	if baseQuery.IsBlocked {
+		lockConflict = common_domain.FindLockConflict(baseQuery, firstSample(sampleMap, baseQuery.Block.BlockedBy))
+	}
 	planFound := true
 	plan, err := h.repo.GetExecutionPlan(ctx, baseQuery.PlanHandle, &common_domain.ServerMeta{
 		Host: snap.SnapInfo.Server.Host,
-		Type: "mssql",
+		Type: snap.SnapInfo.Server.Type,
 	})
 	if err != nil {
 		if !errors.As(err, &custom_errors.NotFoundErr{}) {
@@ -79,12 +83,14 @@ func (h *GetQuerySampleDetailsHandler) Handle(ctx context.Context, snapID string
 		if err != nil {
 			return nil, fmt.Errorf("parsed execution to proto: %w", err)
 		}
+		parsers.OverlayLiveProgress(protoParsedPlan, baseQuery.LiveProgress)
 	}
 
 	return &dbmv1.GetSampleDetailsResponse{
-		QuerySample: converters.SampleToProto(baseQuery),
-		ParsedPlan:  protoParsedPlan,
-		BlockChain:  &dbmv1.BlockChain{Roots: participants},
+		QuerySample:  converters.SampleToProto(baseQuery),
+		ParsedPlan:   protoParsedPlan,
+		BlockChain:   &dbmv1.BlockChain{Roots: participants},
+		LockConflict: converters.LockConflictToProto(lockConflict),
 	}, nil
 
 }
@@ -130,6 +136,7 @@ func (h *GetQuerySampleDetailsHandler) buildblockNode(sampleMap map[string][]*co
 		if node == nil {
 			continue
 		}
+		node.LockConflict = converters.LockConflictToProto(common_domain.FindLockConflict(sampleMap[s][0], sample))
 		childNodes = append(childNodes, node)
 	}
 	return &dbmv1.BlockChain_BlockingNode{
@@ -137,3 +144,11 @@ func (h *GetQuerySampleDetailsHandler) buildblockNode(sampleMap map[string][]*co
 		ChildNodes:  childNodes,
 	}
 }
+
+func firstSample(sampleMap map[string][]*common_domain.QuerySample, sessionID string) *common_domain.QuerySample {
+	samples, ok := sampleMap[sessionID]
+	if !ok {
+		return nil
+	}
+	return samples[0]
+}
@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type GetReplicaStatesTimeSeriesHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetReplicaStatesTimeSeriesHandler(repo domain.QueryMetricsRepository) GetReplicaStatesTimeSeriesHandler {
+	return GetReplicaStatesTimeSeriesHandler{repo: repo}
+}
+
+func (h GetReplicaStatesTimeSeriesHandler) Handle(ctx context.Context, serverID string, start time.Time, end time.Time, agName string) ([]*common_domain.ReplicaStateSeries, error) {
+	return h.repo.GetReplicaStates(ctx, serverID, start, end, agName)
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+const defaultTopWaitTypes = 10
+
+type GetWaitStatsTimeSeriesHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewGetWaitStatsTimeSeriesHandler(repo domain.QueryMetricsRepository) GetWaitStatsTimeSeriesHandler {
+	return GetWaitStatsTimeSeriesHandler{repo: repo}
+}
+
+func (h GetWaitStatsTimeSeriesHandler) Handle(ctx context.Context, serverID string, start time.Time, end time.Time, waitTypes []string, top int) ([]*common_domain.WaitStatSeries, error) {
+	if top <= 0 {
+		top = defaultTopWaitTypes
+	}
+	if len(waitTypes) > top {
+		top = len(waitTypes)
+	}
+	return h.repo.GetWaitStats(ctx, serverID, start, end, waitTypes, top)
+}
@@ -0,0 +1,20 @@
+package query
+
+import (
+	"context"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+type ListAgentsHandler struct {
+	repo domain.AgentsRepository
+}
+
+func NewListAgentsHandler(repo domain.AgentsRepository) ListAgentsHandler {
+	return ListAgentsHandler{repo: repo}
+}
+
+func (h ListAgentsHandler) Handle(ctx context.Context) ([]*common_domain.Agent, error) {
+	return h.repo.ListAgents(ctx)
+}
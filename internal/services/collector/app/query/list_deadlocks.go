@@ -0,0 +1,34 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type DeadlocksQuery struct {
+	Start      time.Time
+	End        time.Time
+	PageNumber int
+	PageSize   int
+	ServerID   string
+}
+
+type ListDeadlocksHandler struct {
+	repo   domain.DeadlocksRepository
+	tracer trace.Tracer
+}
+
+func NewListDeadlocksHandler(repo domain.DeadlocksRepository) ListDeadlocksHandler {
+	return ListDeadlocksHandler{repo: repo, tracer: otel.Tracer("ListDeadlocksHandler")}
+}
+
+func (h ListDeadlocksHandler) Handle(ctx context.Context, query DeadlocksQuery) ([]*common_domain.Deadlock, int, error) {
+	ctx, span := h.tracer.Start(ctx, "ListDeadlocks")
+	defer span.End()
+	return h.repo.ListDeadlocks(ctx, query.ServerID, query.Start, query.End, query.PageNumber, query.PageSize)
+}
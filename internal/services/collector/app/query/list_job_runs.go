@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/domain"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
+)
+
+// jobHistoryWindow is how far back the runs used for the median duration of a job go
+const jobHistoryWindow = 30 * 24 * time.Hour
+
+type ListJobRunsHandler struct {
+	repo domain.QueryMetricsRepository
+}
+
+func NewListJobRunsHandler(repo domain.QueryMetricsRepository) ListJobRunsHandler {
+	return ListJobRunsHandler{repo: repo}
+}
+
+func (h ListJobRunsHandler) Handle(ctx context.Context, serverID string, start time.Time, end time.Time, longRunningOnly bool) ([]common_domain.JobRunReview, error) {
+	runs, err := h.repo.ListJobRuns(ctx, serverID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("list job runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return []common_domain.JobRunReview{}, nil
+	}
+	history, err := h.repo.ListJobRuns(ctx, serverID, start.Add(-jobHistoryWindow), end)
+	if err != nil {
+		return nil, fmt.Errorf("list job history: %w", err)
+	}
+	reviews := common_domain.ReviewJobRuns(runs, history)
+	if !longRunningOnly {
+		return reviews, nil
+	}
+	ret := make([]common_domain.JobRunReview, 0)
+	for _, r := range reviews {
+		if r.LongRunning {
+			ret = append(ret, r)
+		}
+	}
+	return ret, nil
+}
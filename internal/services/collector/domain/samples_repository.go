@@ -31,6 +31,25 @@ type QueryMetricsRepository interface {
 	GetQueryMetricsSlice(ctx context.Context, start time.Time, end time.Time, serverID string, sampleID string) ([]*common_domain.QueryMetric, error)
 	PurgeQueryMetrics(ctx context.Context, start time.Time, end time.Time, batchSize int) error
 	PurgeAllQueryMetrics(ctx context.Context) error
+	StoreWaitStats(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, waitStats []common_domain.WaitStat) error
+	GetWaitStats(ctx context.Context, serverID string, start time.Time, end time.Time, waitTypes []string, top int) ([]*common_domain.WaitStatSeries, error)
+	StorePerformanceCounters(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, counters []common_domain.PerformanceCounter) error
+	GetPerformanceCounters(ctx context.Context, serverID string, start time.Time, end time.Time, names []string) ([]*common_domain.PerformanceCounterSeries, error)
+	StoreFileIOStats(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, stats []common_domain.FileIOStat) error
+	GetFileIOStats(ctx context.Context, serverID string, start time.Time, end time.Time, databases []string) ([]*common_domain.FileIOSeries, error)
+	StoreReplicaStates(ctx context.Context, server common_domain.ServerMeta, timestamp time.Time, states []common_domain.ReplicaState) error
+	GetReplicaStates(ctx context.Context, serverID string, start time.Time, end time.Time, agName string) ([]*common_domain.ReplicaStateSeries, error)
+	GetLatestReplicaStates(ctx context.Context, serverID string, agName string) ([]common_domain.ReplicaState, time.Time, error)
+	StoreIndexStats(ctx context.Context, stats common_domain.IndexStats) error
+	GetIndexUsage(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.IndexUsage, []common_domain.IndexUsage, error)
+	GetMissingIndexes(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.MissingIndex, error)
+	StoreQueryStoreStats(ctx context.Context, stats common_domain.QueryStoreStats) error
+	GetQueryPlanHistory(ctx context.Context, serverID string, database string, queryHash string, start time.Time, end time.Time) ([]*common_domain.QueryPlanHistory, error)
+	StoreJobRuns(ctx context.Context, stats common_domain.JobStats) error
+	ListJobRuns(ctx context.Context, serverID string, start time.Time, end time.Time) ([]common_domain.JobRun, error)
+	StoreFileSizeStats(ctx context.Context, stats common_domain.FileSizeStats) error
+	GetFileSizes(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]*common_domain.FileGrowthTrend, error)
+	GetAutogrowthEvents(ctx context.Context, serverID string, database string, start time.Time, end time.Time) ([]common_domain.AutogrowthEvent, error)
 }
 
 type WarningsRepository interface {
@@ -42,3 +61,34 @@ type WarningsRepository interface {
 	GetWarningStats(ctx context.Context) (map[string]map[string]int, error)
 	BatchStoreWarnings(ctx context.Context, warnings []*common_domain.Warning, serverMeta common_domain.ServerMeta, batchSize int) error
 }
+
+type DeadlocksRepository interface {
+	StoreDeadlocks(ctx context.Context, deadlocks []*common_domain.Deadlock, serverMeta common_domain.ServerMeta) error
+	ListDeadlocks(ctx context.Context, serverID string, start time.Time, end time.Time, pageNumber int, pageSize int) ([]*common_domain.Deadlock, int, error)
+	GetDeadlock(ctx context.Context, id string) (*common_domain.Deadlock, error)
+	GetStatementHandles(ctx context.Context, server common_domain.ServerMeta, sqlHandles []string) (map[string]StatementHandles, error)
+}
+
+// StatementHandles are the plan handle and query hash a sql handle was last sampled with
+type StatementHandles struct {
+	PlanHandle string
+	QueryHash  string
+}
+
+type AgentsRepository interface {
+	RegisterAgent(ctx context.Context, registration AgentRegistration) error
+	RecordHeartbeat(ctx context.Context, agentID string, server common_domain.ServerMeta, status common_domain.CollectionStatus, timestamp time.Time) error
+	ListAgents(ctx context.Context) ([]*common_domain.Agent, error)
+	GetAgent(ctx context.Context, id string) (*common_domain.Agent, error)
+	GetCollectionConfig(ctx context.Context, server common_domain.ServerMeta) (*common_domain.CollectionConfig, error)
+	StoreCollectionConfig(ctx context.Context, config common_domain.CollectionConfig) error
+}
+
+// AgentRegistration is sent by an agent for each of its targets when it starts
+type AgentRegistration struct {
+	AgentID      string
+	AgentVersion string
+	Server       common_domain.ServerMeta
+	Tags         []string
+	Timestamp    time.Time
+}
@@ -2,12 +2,15 @@ package ports
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/app"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/app/command"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/app/query"
+	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain/converters"
 	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
 	"go.opentelemetry.io/otel"
@@ -163,10 +166,11 @@ func (s GRPCServer) ListSnapshots(ctx context.Context, request *dbmv1.ListSnapsh
 					BlockedSessions: sample.Block.BlockedSessions,
 				},
 				WaitInfo: &dbmv1.WaitMetadata{
-					WaitType:     waitType,
-					WaitTime:     int64(sample.Wait.WaitTime),
-					LastWaitType: sample.Wait.LastWaitType,
-					WaitResource: sample.Wait.WaitResource,
+					WaitType:       waitType,
+					WaitTime:       int64(sample.Wait.WaitTime),
+					LastWaitType:   sample.Wait.LastWaitType,
+					WaitResource:   sample.Wait.WaitResource,
+					ResourceObject: converters.WaitResourceObjectToProto(sample.Wait.ResourceObject),
 				},
 			}
 		}
@@ -297,3 +301,283 @@ func (s GRPCServer) GetQueryMetricsTimeSeries(ctx context.Context, in *dbmv1.Get
 	}
 	return &dbmv1.GetQueryMetricsTimeSeriesResponse{Metrics: protoM}, nil
 }
+
+func (s GRPCServer) ListDeadlocks(ctx context.Context, in *dbmv1.ListDeadlocksRequest) (*dbmv1.ListDeadlocksResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.start", in.GetStart().AsTime().Format(time.RFC3339)),
+		attribute.String("request.end", in.GetEnd().AsTime().Format(time.RFC3339)),
+		attribute.String("request.host", in.GetHost()),
+		attribute.Int64("request.page_number", in.GetPageNumber()),
+		attribute.Int64("request.page_size", int64(in.GetPageSize())),
+	)
+	pageNumber := in.GetPageNumber()
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+	deadlocks, total, err := s.app.Queries.ListDeadlocks.Handle(ctx, query.DeadlocksQuery{
+		Start:      in.GetStart().AsTime(),
+		End:        in.GetEnd().AsTime(),
+		PageNumber: int(pageNumber),
+		PageSize:   int(in.GetPageSize()),
+		ServerID:   in.GetHost(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	protoDeadlocks := make([]*dbmv1.Deadlock, len(deadlocks))
+	for i, deadlock := range deadlocks {
+		protoDeadlocks[i] = converters.DeadlockToProto(deadlock)
+		// the raw report is only sent by GetDeadlock
+		protoDeadlocks[i].XmlData = ""
+	}
+	span.SetAttributes(attribute.Int64("response.total_items", int64(total)))
+	return &dbmv1.ListDeadlocksResponse{
+		Deadlocks:  protoDeadlocks,
+		PageNumber: pageNumber,
+		TotalCount: int64(total),
+	}, nil
+}
+
+func (s GRPCServer) GetDeadlock(ctx context.Context, in *dbmv1.GetDeadlockRequest) (*dbmv1.GetDeadlockResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("request.id", in.GetId()))
+	deadlock, err := s.app.Queries.GetDeadlock.Handle(ctx, in.GetId())
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &dbmv1.GetDeadlockResponse{Deadlock: converters.DeadlockToProto(deadlock)}, nil
+}
+
+func (s GRPCServer) ListAgents(ctx context.Context, in *dbmv1.ListAgentsRequest) (*dbmv1.ListAgentsResponse, error) {
+	agents, err := s.app.Queries.ListAgents.Handle(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	protoAgents := make([]*dbmv1.Agent, len(agents))
+	for i, agent := range agents {
+		protoAgents[i] = converters.AgentToProto(agent)
+	}
+	return &dbmv1.ListAgentsResponse{Agents: protoAgents}, nil
+}
+
+func (s GRPCServer) GetAgent(ctx context.Context, in *dbmv1.GetAgentRequest) (*dbmv1.GetAgentResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("request.id", in.GetId()))
+	agent, err := s.app.Queries.GetAgent.Handle(ctx, in.GetId())
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &dbmv1.GetAgentResponse{Agent: converters.AgentToProto(agent)}, nil
+}
+
+func (s GRPCServer) GetTargetCollectionConfig(ctx context.Context, in *dbmv1.GetTargetCollectionConfigRequest) (*dbmv1.GetTargetCollectionConfigResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("request.host", in.GetHost()))
+	config, err := s.app.Queries.GetCollectionConfig.Handle(ctx, common_domain.ServerMeta{Host: in.GetHost()})
+	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &dbmv1.GetTargetCollectionConfigResponse{Config: converters.CollectionConfigToProto(config)}, nil
+}
+
+func (s GRPCServer) SetTargetCollectionConfig(ctx context.Context, in *dbmv1.SetTargetCollectionConfigRequest) (*dbmv1.SetTargetCollectionConfigResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("request.config.server.host", in.GetConfig().GetServer().GetHost()))
+	if in.GetConfig().GetServer().GetHost() == "" || in.GetConfig().GetServer().GetType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "config.server host and type are required")
+	}
+	config := converters.CollectionConfigToDomain(in.GetConfig())
+	if config.SnapshotInterval < 0 || config.MetricsInterval < 0 || config.DeadlockInterval < 0 || config.IndexStatsInterval < 0 ||
+		config.QueryStoreInterval < 0 || config.JobInterval < 0 || config.FileSizeInterval < 0 {
+		return nil, status.Error(codes.InvalidArgument, "intervals must not be negative")
+	}
+	config.UpdatedAt = time.Now()
+	err := s.app.Commands.StoreCollectionConfig.Handle(ctx, *config)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &dbmv1.SetTargetCollectionConfigResponse{Config: converters.CollectionConfigToProto(config)}, nil
+}
+
+func (s GRPCServer) GetWaitStatsTimeSeries(ctx context.Context, in *dbmv1.GetWaitStatsTimeSeriesRequest) (*dbmv1.GetWaitStatsTimeSeriesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.StringSlice("request.wait_types", in.GetWaitTypes()),
+	)
+	series, err := s.app.Queries.GetWaitStatsTimeSeries.Handle(ctx, in.GetHost(), in.GetStart().AsTime(), in.GetEnd().AsTime(),
+		in.GetWaitTypes(), int(in.GetTop()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.WaitStatSeries, len(series))
+	for i, serie := range series {
+		ret[i] = converters.WaitStatSeriesToProto(serie)
+	}
+	return &dbmv1.GetWaitStatsTimeSeriesResponse{Series: ret}, nil
+}
+
+func (s GRPCServer) GetPerformanceCountersTimeSeries(ctx context.Context, in *dbmv1.GetPerformanceCountersTimeSeriesRequest) (*dbmv1.GetPerformanceCountersTimeSeriesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.StringSlice("request.counter_names", in.GetCounterNames()),
+	)
+	series, err := s.app.Queries.GetCountersTimeSeries.Handle(ctx, in.GetHost(), in.GetStart().AsTime(), in.GetEnd().AsTime(),
+		in.GetCounterNames())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.PerformanceCounterSeries, len(series))
+	for i, serie := range series {
+		ret[i] = converters.PerformanceCounterSeriesToProto(serie)
+	}
+	return &dbmv1.GetPerformanceCountersTimeSeriesResponse{Series: ret}, nil
+}
+
+func (s GRPCServer) GetFileIOTimeSeries(ctx context.Context, in *dbmv1.GetFileIOTimeSeriesRequest) (*dbmv1.GetFileIOTimeSeriesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.StringSlice("request.databases", in.GetDatabases()),
+	)
+	series, err := s.app.Queries.GetFileIOTimeSeries.Handle(ctx, in.GetHost(), in.GetStart().AsTime(), in.GetEnd().AsTime(),
+		in.GetDatabases())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.FileIOSeries, len(series))
+	for i, serie := range series {
+		ret[i] = converters.FileIOSeriesToProto(serie)
+	}
+	return &dbmv1.GetFileIOTimeSeriesResponse{Series: ret}, nil
+}
+
+func (s GRPCServer) GetIndexReview(ctx context.Context, in *dbmv1.GetIndexReviewRequest) (*dbmv1.GetIndexReviewResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.String("request.database", in.GetDatabase()),
+	)
+	review, err := s.app.Queries.GetIndexReview.Handle(ctx, in.GetHost(), in.GetDatabase(), in.GetStart().AsTime(),
+		in.GetEnd().AsTime(), in.GetWriteRatio(), int(in.GetTopMissing()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	unused := make([]*dbmv1.IndexUsage, len(review.Unused))
+	for i, index := range review.Unused {
+		unused[i] = converters.IndexUsageToProto(index)
+	}
+	writeHeavy := make([]*dbmv1.IndexUsage, len(review.WriteHeavy))
+	for i, index := range review.WriteHeavy {
+		writeHeavy[i] = converters.IndexUsageToProto(index)
+	}
+	missing := make([]*dbmv1.MissingIndex, len(review.MissingIndexes))
+	for i, m := range review.MissingIndexes {
+		missing[i] = converters.MissingIndexToProto(m)
+	}
+	return &dbmv1.GetIndexReviewResponse{Unused: unused, WriteHeavy: writeHeavy, MissingIndexes: missing}, nil
+}
+
+func (s GRPCServer) GetQueryPlanHistory(ctx context.Context, in *dbmv1.GetQueryPlanHistoryRequest) (*dbmv1.GetQueryPlanHistoryResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.String("request.query_hash", in.GetQueryHash()),
+		attribute.String("request.database", in.GetDatabase()),
+	)
+	if in.GetQueryHash() == "" {
+		return nil, status.Error(codes.InvalidArgument, "query_hash is required")
+	}
+	plans, err := s.app.Queries.GetQueryPlanHistory.Handle(ctx, in.GetHost(), in.GetDatabase(), in.GetQueryHash(),
+		in.GetStart().AsTime(), in.GetEnd().AsTime())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.QueryPlanHistory, len(plans))
+	for i, plan := range plans {
+		ret[i] = converters.QueryPlanHistoryToProto(plan)
+	}
+	return &dbmv1.GetQueryPlanHistoryResponse{Plans: ret}, nil
+}
+
+func (s GRPCServer) ListJobRuns(ctx context.Context, in *dbmv1.ListJobRunsRequest) (*dbmv1.ListJobRunsResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.Bool("request.long_running_only", in.GetLongRunningOnly()),
+	)
+	runs, err := s.app.Queries.ListJobRuns.Handle(ctx, in.GetHost(), in.GetStart().AsTime(), in.GetEnd().AsTime(),
+		in.GetLongRunningOnly())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.JobRunReview, len(runs))
+	for i, run := range runs {
+		ret[i] = converters.JobRunReviewToProto(run)
+	}
+	return &dbmv1.ListJobRunsResponse{Runs: ret}, nil
+}
+
+func (s GRPCServer) GetAvailabilityGroupTopology(ctx context.Context, in *dbmv1.GetAvailabilityGroupTopologyRequest) (*dbmv1.GetAvailabilityGroupTopologyResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.String("request.ag_name", in.GetAgName()),
+	)
+	topologies, err := s.app.Queries.GetAGTopology.Handle(ctx, in.GetHost(), in.GetAgName())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.AvailabilityGroupTopology, len(topologies))
+	for i, topology := range topologies {
+		ret[i] = converters.AvailabilityGroupTopologyToProto(topology)
+	}
+	return &dbmv1.GetAvailabilityGroupTopologyResponse{AvailabilityGroups: ret}, nil
+}
+
+func (s GRPCServer) GetReplicaStatesTimeSeries(ctx context.Context, in *dbmv1.GetReplicaStatesTimeSeriesRequest) (*dbmv1.GetReplicaStatesTimeSeriesResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.String("request.ag_name", in.GetAgName()),
+	)
+	series, err := s.app.Queries.GetReplicaStatesTimeSeries.Handle(ctx, in.GetHost(), in.GetStart().AsTime(),
+		in.GetEnd().AsTime(), in.GetAgName())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.ReplicaStateSeries, len(series))
+	for i, serie := range series {
+		ret[i] = converters.ReplicaStateSeriesToProto(serie)
+	}
+	return &dbmv1.GetReplicaStatesTimeSeriesResponse{Series: ret}, nil
+}
+
+func (s GRPCServer) GetFileGrowthTrend(ctx context.Context, in *dbmv1.GetFileGrowthTrendRequest) (*dbmv1.GetFileGrowthTrendResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.host", in.GetHost()),
+		attribute.String("request.database", in.GetDatabase()),
+	)
+	trends, err := s.app.Queries.GetFileGrowthTrend.Handle(ctx, in.GetHost(), in.GetDatabase(), in.GetStart().AsTime(),
+		in.GetEnd().AsTime())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ret := make([]*dbmv1.FileGrowthTrend, len(trends))
+	for i, trend := range trends {
+		ret[i] = converters.FileGrowthTrendToProto(trend)
+	}
+	return &dbmv1.GetFileGrowthTrendResponse{Trends: ret}, nil
+}
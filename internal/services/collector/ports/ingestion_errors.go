@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ingestionError maps the error storing ingested data to a grpc status. Data the database refuses (too long, out of
+// range, violating a constraint) is InvalidArgument, the agent drops it instead of sending it again.
+func ingestionError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		case "22", "23":
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return err
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIngestionError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{name: "value too long", err: fmt.Errorf("insert warnings: %w", &pq.Error{Code: "22001"}), expected: codes.InvalidArgument},
+		{name: "foreign key violation", err: fmt.Errorf("insert samples: %w", &pq.Error{Code: "23503"}), expected: codes.InvalidArgument},
+		{name: "connection failure", err: fmt.Errorf("insert samples: %w", &pq.Error{Code: "08006"}), expected: codes.Unknown},
+		{name: "other error", err: errors.New("start transaction: driver: bad connection"), expected: codes.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, status.Code(ingestionError(tt.err)))
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/guilhermearpassos/database-monitoring/internal/common/custom_errors"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/app"
 	"github.com/guilhermearpassos/database-monitoring/internal/services/collector/app/command"
@@ -23,12 +22,11 @@ import (
 type IngestionSvc struct {
 	*collectorv1.UnimplementedIngestionServiceServer
 	*collectorv1.UnimplementedCollectorSyncServiceServer
-	agents map[string]*domain.AgentConfig
-	app    app.Application
+	app app.Application
 }
 
 func NewIngestionSvc(app app.Application) *IngestionSvc {
-	return &IngestionSvc{agents: make(map[string]*domain.AgentConfig), app: app}
+	return &IngestionSvc{app: app}
 }
 
 func (s IngestionSvc) RegisterAgent(ctx context.Context, request *collectorv1.RegisterAgentRequest) (*collectorv1.RegisterAgentResponse, error) {
@@ -37,33 +35,140 @@ func (s IngestionSvc) RegisterAgent(ctx context.Context, request *collectorv1.Re
 		attribute.String("request.target_host", request.TargetHost),
 		attribute.String("request.target_type", request.TargetType),
 		attribute.String("request.agent_version", request.AgentVersion),
+		attribute.String("request.agent_id", request.AgentId),
 	)
-
-	s.agents[request.TargetHost] = &domain.AgentConfig{
-		ID:           uuid.NewString(),
-		TargetHost:   request.TargetHost,
-		TargetType:   request.TargetType,
+	if request.TargetHost == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_host is required")
+	}
+	agentID := request.AgentId
+	if agentID == "" {
+		// agents older than the agent id report one registration per target
+		agentID = request.TargetHost
+	}
+	err := s.app.Commands.RegisterAgent.Handle(ctx, domain.AgentRegistration{
+		AgentID:      agentID,
 		AgentVersion: request.AgentVersion,
-		Tags:         nil,
+		Server:       common_domain.ServerMeta{Host: request.TargetHost, Type: request.TargetType},
+		Tags:         request.Tags,
+		Timestamp:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
 	}
 	return &collectorv1.RegisterAgentResponse{}, nil
 }
 
+func (s IngestionSvc) Heartbeat(ctx context.Context, in *collectorv1.HeartbeatRequest) (*collectorv1.HeartbeatResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.agent_id", in.GetAgentId()),
+		attribute.String("request.server.host", in.GetServer().GetHost()),
+	)
+	err := s.app.Commands.RecordHeartbeat.Handle(ctx, command.RecordHeartbeat{
+		AgentID:   in.GetAgentId(),
+		Server:    common_domain.ServerMeta{Host: in.GetServer().GetHost(), Type: in.GetServer().GetType()},
+		Status:    converters.CollectionStatusToDomain(in.GetStatus()),
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		// the agent registers again when the collector does not know it
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &collectorv1.HeartbeatResponse{}, nil
+}
+
+func (s IngestionSvc) GetCollectionConfig(ctx context.Context, in *collectorv1.GetCollectionConfigRequest) (*collectorv1.GetCollectionConfigResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.agent_id", in.GetAgentId()),
+		attribute.String("request.server.host", in.GetServer().GetHost()),
+	)
+	config, err := s.app.Queries.GetCollectionConfig.Handle(ctx, common_domain.ServerMeta{Host: in.GetServer().GetHost(), Type: in.GetServer().GetType()})
+	if err != nil {
+		// targets without a managed config are collected with the agent local config
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &collectorv1.GetCollectionConfigResponse{Config: converters.CollectionConfigToProto(config)}, nil
+}
+
 func (s IngestionSvc) IngestMetrics(ctx context.Context, metrics *collectorv1.DatabaseMetrics) (*collectorv1.IngestMetricsResponse, error) {
+	if metrics.GetServer() == nil {
+		return nil, status.Error(codes.InvalidArgument, "server is required")
+	}
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(
 		attribute.String("request.timestamp", metrics.Timestamp.AsTime().Format(time.RFC3339)),
 		attribute.String("request.server.host", metrics.Server.Host),
 		attribute.String("request.server.type", metrics.Server.Type),
-		attribute.Int("request.metrics_count", len(metrics.GetQueryMetrics().QueryMetrics)),
+		attribute.Int("request.metrics_count", len(metrics.GetQueryMetrics().GetQueryMetrics())),
+		attribute.Int("request.wait_stats_count", len(metrics.GetSystemMetrics().GetWaitStats())),
+		attribute.Int("request.counters_count", len(metrics.GetSystemMetrics().GetCounters())),
+		attribute.Int("request.file_io_count", len(metrics.GetSystemMetrics().GetFileIo())),
+		attribute.Int("request.replica_count", len(metrics.GetSystemMetrics().GetReplicas())),
+		attribute.Int("request.index_count", len(metrics.GetIndexStats().GetIndexes())),
+		attribute.Int("request.query_store_plans_count", len(metrics.GetQueryStore().GetPlans())),
+		attribute.Int("request.job_runs_count", len(metrics.GetJobs().GetRuns())),
+		attribute.Int("request.file_sizes_count", len(metrics.GetFileSizes().GetFiles())),
 	)
 
 	timestamp := metrics.Timestamp.AsTime()
+	if indexStats := metrics.GetIndexStats(); indexStats != nil {
+		server := common_domain.ServerMeta{Host: metrics.Server.Host, Type: metrics.Server.Type}
+		err := s.app.Commands.StoreIndexStats.Handle(ctx, *converters.IndexStatsToDomain(server, timestamp, indexStats))
+		if err != nil {
+			return nil, err
+		}
+		return &collectorv1.IngestMetricsResponse{Success: true}, nil
+	}
+	if queryStore := metrics.GetQueryStore(); queryStore != nil {
+		server := common_domain.ServerMeta{Host: metrics.Server.Host, Type: metrics.Server.Type}
+		err := s.app.Commands.StoreQueryStore.Handle(ctx, *converters.QueryStoreStatsToDomain(server, timestamp, queryStore))
+		if err != nil {
+			return nil, err
+		}
+		return &collectorv1.IngestMetricsResponse{Success: true}, nil
+	}
+	if jobs := metrics.GetJobs(); jobs != nil {
+		server := common_domain.ServerMeta{Host: metrics.Server.Host, Type: metrics.Server.Type}
+		err := s.app.Commands.StoreJobRuns.Handle(ctx, *converters.JobStatsToDomain(server, timestamp, jobs))
+		if err != nil {
+			return nil, err
+		}
+		return &collectorv1.IngestMetricsResponse{Success: true}, nil
+	}
+	if fileSizes := metrics.GetFileSizes(); fileSizes != nil {
+		server := common_domain.ServerMeta{Host: metrics.Server.Host, Type: metrics.Server.Type}
+		err := s.app.Commands.StoreFileSizeStats.Handle(ctx, *converters.FileSizeStatsToDomain(server, timestamp, fileSizes))
+		if err != nil {
+			return nil, err
+		}
+		return &collectorv1.IngestMetricsResponse{Success: true}, nil
+	}
+	if systemMetrics := metrics.GetSystemMetrics(); systemMetrics != nil {
+		err := s.app.Commands.StoreSystemMetrics.Handle(ctx, common_domain.SystemMetrics{
+			Server:    common_domain.ServerMeta{Host: metrics.Server.Host, Type: metrics.Server.Type},
+			Timestamp: timestamp,
+			WaitStats: converters.WaitStatsToDomain(systemMetrics.GetWaitStats()),
+			Counters:  converters.PerformanceCountersToDomain(systemMetrics.GetCounters()),
+			FileIO:    converters.FileIOStatsToDomain(systemMetrics.GetFileIo()),
+			Replicas:  converters.ReplicaStatesToDomain(systemMetrics.GetReplicas()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &collectorv1.IngestMetricsResponse{Success: true}, nil
+	}
 	domainMetrics := make([]*common_domain.QueryMetric, len(metrics.GetQueryMetrics().QueryMetrics))
 	for i, m := range metrics.GetQueryMetrics().QueryMetrics {
 		domainMetric, err := converters.QueryMetricToDomain(m)
 		if err != nil {
-			return nil, err
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		domainMetric.CollectionTime = timestamp
 		domainMetrics[i] = domainMetric
@@ -84,6 +189,9 @@ func (s IngestionSvc) IngestMetrics(ctx context.Context, metrics *collectorv1.Da
 func (s IngestionSvc) IngestSnapshot(ctx context.Context, request *collectorv1.IngestSnapshotRequest) (*collectorv1.IngestSnapshotResponse, error) {
 	span := trace.SpanFromContext(ctx)
 	snapshot := request.GetSnapshot()
+	if snapshot.GetServer() == nil {
+		return nil, status.Error(codes.InvalidArgument, "snapshot server is required")
+	}
 	span.SetAttributes(
 		attribute.String("request.snapshot.id", snapshot.Id),
 		attribute.String("request.snapshot.server_host", snapshot.Server.Host),
@@ -94,7 +202,7 @@ func (s IngestionSvc) IngestSnapshot(ctx context.Context, request *collectorv1.I
 	domain_snap := converters.DatabaseSnapshotToDomain(snapshot)
 	err := s.app.Commands.StoreSnapshot.Handle(ctx, domain_snap)
 	if err != nil {
-		return nil, err
+		return nil, ingestionError(err)
 	}
 	return &collectorv1.IngestSnapshotResponse{}, nil
 }
@@ -112,7 +220,7 @@ func (s IngestionSvc) IngestSnapshotSamples(ctx context.Context, request *collec
 	}
 	err := s.app.Commands.StoreSnapshotSamples.Handle(ctx, request.GetId(), samples)
 	if err != nil {
-		return nil, err
+		return nil, ingestionError(err)
 	}
 	return &collectorv1.IngestSnapshotSamplesResponse{}, nil
 }
@@ -127,13 +235,13 @@ func (s IngestionSvc) IngestExecutionPlans(ctx context.Context, in *collectorv1.
 	for i, plan := range in.GetPlans() {
 		protoPlan, err := converters.ExecutionPlanToDomain(plan)
 		if err != nil {
-			return nil, err
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		domainPlans[i] = protoPlan
 	}
 	err := s.app.Commands.StoreExecutionPlans.Handle(ctx, domainPlans)
 	if err != nil {
-		return nil, err
+		return nil, ingestionError(err)
 	}
 	return &collectorv1.IngestExecutionPlansResponse{}, nil
 }
@@ -178,7 +286,7 @@ func (s IngestionSvc) IngestWarnings(ctx context.Context, in *collectorv1.Ingest
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, ingestionError(err)
 	}
 	return &collectorv1.IngestWarningsResponse{}, nil
 }
@@ -191,6 +299,9 @@ func (s IngestionSvc) GetKnownWarnings(ctx context.Context, in *collectorv1.GetK
 	)
 	warns, err := s.app.Queries.GetKnownWarnings.Handle(ctx, in.GetServer().GetHost(), int(in.GetPageSize()), int(in.GetPageNumber()))
 	if err != nil {
+		if errors.As(err, &custom_errors.NotFoundErr{}) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
 	protoW := make([]*dbmv1.Warning, len(warns))
@@ -199,3 +310,28 @@ func (s IngestionSvc) GetKnownWarnings(ctx context.Context, in *collectorv1.GetK
 	}
 	return &collectorv1.GetKnownWarningsResponse{Warnings: protoW}, nil
 }
+
+func (s IngestionSvc) IngestDeadlocks(ctx context.Context, in *collectorv1.IngestDeadlocksRequest) (*collectorv1.IngestDeadlocksResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("request.server.host", in.GetServer().GetHost()),
+		attribute.Int("request.deadlocks_count", len(in.GetDeadlocks())),
+	)
+	serverMeta := common_domain.ServerMeta{
+		Host: in.GetServer().GetHost(),
+		Type: in.GetServer().GetType(),
+	}
+	deadlocks := make([]*common_domain.Deadlock, len(in.GetDeadlocks()))
+	for i, deadlock := range in.GetDeadlocks() {
+		deadlocks[i] = converters.DeadlockToDomain(deadlock)
+		deadlocks[i].Server = serverMeta
+	}
+	err := s.app.Commands.StoreDeadlocks.Handle(ctx, command.StoreDeadlocks{
+		Deadlocks:  deadlocks,
+		ServerMeta: serverMeta,
+	})
+	if err != nil {
+		return nil, ingestionError(err)
+	}
+	return &collectorv1.IngestDeadlocksResponse{}, nil
+}
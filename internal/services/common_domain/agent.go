@@ -0,0 +1,46 @@
+package common_domain
+
+import "time"
+
+type Agent struct {
+	ID           string
+	Version      string
+	RegisteredAt time.Time
+	LastSeen     time.Time
+	Targets      []AgentTarget
+}
+
+type AgentTarget struct {
+	Server       ServerMeta
+	Tags         []string
+	RegisteredAt time.Time
+	LastSeen     time.Time
+	Status       CollectionStatus
+}
+
+// CollectionStatus is reported by the agent on every heartbeat, zero times mean it did not happen since the agent started.
+type CollectionStatus struct {
+	LastSnapshot  time.Time
+	LastMetrics   time.Time
+	LastError     string
+	LastErrorTime time.Time
+}
+
+// CollectionConfig is what an agent collects from a target and how often, it is managed centrally on the collector.
+// A zero interval keeps the value the agent was configured with.
+type CollectionConfig struct {
+	Server             ServerMeta
+	SnapshotInterval   time.Duration
+	MetricsInterval    time.Duration
+	DeadlockInterval   time.Duration
+	IndexStatsInterval time.Duration
+	QueryStoreInterval time.Duration
+	JobInterval        time.Duration
+	FileSizeInterval   time.Duration
+	Databases          DatabaseFilter
+	CollectMetrics     bool
+	CollectDeadlocks   bool
+	FetchPlans         bool
+	CollectLockMetrics bool
+	UpdatedAt          time.Time
+}
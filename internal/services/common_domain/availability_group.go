@@ -0,0 +1,113 @@
+package common_domain
+
+import "time"
+
+const ReplicaRolePrimary = "PRIMARY"
+
+// ReplicaState is a dm_hadr_database_replica_states reading of an availability database on one replica
+type ReplicaState struct {
+	AGName                string
+	ReplicaServerName     string
+	DatabaseName          string
+	Role                  string
+	AvailabilityMode      string
+	FailoverMode          string
+	ConnectedState        string
+	IsLocal               bool
+	SynchronizationState  string
+	SynchronizationHealth string
+	IsSuspended           bool
+	SuspendReason         string
+	LogSendQueueKB        int64
+	LogSendRateKBps       int64
+	RedoQueueKB           int64
+	RedoRateKBps          int64
+	LastCommitTime        time.Time
+	// EstimatedDataLossS is how far the last commit of the replica is behind the primary
+	EstimatedDataLossS int64
+	// EstimatedRecoveryTimeS is the time to redo the redo queue at the current redo rate
+	EstimatedRecoveryTimeS int64
+}
+
+// EstimateRecoveryTime returns the seconds to redo redoQueueKB at redoRateKBps, a queue without redo activity is
+// reported as one second per KB so that a stuck redo does not look healthy
+func EstimateRecoveryTime(redoQueueKB int64, redoRateKBps int64) int64 {
+	if redoQueueKB <= 0 {
+		return 0
+	}
+	if redoRateKBps <= 0 {
+		return redoQueueKB
+	}
+	return (redoQueueKB + redoRateKBps - 1) / redoRateKBps
+}
+
+type ReplicaStateSeries struct {
+	AGName            string
+	ReplicaServerName string
+	DatabaseName      string
+	Points            []ReplicaStatePoint
+}
+
+type ReplicaStatePoint struct {
+	Timestamp time.Time
+	ReplicaState
+}
+
+// AvailabilityGroupTopology is the latest state of the replicas of an availability group
+type AvailabilityGroupTopology struct {
+	AGName      string
+	CollectedAt time.Time
+	Replicas    []AvailabilityReplica
+}
+
+type AvailabilityReplica struct {
+	ReplicaServerName string
+	Role              string
+	AvailabilityMode  string
+	FailoverMode      string
+	ConnectedState    string
+	// SynchronizationHealth is the worst health of the databases of the replica
+	SynchronizationHealth string
+	Databases             []ReplicaState
+}
+
+// BuildAvailabilityGroupTopologies groups replica states by availability group and replica, keeping the order of
+// the states
+func BuildAvailabilityGroupTopologies(states []ReplicaState, collectedAt time.Time) []AvailabilityGroupTopology {
+	ret := make([]AvailabilityGroupTopology, 0)
+	for _, state := range states {
+		if len(ret) == 0 || ret[len(ret)-1].AGName != state.AGName {
+			ret = append(ret, AvailabilityGroupTopology{AGName: state.AGName, CollectedAt: collectedAt})
+		}
+		ag := &ret[len(ret)-1]
+		if len(ag.Replicas) == 0 || ag.Replicas[len(ag.Replicas)-1].ReplicaServerName != state.ReplicaServerName {
+			ag.Replicas = append(ag.Replicas, AvailabilityReplica{
+				ReplicaServerName:     state.ReplicaServerName,
+				Role:                  state.Role,
+				AvailabilityMode:      state.AvailabilityMode,
+				FailoverMode:          state.FailoverMode,
+				ConnectedState:        state.ConnectedState,
+				SynchronizationHealth: state.SynchronizationHealth,
+			})
+		}
+		replica := &ag.Replicas[len(ag.Replicas)-1]
+		if synchronizationHealthRank(state.SynchronizationHealth) > synchronizationHealthRank(replica.SynchronizationHealth) {
+			replica.SynchronizationHealth = state.SynchronizationHealth
+		}
+		replica.Databases = append(replica.Databases, state)
+	}
+	return ret
+}
+
+func synchronizationHealthRank(health string) int {
+	switch health {
+	case "HEALTHY":
+		return 0
+	case "PARTIALLY_HEALTHY":
+		return 1
+	case "NOT_HEALTHY":
+		return 2
+	default:
+		return -1
+	}
+}
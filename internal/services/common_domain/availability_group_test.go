@@ -0,0 +1,42 @@
+package common_domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAvailabilityGroupTopologies(t *testing.T) {
+	collectedAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	state := func(ag string, replica string, role string, database string, health string) ReplicaState {
+		return ReplicaState{AGName: ag, ReplicaServerName: replica, Role: role, DatabaseName: database,
+			SynchronizationHealth: health}
+	}
+	states := []ReplicaState{
+		state("ag1", "node1", ReplicaRolePrimary, "orders", "HEALTHY"),
+		state("ag1", "node1", ReplicaRolePrimary, "stock", "HEALTHY"),
+		state("ag1", "node2", "SECONDARY", "orders", "HEALTHY"),
+		state("ag1", "node2", "SECONDARY", "stock", "NOT_HEALTHY"),
+		state("ag2", "node1", "SECONDARY", "billing", "PARTIALLY_HEALTHY"),
+	}
+
+	got := BuildAvailabilityGroupTopologies(states, collectedAt)
+	require.Len(t, got, 2)
+	assert.Equal(t, "ag1", got[0].AGName)
+	assert.Equal(t, collectedAt, got[0].CollectedAt)
+	require.Len(t, got[0].Replicas, 2)
+	assert.Equal(t, "node1", got[0].Replicas[0].ReplicaServerName)
+	assert.Equal(t, "HEALTHY", got[0].Replicas[0].SynchronizationHealth)
+	assert.Len(t, got[0].Replicas[0].Databases, 2)
+	assert.Equal(t, "NOT_HEALTHY", got[0].Replicas[1].SynchronizationHealth)
+	require.Len(t, got[1].Replicas, 1)
+	assert.Equal(t, "PARTIALLY_HEALTHY", got[1].Replicas[0].SynchronizationHealth)
+}
+
+func TestEstimateRecoveryTime(t *testing.T) {
+	assert.Equal(t, int64(0), EstimateRecoveryTime(0, 100))
+	assert.Equal(t, int64(3), EstimateRecoveryTime(250, 100))
+	assert.Equal(t, int64(500), EstimateRecoveryTime(500, 0))
+}
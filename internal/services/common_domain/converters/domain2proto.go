@@ -1,8 +1,13 @@
 package converters
 
 import (
+	"time"
+
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
 	"github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	collectorv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1/collector"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,8 +22,35 @@ func DatabaseSnapshotToProto(d *common_domain.DataBaseSnapshot) *dbmv1.DBSnapsho
 		Server: &dbmv1.ServerMetadata{Host: d.SnapInfo.Server.Host,
 			Type: d.SnapInfo.Server.Type,
 		},
-		Samples: samples,
+		Samples:        samples,
+		Transactions:   OpenTransactionsToProto(d.Transactions),
+		VersionStoreKb: d.VersionStoreKB,
+	}
+}
+
+func OpenTransactionsToProto(transactions []common_domain.OpenTransaction) []*dbmv1.OpenTransaction {
+	ret := make([]*dbmv1.OpenTransaction, len(transactions))
+	for i, t := range transactions {
+		ret[i] = &dbmv1.OpenTransaction{
+			TransactionId:     t.TransactionID,
+			Name:              t.Name,
+			SessionId:         t.Session.SessionID,
+			HostName:          t.Session.HostName,
+			ProgramName:       t.Session.ProgramName,
+			LoginName:         t.Session.LoginName,
+			SessionStatus:     t.Session.Status,
+			BeginTime:         timestamppb.New(t.BeginTime),
+			DurationMs:        t.DurationMs,
+			TransactionType:   t.TransactionType,
+			TransactionState:  t.TransactionState,
+			DatabaseName:      t.DatabaseName,
+			LogBytesUsed:      t.LogBytesUsed,
+			LogBytesReserved:  t.LogBytesReserved,
+			LogRecordCount:    t.LogRecordCount,
+			HoldsVersionStore: t.HoldsVersionStore,
+		}
 	}
+	return ret
 }
 
 func SampleToProto(sample *common_domain.QuerySample) *dbmv1.QuerySample {
@@ -55,18 +87,23 @@ func SampleToProto(sample *common_domain.QuerySample) *dbmv1.QuerySample {
 			BlockedSessions: sample.Block.BlockedSessions,
 		},
 		WaitInfo: &dbmv1.WaitMetadata{
-			WaitType:     waitType,
-			WaitTime:     int64(sample.Wait.WaitTime),
-			LastWaitType: sample.Wait.LastWaitType,
-			WaitResource: sample.Wait.WaitResource,
+			WaitType:       waitType,
+			WaitTime:       int64(sample.Wait.WaitTime),
+			LastWaitType:   sample.Wait.LastWaitType,
+			WaitResource:   sample.Wait.WaitResource,
+			ResourceObject: WaitResourceObjectToProto(sample.Wait.ResourceObject),
 		},
 		SnapInfo: &dbmv1.SnapMetadata{
 			Id:        sample.Snapshot.ID,
 			Timestamp: timestamppb.New(sample.Snapshot.Timestamp),
 		},
-		PlanHandle: sample.PlanHandle,
-		Id:         sample.Id,
-		Command:    CommandMetaToProto(&sample.CommandMetadata),
+		PlanHandle:   sample.PlanHandle,
+		Id:           sample.Id,
+		Command:      CommandMetaToProto(&sample.CommandMetadata),
+		Locks:        LockDetailsToProto(sample.Locks),
+		IdleSession:  IdleSessionToProto(sample.IdleSession, sample.Snapshot.Timestamp),
+		Statement:    StatementToProto(sample.Statement),
+		LiveProgress: OperatorProgressToProto(sample.LiveProgress),
 	}
 }
 
@@ -121,3 +158,588 @@ func CommandMetaToProto(cm *common_domain.CommandMetadata) *dbmv1.CommandMetadat
 		PercentComplete:         cm.PercentComplete,
 	}
 }
+
+func WaitResourceObjectToProto(o *common_domain.WaitResourceObject) *dbmv1.WaitResourceObject {
+	if o == nil {
+		return nil
+	}
+	return &dbmv1.WaitResourceObject{
+		ResourceType: o.Type,
+		DatabaseName: o.DatabaseName,
+		SchemaName:   o.SchemaName,
+		TableName:    o.TableName,
+		IndexName:    o.IndexName,
+	}
+}
+
+func OperatorProgressToProto(progress []common_domain.OperatorProgress) []*dbmv1.OperatorProgress {
+	ret := make([]*dbmv1.OperatorProgress, len(progress))
+	for i, p := range progress {
+		ret[i] = &dbmv1.OperatorProgress{
+			NodeId:            p.NodeID,
+			PhysicalOperator:  p.PhysicalOperator,
+			RowCount:          p.RowCount,
+			EstimatedRowCount: p.EstimatedRowCount,
+			ElapsedTimeMs:     p.ElapsedTimeMs,
+			CpuTimeMs:         p.CPUTimeMs,
+			LogicalReads:      p.LogicalReads,
+			Active:            p.Active,
+		}
+	}
+	return ret
+}
+
+func StatementToProto(s *common_domain.StatementMetadata) *dbmv1.StatementMetadata {
+	if s == nil {
+		return nil
+	}
+	return &dbmv1.StatementMetadata{
+		Text:        s.Text,
+		StartOffset: s.StartOffset,
+		EndOffset:   s.EndOffset,
+		ObjectName:  s.ObjectName,
+	}
+}
+
+func IdleSessionToProto(m *common_domain.IdleSessionMetadata, at time.Time) *dbmv1.IdleSessionMetadata {
+	if m == nil {
+		return nil
+	}
+	ret := &dbmv1.IdleSessionMetadata{
+		InputBuffer:           m.InputBuffer,
+		InputBufferEventType:  m.InputBufferEventType,
+		OpenTransactionCount:  m.OpenTransactionCount,
+		TransactionDurationMs: m.TransactionDuration(at).Milliseconds(),
+	}
+	if !m.TransactionBeginTime.IsZero() {
+		ret.TransactionBeginTime = timestamppb.New(m.TransactionBeginTime)
+	}
+	return ret
+}
+
+func LockDetailsToProto(locks []common_domain.LockDetail) []*dbmv1.LockDetail {
+	ret := make([]*dbmv1.LockDetail, len(locks))
+	for i, l := range locks {
+		ret[i] = &dbmv1.LockDetail{
+			ResourceType:        l.ResourceType,
+			DatabaseId:          l.DatabaseID,
+			DatabaseName:        l.DatabaseName,
+			ResourceDescription: l.ResourceDescription,
+			AssociatedEntityId:  l.AssociatedEntityID,
+			RequestMode:         l.Mode,
+			RequestStatus:       l.Status,
+			ResourceObject:      WaitResourceObjectToProto(l.ResourceObject),
+		}
+	}
+	return ret
+}
+
+func LockConflictToProto(c *common_domain.LockConflict) *dbmv1.LockConflict {
+	if c == nil {
+		return nil
+	}
+	return &dbmv1.LockConflict{
+		WaitingLock:       LockDetailsToProto([]common_domain.LockDetail{c.WaitingLock})[0],
+		BlockingSessionId: c.BlockingSessionID,
+		BlockingLocks:     LockDetailsToProto(c.BlockingLocks),
+	}
+}
+
+func DeadlockToProto(d *common_domain.Deadlock) *dbmv1.Deadlock {
+	processes := make([]*dbmv1.DeadlockProcess, len(d.Processes))
+	for i, p := range d.Processes {
+		frames := make([]*dbmv1.DeadlockFrame, len(p.Frames))
+		for j, f := range p.Frames {
+			frames[j] = &dbmv1.DeadlockFrame{
+				ProcName:      f.ProcName,
+				Line:          int32(f.Line),
+				SqlHandle:     f.SqlHandle,
+				QueryHash:     f.QueryHash,
+				QueryPlanHash: f.QueryPlanHash,
+				PlanHandle:    f.PlanHandle,
+				Text:          f.Text,
+			}
+		}
+		processes[i] = &dbmv1.DeadlockProcess{
+			Id:                     p.ID,
+			SessionId:              p.SessionID,
+			Victim:                 p.IsVictim,
+			DatabaseId:             p.Database.DatabaseID,
+			DatabaseName:           p.Database.DatabaseName,
+			LoginName:              p.LoginName,
+			HostName:               p.HostName,
+			ProgramName:            p.ProgramName,
+			IsolationLevel:         p.IsolationLevel,
+			Status:                 p.Status,
+			LockMode:               p.LockMode,
+			WaitResource:           p.WaitResource,
+			WaitTimeMs:             p.WaitTimeMs,
+			TransactionName:        p.TransactionName,
+			LastTransactionStarted: timestamppb.New(p.LastTransactionStarted),
+			TransactionCount:       int32(p.TransactionCount),
+			InputBuffer:            p.InputBuffer,
+			Frames:                 frames,
+		}
+	}
+	resources := make([]*dbmv1.DeadlockResource, len(d.Resources))
+	for i, r := range d.Resources {
+		resources[i] = &dbmv1.DeadlockResource{
+			ResourceType: r.ResourceType,
+			DatabaseId:   r.DatabaseID,
+			ObjectName:   r.ObjectName,
+			IndexName:    r.IndexName,
+			HobtId:       r.HobtID,
+			Mode:         r.Mode,
+			Owners:       deadlockLocksToProto(r.Owners),
+			Waiters:      deadlockLocksToProto(r.Waiters),
+		}
+	}
+	return &dbmv1.Deadlock{
+		Id:               d.ID,
+		Server:           &dbmv1.ServerMetadata{Host: d.Server.Host, Type: d.Server.Type},
+		Timestamp:        timestamppb.New(d.Timestamp),
+		VictimProcessIds: d.VictimProcessIDs,
+		Processes:        processes,
+		Resources:        resources,
+		XmlData:          d.XmlData,
+	}
+}
+
+func deadlockLocksToProto(locks []common_domain.DeadlockLock) []*dbmv1.DeadlockResource_Lock {
+	ret := make([]*dbmv1.DeadlockResource_Lock, len(locks))
+	for i, l := range locks {
+		ret[i] = &dbmv1.DeadlockResource_Lock{
+			ProcessId:   l.ProcessID,
+			Mode:        l.Mode,
+			RequestType: l.RequestType,
+		}
+	}
+	return ret
+}
+
+func AgentToProto(a *common_domain.Agent) *dbmv1.Agent {
+	targets := make([]*dbmv1.AgentTarget, len(a.Targets))
+	for i, t := range a.Targets {
+		targets[i] = &dbmv1.AgentTarget{
+			Server:       &dbmv1.ServerMetadata{Host: t.Server.Host, Type: t.Server.Type},
+			Tags:         t.Tags,
+			RegisteredAt: timestamppb.New(t.RegisteredAt),
+			LastSeen:     timestamppb.New(t.LastSeen),
+			Status:       CollectionStatusToProto(t.Status),
+		}
+	}
+	return &dbmv1.Agent{
+		Id:           a.ID,
+		Version:      a.Version,
+		RegisteredAt: timestamppb.New(a.RegisteredAt),
+		LastSeen:     timestamppb.New(a.LastSeen),
+		Targets:      targets,
+	}
+}
+
+func CollectionStatusToProto(s common_domain.CollectionStatus) *dbmv1.TargetCollectionStatus {
+	return &dbmv1.TargetCollectionStatus{
+		LastSnapshot:  optionalTimestamp(s.LastSnapshot),
+		LastMetrics:   optionalTimestamp(s.LastMetrics),
+		LastError:     s.LastError,
+		LastErrorTime: optionalTimestamp(s.LastErrorTime),
+	}
+}
+
+// optionalTimestamp leaves unset times out of the message instead of sending year 1
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func CollectionConfigToProto(c *common_domain.CollectionConfig) *dbmv1.TargetCollectionConfig {
+	return &dbmv1.TargetCollectionConfig{
+		Server:             &dbmv1.ServerMetadata{Host: c.Server.Host, Type: c.Server.Type},
+		SnapshotInterval:   durationpb.New(c.SnapshotInterval),
+		MetricsInterval:    durationpb.New(c.MetricsInterval),
+		DeadlockInterval:   durationpb.New(c.DeadlockInterval),
+		IndexStatsInterval: durationpb.New(c.IndexStatsInterval),
+		QueryStoreInterval: durationpb.New(c.QueryStoreInterval),
+		JobInterval:        durationpb.New(c.JobInterval),
+		FileSizeInterval:   durationpb.New(c.FileSizeInterval),
+		Databases:          c.Databases.Include,
+		ExcludeDatabases:   c.Databases.Exclude,
+		CollectMetrics:     c.CollectMetrics,
+		CollectDeadlocks:   c.CollectDeadlocks,
+		FetchPlans:         proto.Bool(c.FetchPlans),
+		CollectLockMetrics: proto.Bool(c.CollectLockMetrics),
+		UpdatedAt:          optionalTimestamp(c.UpdatedAt),
+	}
+}
+
+func WaitStatsToProto(stats []common_domain.WaitStat) []*collectorv1.WaitStatDelta {
+	ret := make([]*collectorv1.WaitStatDelta, len(stats))
+	for i, w := range stats {
+		ret[i] = &collectorv1.WaitStatDelta{
+			WaitType:         w.WaitType,
+			WaitingTasks:     w.WaitingTasks,
+			WaitTimeMs:       w.WaitTimeMs,
+			SignalWaitTimeMs: w.SignalWaitTimeMs,
+			MaxWaitTimeMs:    w.MaxWaitTimeMs,
+		}
+	}
+	return ret
+}
+
+func WaitStatSeriesToProto(s *common_domain.WaitStatSeries) *dbmv1.WaitStatSeries {
+	points := make([]*dbmv1.WaitStatPoint, len(s.Points))
+	for i, p := range s.Points {
+		points[i] = &dbmv1.WaitStatPoint{
+			Timestamp:        timestamppb.New(p.Timestamp),
+			WaitingTasks:     p.WaitingTasks,
+			WaitTimeMs:       p.WaitTimeMs,
+			SignalWaitTimeMs: p.SignalWaitTimeMs,
+			MaxWaitTimeMs:    p.MaxWaitTimeMs,
+		}
+	}
+	return &dbmv1.WaitStatSeries{WaitType: s.WaitType, Points: points}
+}
+
+func PerformanceCountersToProto(counters []common_domain.PerformanceCounter) []*collectorv1.PerformanceCounters {
+	ret := make([]*collectorv1.PerformanceCounters, len(counters))
+	for i, c := range counters {
+		ret[i] = &collectorv1.PerformanceCounters{
+			CounterName:  c.Name,
+			CounterValue: c.Value,
+			CounterRate:  c.Rate,
+		}
+	}
+	return ret
+}
+
+func PerformanceCounterSeriesToProto(s *common_domain.PerformanceCounterSeries) *dbmv1.PerformanceCounterSeries {
+	points := make([]*dbmv1.PerformanceCounterPoint, len(s.Points))
+	for i, p := range s.Points {
+		points[i] = &dbmv1.PerformanceCounterPoint{
+			Timestamp: timestamppb.New(p.Timestamp),
+			Value:     p.Value,
+			Rate:      p.Rate,
+		}
+	}
+	return &dbmv1.PerformanceCounterSeries{CounterName: s.Name, Points: points}
+}
+
+func FileIOStatsToProto(stats []common_domain.FileIOStat) []*collectorv1.FileIOStatDelta {
+	ret := make([]*collectorv1.FileIOStatDelta, len(stats))
+	for i, f := range stats {
+		ret[i] = &collectorv1.FileIOStatDelta{
+			DatabaseName: f.DatabaseName,
+			FileId:       f.FileID,
+			LogicalName:  f.LogicalName,
+			PhysicalName: f.PhysicalName,
+			FileType:     f.FileType,
+			IntervalMs:   f.IntervalMs,
+			Reads:        f.Reads,
+			BytesRead:    f.BytesRead,
+			ReadStallMs:  f.ReadStallMs,
+			Writes:       f.Writes,
+			BytesWritten: f.BytesWritten,
+			WriteStallMs: f.WriteStallMs,
+		}
+	}
+	return ret
+}
+
+func FileIOSeriesToProto(s *common_domain.FileIOSeries) *dbmv1.FileIOSeries {
+	points := make([]*dbmv1.FileIOPoint, len(s.Points))
+	for i, p := range s.Points {
+		points[i] = &dbmv1.FileIOPoint{
+			Timestamp:      timestamppb.New(p.Timestamp),
+			IntervalMs:     p.IntervalMs,
+			Reads:          p.Reads,
+			BytesRead:      p.BytesRead,
+			ReadStallMs:    p.ReadStallMs,
+			Writes:         p.Writes,
+			BytesWritten:   p.BytesWritten,
+			WriteStallMs:   p.WriteStallMs,
+			ReadLatencyMs:  p.ReadLatencyMs(),
+			WriteLatencyMs: p.WriteLatencyMs(),
+		}
+	}
+	return &dbmv1.FileIOSeries{
+		DatabaseName: s.DatabaseName,
+		FileId:       s.FileID,
+		LogicalName:  s.LogicalName,
+		PhysicalName: s.PhysicalName,
+		FileType:     s.FileType,
+		Points:       points,
+	}
+}
+
+func IndexUsageToProto(i common_domain.IndexUsage) *dbmv1.IndexUsage {
+	return &dbmv1.IndexUsage{
+		DatabaseName:   i.DatabaseName,
+		SchemaName:     i.SchemaName,
+		TableName:      i.TableName,
+		IndexName:      i.IndexName,
+		IndexId:        i.IndexID,
+		IndexType:      i.IndexType,
+		IsUnique:       i.IsUnique,
+		IsPrimaryKey:   i.IsPrimaryKey,
+		UserSeeks:      i.UserSeeks,
+		UserScans:      i.UserScans,
+		UserLookups:    i.UserLookups,
+		UserUpdates:    i.UserUpdates,
+		LastUserRead:   optionalTimestamp(i.LastUserRead),
+		LastUserUpdate: optionalTimestamp(i.LastUserUpdate),
+		SizeKb:         i.SizeKB,
+		RowCount:       i.RowCount,
+	}
+}
+
+func MissingIndexToProto(m common_domain.MissingIndex) *dbmv1.MissingIndex {
+	return &dbmv1.MissingIndex{
+		DatabaseName:       m.DatabaseName,
+		TableName:          m.TableName,
+		EqualityColumns:    m.EqualityColumns,
+		InequalityColumns:  m.InequalityColumns,
+		IncludedColumns:    m.IncludedColumns,
+		UniqueCompiles:     m.UniqueCompiles,
+		UserSeeks:          m.UserSeeks,
+		UserScans:          m.UserScans,
+		AvgTotalUserCost:   m.AvgTotalUserCost,
+		AvgUserImpact:      m.AvgUserImpact,
+		LastUserSeek:       optionalTimestamp(m.LastUserSeek),
+		ImprovementMeasure: m.ImprovementMeasure(),
+	}
+}
+
+func IndexStatsToProto(stats *common_domain.IndexStats) *collectorv1.DatabaseMetrics_IndexStatsSample {
+	indexes := make([]*dbmv1.IndexUsage, len(stats.Indexes))
+	for i, index := range stats.Indexes {
+		indexes[i] = IndexUsageToProto(index)
+	}
+	missing := make([]*dbmv1.MissingIndex, len(stats.MissingIndexes))
+	for i, m := range stats.MissingIndexes {
+		missing[i] = MissingIndexToProto(m)
+	}
+	return &collectorv1.DatabaseMetrics_IndexStatsSample{Indexes: indexes, MissingIndexes: missing}
+}
+
+func QueryStorePlanToProto(p common_domain.QueryStorePlan) *dbmv1.QueryStorePlan {
+	return &dbmv1.QueryStorePlan{
+		DatabaseName:      p.DatabaseName,
+		QueryId:           p.QueryID,
+		PlanId:            p.PlanID,
+		QueryHash:         p.QueryHash,
+		QueryPlanHash:     p.QueryPlanHash,
+		QueryText:         p.QueryText,
+		PlanXml:           p.PlanXML,
+		IsForcedPlan:      p.IsForcedPlan,
+		FirstCompileTime:  optionalTimestamp(p.FirstCompileTime),
+		LastExecutionTime: optionalTimestamp(p.LastExecutionTime),
+	}
+}
+
+func QueryStoreRuntimeStatsToProto(rs common_domain.QueryStoreRuntimeStats) *dbmv1.QueryStoreRuntimeStats {
+	return &dbmv1.QueryStoreRuntimeStats{
+		DatabaseName:      rs.DatabaseName,
+		PlanId:            rs.PlanID,
+		IntervalStart:     timestamppb.New(rs.IntervalStart),
+		IntervalEnd:       timestamppb.New(rs.IntervalEnd),
+		Executions:        rs.Executions,
+		AbortedExecutions: rs.AbortedExecutions,
+		AvgDurationUs:     rs.AvgDurationUs,
+		MaxDurationUs:     rs.MaxDurationUs,
+		AvgCpuTimeUs:      rs.AvgCPUTimeUs,
+		AvgLogicalReads:   rs.AvgLogicalReads,
+		AvgPhysicalReads:  rs.AvgPhysicalReads,
+		AvgRowcount:       rs.AvgRowCount,
+		LastExecutionTime: optionalTimestamp(rs.LastExecutionTime),
+	}
+}
+
+func QueryStoreStatsToProto(stats *common_domain.QueryStoreStats) *collectorv1.DatabaseMetrics_QueryStoreSample {
+	plans := make([]*dbmv1.QueryStorePlan, len(stats.Plans))
+	for i, p := range stats.Plans {
+		plans[i] = QueryStorePlanToProto(p)
+	}
+	runtimeStats := make([]*dbmv1.QueryStoreRuntimeStats, len(stats.RuntimeStats))
+	for i, rs := range stats.RuntimeStats {
+		runtimeStats[i] = QueryStoreRuntimeStatsToProto(rs)
+	}
+	return &collectorv1.DatabaseMetrics_QueryStoreSample{Plans: plans, RuntimeStats: runtimeStats}
+}
+
+func QueryPlanHistoryToProto(h *common_domain.QueryPlanHistory) *dbmv1.QueryPlanHistory {
+	runtimeStats := make([]*dbmv1.QueryStoreRuntimeStats, len(h.RuntimeStats))
+	for i, rs := range h.RuntimeStats {
+		runtimeStats[i] = QueryStoreRuntimeStatsToProto(rs)
+	}
+	return &dbmv1.QueryPlanHistory{
+		Plan:            QueryStorePlanToProto(h.Plan),
+		RuntimeStats:    runtimeStats,
+		Executions:      h.Executions,
+		AvgDurationUs:   h.AvgDurationUs,
+		AvgCpuTimeUs:    h.AvgCPUTimeUs,
+		AvgLogicalReads: h.AvgLogicalReads,
+		Regressed:       h.Regressed,
+	}
+}
+
+func JobRunToProto(r common_domain.JobRun) *dbmv1.JobRun {
+	return &dbmv1.JobRun{
+		JobId:       r.JobID,
+		JobName:     r.JobName,
+		Status:      r.Status,
+		StartTime:   timestamppb.New(r.StartTime),
+		DurationMs:  r.DurationMs,
+		Message:     r.Message,
+		CurrentStep: r.CurrentStep,
+	}
+}
+
+func JobStatsToProto(stats *common_domain.JobStats) *collectorv1.DatabaseMetrics_JobSample {
+	runs := make([]*dbmv1.JobRun, len(stats.Runs))
+	for i, r := range stats.Runs {
+		runs[i] = JobRunToProto(r)
+	}
+	return &collectorv1.DatabaseMetrics_JobSample{Runs: runs}
+}
+
+func JobRunReviewToProto(r common_domain.JobRunReview) *dbmv1.JobRunReview {
+	return &dbmv1.JobRunReview{
+		Run:              JobRunToProto(r.Run),
+		MedianDurationMs: r.MedianDurationMs,
+		LongRunning:      r.LongRunning,
+	}
+}
+
+func ReplicaStateToProto(r common_domain.ReplicaState) *dbmv1.ReplicaState {
+	ret := &dbmv1.ReplicaState{
+		AgName:                 r.AGName,
+		ReplicaServerName:      r.ReplicaServerName,
+		DatabaseName:           r.DatabaseName,
+		Role:                   r.Role,
+		AvailabilityMode:       r.AvailabilityMode,
+		FailoverMode:           r.FailoverMode,
+		ConnectedState:         r.ConnectedState,
+		IsLocal:                r.IsLocal,
+		SynchronizationState:   r.SynchronizationState,
+		SynchronizationHealth:  r.SynchronizationHealth,
+		IsSuspended:            r.IsSuspended,
+		SuspendReason:          r.SuspendReason,
+		LogSendQueueKb:         r.LogSendQueueKB,
+		LogSendRateKbps:        r.LogSendRateKBps,
+		RedoQueueKb:            r.RedoQueueKB,
+		RedoRateKbps:           r.RedoRateKBps,
+		EstimatedDataLossS:     r.EstimatedDataLossS,
+		EstimatedRecoveryTimeS: r.EstimatedRecoveryTimeS,
+	}
+	if !r.LastCommitTime.IsZero() {
+		ret.LastCommitTime = timestamppb.New(r.LastCommitTime)
+	}
+	return ret
+}
+
+func ReplicaStatesToProto(states []common_domain.ReplicaState) []*dbmv1.ReplicaState {
+	ret := make([]*dbmv1.ReplicaState, len(states))
+	for i, r := range states {
+		ret[i] = ReplicaStateToProto(r)
+	}
+	return ret
+}
+
+func ReplicaStateSeriesToProto(s *common_domain.ReplicaStateSeries) *dbmv1.ReplicaStateSeries {
+	points := make([]*dbmv1.ReplicaStatePoint, len(s.Points))
+	for i, p := range s.Points {
+		points[i] = &dbmv1.ReplicaStatePoint{
+			Timestamp:              timestamppb.New(p.Timestamp),
+			Role:                   p.Role,
+			SynchronizationState:   p.SynchronizationState,
+			LogSendQueueKb:         p.LogSendQueueKB,
+			LogSendRateKbps:        p.LogSendRateKBps,
+			RedoQueueKb:            p.RedoQueueKB,
+			RedoRateKbps:           p.RedoRateKBps,
+			EstimatedDataLossS:     p.EstimatedDataLossS,
+			EstimatedRecoveryTimeS: p.EstimatedRecoveryTimeS,
+		}
+	}
+	return &dbmv1.ReplicaStateSeries{
+		AgName:            s.AGName,
+		ReplicaServerName: s.ReplicaServerName,
+		DatabaseName:      s.DatabaseName,
+		Points:            points,
+	}
+}
+
+func AvailabilityGroupTopologyToProto(t common_domain.AvailabilityGroupTopology) *dbmv1.AvailabilityGroupTopology {
+	replicas := make([]*dbmv1.AvailabilityReplica, len(t.Replicas))
+	for i, r := range t.Replicas {
+		replicas[i] = &dbmv1.AvailabilityReplica{
+			ReplicaServerName:     r.ReplicaServerName,
+			Role:                  r.Role,
+			AvailabilityMode:      r.AvailabilityMode,
+			FailoverMode:          r.FailoverMode,
+			ConnectedState:        r.ConnectedState,
+			SynchronizationHealth: r.SynchronizationHealth,
+			Databases:             ReplicaStatesToProto(r.Databases),
+		}
+	}
+	return &dbmv1.AvailabilityGroupTopology{
+		AgName:      t.AGName,
+		CollectedAt: timestamppb.New(t.CollectedAt),
+		Replicas:    replicas,
+	}
+}
+
+func FileSizeStatsToProto(stats *common_domain.FileSizeStats) *collectorv1.DatabaseMetrics_FileSizeSample {
+	files := make([]*dbmv1.DatabaseFileSize, len(stats.Files))
+	for i, f := range stats.Files {
+		files[i] = &dbmv1.DatabaseFileSize{
+			DatabaseName:    f.DatabaseName,
+			FileId:          f.FileID,
+			LogicalName:     f.LogicalName,
+			PhysicalName:    f.PhysicalName,
+			FileType:        f.FileType,
+			SizeKb:          f.SizeKB,
+			UsedKb:          f.UsedKB,
+			MaxSizeKb:       f.MaxSizeKB,
+			Growth:          f.Growth,
+			IsPercentGrowth: f.IsPercentGrowth,
+			VolumeFreeKb:    f.VolumeFreeKB,
+		}
+	}
+	return &collectorv1.DatabaseMetrics_FileSizeSample{Files: files, GrowthEvents: AutogrowthEventsToProto(stats.GrowthEvents)}
+}
+
+func AutogrowthEventsToProto(events []common_domain.AutogrowthEvent) []*dbmv1.AutogrowthEvent {
+	ret := make([]*dbmv1.AutogrowthEvent, len(events))
+	for i, e := range events {
+		ret[i] = &dbmv1.AutogrowthEvent{
+			DatabaseName: e.DatabaseName,
+			LogicalName:  e.LogicalName,
+			FileType:     e.FileType,
+			StartTime:    timestamppb.New(e.StartTime),
+			DurationMs:   e.DurationMs,
+			GrowthKb:     e.GrowthKB,
+		}
+	}
+	return ret
+}
+
+func FileGrowthTrendToProto(t *common_domain.FileGrowthTrend) *dbmv1.FileGrowthTrend {
+	points := make([]*dbmv1.FileSizePoint, len(t.Points))
+	for i, p := range t.Points {
+		points[i] = &dbmv1.FileSizePoint{Timestamp: timestamppb.New(p.Timestamp), SizeKb: p.SizeKB, UsedKb: p.UsedKB}
+	}
+	return &dbmv1.FileGrowthTrend{
+		DatabaseName:   t.DatabaseName,
+		FileId:         t.FileID,
+		LogicalName:    t.LogicalName,
+		PhysicalName:   t.PhysicalName,
+		FileType:       t.FileType,
+		CapacityKb:     t.CapacityKB,
+		Points:         points,
+		GrowthKbPerDay: t.GrowthKBPerDay,
+		DaysUntilFull:  t.DaysUntilFull,
+		GrowthEvents:   AutogrowthEventsToProto(t.GrowthEvents),
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/guilhermearpassos/database-monitoring/internal/services/common_domain"
 	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	collectorv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1/collector"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func DatabaseSnapshotToDomain(p *dbmv1.DBSnapshot) common_domain.DataBaseSnapshot {
@@ -21,10 +23,39 @@ func DatabaseSnapshotToDomain(p *dbmv1.DBSnapshot) common_domain.DataBaseSnapsho
 				Type: p.Server.Type,
 			},
 		},
-		Samples: samples,
+		Samples:        samples,
+		Transactions:   OpenTransactionsToDomain(p.Transactions),
+		VersionStoreKB: p.VersionStoreKb,
 	}
 }
 
+func OpenTransactionsToDomain(transactions []*dbmv1.OpenTransaction) []common_domain.OpenTransaction {
+	ret := make([]common_domain.OpenTransaction, len(transactions))
+	for i, t := range transactions {
+		ret[i] = common_domain.OpenTransaction{
+			TransactionID: t.TransactionId,
+			Name:          t.Name,
+			Session: common_domain.SessionMetadata{
+				SessionID:   t.SessionId,
+				HostName:    t.HostName,
+				ProgramName: t.ProgramName,
+				LoginName:   t.LoginName,
+				Status:      t.SessionStatus,
+			},
+			BeginTime:         t.BeginTime.AsTime(),
+			DurationMs:        t.DurationMs,
+			TransactionType:   t.TransactionType,
+			TransactionState:  t.TransactionState,
+			DatabaseName:      t.DatabaseName,
+			LogBytesUsed:      t.LogBytesUsed,
+			LogBytesReserved:  t.LogBytesReserved,
+			LogRecordCount:    t.LogRecordCount,
+			HoldsVersionStore: t.HoldsVersionStore,
+		}
+	}
+	return ret
+}
+
 func SampleToDomain(sample *dbmv1.QuerySample) *common_domain.QuerySample {
 	return &common_domain.QuerySample{
 		Status:        sample.Status,
@@ -55,10 +86,11 @@ func SampleToDomain(sample *dbmv1.QuerySample) *common_domain.QuerySample {
 			BlockedSessions: sample.BlockInfo.BlockedSessions,
 		},
 		Wait: common_domain.WaitMetadata{
-			WaitType:     &sample.WaitInfo.WaitType,
-			WaitTime:     int(sample.WaitInfo.WaitTime),
-			LastWaitType: sample.WaitInfo.LastWaitType,
-			WaitResource: sample.WaitInfo.WaitResource,
+			WaitType:       &sample.WaitInfo.WaitType,
+			WaitTime:       int(sample.WaitInfo.WaitTime),
+			LastWaitType:   sample.WaitInfo.LastWaitType,
+			WaitResource:   sample.WaitInfo.WaitResource,
+			ResourceObject: WaitResourceObjectToDomain(sample.WaitInfo.ResourceObject),
 		},
 		Snapshot: common_domain.SnapshotMetadata{
 			ID:        sample.SnapInfo.Id,
@@ -68,6 +100,10 @@ func SampleToDomain(sample *dbmv1.QuerySample) *common_domain.QuerySample {
 		PlanHandle:      sample.PlanHandle,
 		Id:              sample.Id,
 		CommandMetadata: CommandMetaToDomain(sample.Command),
+		Locks:           LockDetailsToDomain(sample.Locks),
+		IdleSession:     IdleSessionToDomain(sample.IdleSession),
+		Statement:       StatementToDomain(sample.Statement),
+		LiveProgress:    OperatorProgressToDomain(sample.LiveProgress),
 	}
 }
 
@@ -102,3 +138,424 @@ func CommandMetaToDomain(cm *dbmv1.CommandMetadata) common_domain.CommandMetadat
 		PercentComplete:         cm.PercentComplete,
 	}
 }
+
+func WaitResourceObjectToDomain(o *dbmv1.WaitResourceObject) *common_domain.WaitResourceObject {
+	if o == nil {
+		return nil
+	}
+	return &common_domain.WaitResourceObject{
+		Type:         o.ResourceType,
+		DatabaseName: o.DatabaseName,
+		SchemaName:   o.SchemaName,
+		TableName:    o.TableName,
+		IndexName:    o.IndexName,
+	}
+}
+
+func OperatorProgressToDomain(progress []*dbmv1.OperatorProgress) []common_domain.OperatorProgress {
+	if len(progress) == 0 {
+		return nil
+	}
+	ret := make([]common_domain.OperatorProgress, len(progress))
+	for i, p := range progress {
+		ret[i] = common_domain.OperatorProgress{
+			NodeID:            p.NodeId,
+			PhysicalOperator:  p.PhysicalOperator,
+			RowCount:          p.RowCount,
+			EstimatedRowCount: p.EstimatedRowCount,
+			ElapsedTimeMs:     p.ElapsedTimeMs,
+			CPUTimeMs:         p.CpuTimeMs,
+			LogicalReads:      p.LogicalReads,
+			Active:            p.Active,
+		}
+	}
+	return ret
+}
+
+func StatementToDomain(s *dbmv1.StatementMetadata) *common_domain.StatementMetadata {
+	if s == nil {
+		return nil
+	}
+	return &common_domain.StatementMetadata{
+		Text:        s.Text,
+		StartOffset: s.StartOffset,
+		EndOffset:   s.EndOffset,
+		ObjectName:  s.ObjectName,
+	}
+}
+
+func IdleSessionToDomain(m *dbmv1.IdleSessionMetadata) *common_domain.IdleSessionMetadata {
+	if m == nil {
+		return nil
+	}
+	ret := &common_domain.IdleSessionMetadata{
+		InputBuffer:          m.InputBuffer,
+		InputBufferEventType: m.InputBufferEventType,
+		OpenTransactionCount: m.OpenTransactionCount,
+	}
+	if m.TransactionBeginTime != nil {
+		ret.TransactionBeginTime = m.TransactionBeginTime.AsTime()
+	}
+	return ret
+}
+
+func LockDetailsToDomain(locks []*dbmv1.LockDetail) []common_domain.LockDetail {
+	if len(locks) == 0 {
+		return nil
+	}
+	ret := make([]common_domain.LockDetail, len(locks))
+	for i, l := range locks {
+		ret[i] = common_domain.LockDetail{
+			ResourceType:        l.ResourceType,
+			DatabaseID:          l.DatabaseId,
+			DatabaseName:        l.DatabaseName,
+			ResourceDescription: l.ResourceDescription,
+			AssociatedEntityID:  l.AssociatedEntityId,
+			Mode:                l.RequestMode,
+			Status:              l.RequestStatus,
+			ResourceObject:      WaitResourceObjectToDomain(l.ResourceObject),
+		}
+	}
+	return ret
+}
+
+func DeadlockToDomain(d *dbmv1.Deadlock) *common_domain.Deadlock {
+	processes := make([]common_domain.DeadlockProcess, len(d.Processes))
+	for i, p := range d.Processes {
+		frames := make([]common_domain.DeadlockFrame, len(p.Frames))
+		for j, f := range p.Frames {
+			frames[j] = common_domain.DeadlockFrame{
+				ProcName:      f.ProcName,
+				Line:          int(f.Line),
+				SqlHandle:     f.SqlHandle,
+				QueryHash:     f.QueryHash,
+				QueryPlanHash: f.QueryPlanHash,
+				PlanHandle:    f.PlanHandle,
+				Text:          f.Text,
+			}
+		}
+		processes[i] = common_domain.DeadlockProcess{
+			ID:        p.Id,
+			SessionID: p.SessionId,
+			IsVictim:  p.Victim,
+			Database: common_domain.DataBaseMetadata{
+				DatabaseID:   p.DatabaseId,
+				DatabaseName: p.DatabaseName,
+			},
+			LoginName:              p.LoginName,
+			HostName:               p.HostName,
+			ProgramName:            p.ProgramName,
+			IsolationLevel:         p.IsolationLevel,
+			Status:                 p.Status,
+			LockMode:               p.LockMode,
+			WaitResource:           p.WaitResource,
+			WaitTimeMs:             p.WaitTimeMs,
+			TransactionName:        p.TransactionName,
+			LastTransactionStarted: p.GetLastTransactionStarted().AsTime(),
+			TransactionCount:       int(p.TransactionCount),
+			InputBuffer:            p.InputBuffer,
+			Frames:                 frames,
+		}
+	}
+	resources := make([]common_domain.DeadlockResource, len(d.Resources))
+	for i, r := range d.Resources {
+		resources[i] = common_domain.DeadlockResource{
+			ResourceType: r.ResourceType,
+			DatabaseID:   r.DatabaseId,
+			ObjectName:   r.ObjectName,
+			IndexName:    r.IndexName,
+			HobtID:       r.HobtId,
+			Mode:         r.Mode,
+			Owners:       deadlockLocksToDomain(r.Owners),
+			Waiters:      deadlockLocksToDomain(r.Waiters),
+		}
+	}
+	return &common_domain.Deadlock{
+		ID: d.Id,
+		Server: common_domain.ServerMeta{
+			Host: d.GetServer().GetHost(),
+			Type: d.GetServer().GetType(),
+		},
+		Timestamp:        d.GetTimestamp().AsTime(),
+		VictimProcessIDs: d.VictimProcessIds,
+		Processes:        processes,
+		Resources:        resources,
+		XmlData:          d.XmlData,
+	}
+}
+
+func deadlockLocksToDomain(locks []*dbmv1.DeadlockResource_Lock) []common_domain.DeadlockLock {
+	ret := make([]common_domain.DeadlockLock, len(locks))
+	for i, l := range locks {
+		ret[i] = common_domain.DeadlockLock{
+			ProcessID:   l.ProcessId,
+			Mode:        l.Mode,
+			RequestType: l.RequestType,
+		}
+	}
+	return ret
+}
+
+func CollectionStatusToDomain(s *dbmv1.TargetCollectionStatus) common_domain.CollectionStatus {
+	return common_domain.CollectionStatus{
+		LastSnapshot:  optionalTime(s.GetLastSnapshot()),
+		LastMetrics:   optionalTime(s.GetLastMetrics()),
+		LastError:     s.GetLastError(),
+		LastErrorTime: optionalTime(s.GetLastErrorTime()),
+	}
+}
+
+func optionalTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+// CollectionConfigToDomain reads fetch_plans and collect_lock_metrics as on when they are unset
+func CollectionConfigToDomain(c *dbmv1.TargetCollectionConfig) *common_domain.CollectionConfig {
+	return &common_domain.CollectionConfig{
+		Server: common_domain.ServerMeta{
+			Host: c.GetServer().GetHost(),
+			Type: c.GetServer().GetType(),
+		},
+		SnapshotInterval:   c.GetSnapshotInterval().AsDuration(),
+		MetricsInterval:    c.GetMetricsInterval().AsDuration(),
+		DeadlockInterval:   c.GetDeadlockInterval().AsDuration(),
+		IndexStatsInterval: c.GetIndexStatsInterval().AsDuration(),
+		QueryStoreInterval: c.GetQueryStoreInterval().AsDuration(),
+		JobInterval:        c.GetJobInterval().AsDuration(),
+		FileSizeInterval:   c.GetFileSizeInterval().AsDuration(),
+		Databases: common_domain.DatabaseFilter{
+			Include: c.GetDatabases(),
+			Exclude: c.GetExcludeDatabases(),
+		},
+		CollectMetrics:     c.GetCollectMetrics(),
+		CollectDeadlocks:   c.GetCollectDeadlocks(),
+		FetchPlans:         c.FetchPlans == nil || c.GetFetchPlans(),
+		CollectLockMetrics: c.CollectLockMetrics == nil || c.GetCollectLockMetrics(),
+		UpdatedAt:          optionalTime(c.GetUpdatedAt()),
+	}
+}
+
+func WaitStatsToDomain(stats []*collectorv1.WaitStatDelta) []common_domain.WaitStat {
+	ret := make([]common_domain.WaitStat, len(stats))
+	for i, w := range stats {
+		ret[i] = common_domain.WaitStat{
+			WaitType:         w.GetWaitType(),
+			WaitingTasks:     w.GetWaitingTasks(),
+			WaitTimeMs:       w.GetWaitTimeMs(),
+			SignalWaitTimeMs: w.GetSignalWaitTimeMs(),
+			MaxWaitTimeMs:    w.GetMaxWaitTimeMs(),
+		}
+	}
+	return ret
+}
+
+func PerformanceCountersToDomain(counters []*collectorv1.PerformanceCounters) []common_domain.PerformanceCounter {
+	ret := make([]common_domain.PerformanceCounter, len(counters))
+	for i, c := range counters {
+		ret[i] = common_domain.PerformanceCounter{
+			Name:  c.GetCounterName(),
+			Value: c.GetCounterValue(),
+			Rate:  c.GetCounterRate(),
+		}
+	}
+	return ret
+}
+
+func FileIOStatsToDomain(stats []*collectorv1.FileIOStatDelta) []common_domain.FileIOStat {
+	ret := make([]common_domain.FileIOStat, len(stats))
+	for i, f := range stats {
+		ret[i] = common_domain.FileIOStat{
+			DatabaseName: f.GetDatabaseName(),
+			FileID:       f.GetFileId(),
+			LogicalName:  f.GetLogicalName(),
+			PhysicalName: f.GetPhysicalName(),
+			FileType:     f.GetFileType(),
+			IntervalMs:   f.GetIntervalMs(),
+			Reads:        f.GetReads(),
+			BytesRead:    f.GetBytesRead(),
+			ReadStallMs:  f.GetReadStallMs(),
+			Writes:       f.GetWrites(),
+			BytesWritten: f.GetBytesWritten(),
+			WriteStallMs: f.GetWriteStallMs(),
+		}
+	}
+	return ret
+}
+
+func IndexUsageToDomain(i *dbmv1.IndexUsage) common_domain.IndexUsage {
+	return common_domain.IndexUsage{
+		DatabaseName:   i.GetDatabaseName(),
+		SchemaName:     i.GetSchemaName(),
+		TableName:      i.GetTableName(),
+		IndexName:      i.GetIndexName(),
+		IndexID:        i.GetIndexId(),
+		IndexType:      i.GetIndexType(),
+		IsUnique:       i.GetIsUnique(),
+		IsPrimaryKey:   i.GetIsPrimaryKey(),
+		UserSeeks:      i.GetUserSeeks(),
+		UserScans:      i.GetUserScans(),
+		UserLookups:    i.GetUserLookups(),
+		UserUpdates:    i.GetUserUpdates(),
+		LastUserRead:   optionalTime(i.GetLastUserRead()),
+		LastUserUpdate: optionalTime(i.GetLastUserUpdate()),
+		SizeKB:         i.GetSizeKb(),
+		RowCount:       i.GetRowCount(),
+	}
+}
+
+func MissingIndexToDomain(m *dbmv1.MissingIndex) common_domain.MissingIndex {
+	return common_domain.MissingIndex{
+		DatabaseName:      m.GetDatabaseName(),
+		TableName:         m.GetTableName(),
+		EqualityColumns:   m.GetEqualityColumns(),
+		InequalityColumns: m.GetInequalityColumns(),
+		IncludedColumns:   m.GetIncludedColumns(),
+		UniqueCompiles:    m.GetUniqueCompiles(),
+		UserSeeks:         m.GetUserSeeks(),
+		UserScans:         m.GetUserScans(),
+		AvgTotalUserCost:  m.GetAvgTotalUserCost(),
+		AvgUserImpact:     m.GetAvgUserImpact(),
+		LastUserSeek:      optionalTime(m.GetLastUserSeek()),
+	}
+}
+
+func IndexStatsToDomain(server common_domain.ServerMeta, timestamp time.Time, stats *collectorv1.DatabaseMetrics_IndexStatsSample) *common_domain.IndexStats {
+	indexes := make([]common_domain.IndexUsage, len(stats.GetIndexes()))
+	for i, index := range stats.GetIndexes() {
+		indexes[i] = IndexUsageToDomain(index)
+	}
+	missing := make([]common_domain.MissingIndex, len(stats.GetMissingIndexes()))
+	for i, m := range stats.GetMissingIndexes() {
+		missing[i] = MissingIndexToDomain(m)
+	}
+	return &common_domain.IndexStats{Server: server, Timestamp: timestamp, Indexes: indexes, MissingIndexes: missing}
+}
+
+func QueryStorePlanToDomain(p *dbmv1.QueryStorePlan) common_domain.QueryStorePlan {
+	return common_domain.QueryStorePlan{
+		DatabaseName:      p.GetDatabaseName(),
+		QueryID:           p.GetQueryId(),
+		PlanID:            p.GetPlanId(),
+		QueryHash:         p.GetQueryHash(),
+		QueryPlanHash:     p.GetQueryPlanHash(),
+		QueryText:         p.GetQueryText(),
+		PlanXML:           p.GetPlanXml(),
+		IsForcedPlan:      p.GetIsForcedPlan(),
+		FirstCompileTime:  optionalTime(p.GetFirstCompileTime()),
+		LastExecutionTime: optionalTime(p.GetLastExecutionTime()),
+	}
+}
+
+func QueryStoreRuntimeStatsToDomain(rs *dbmv1.QueryStoreRuntimeStats) common_domain.QueryStoreRuntimeStats {
+	return common_domain.QueryStoreRuntimeStats{
+		DatabaseName:      rs.GetDatabaseName(),
+		PlanID:            rs.GetPlanId(),
+		IntervalStart:     rs.GetIntervalStart().AsTime(),
+		IntervalEnd:       rs.GetIntervalEnd().AsTime(),
+		Executions:        rs.GetExecutions(),
+		AbortedExecutions: rs.GetAbortedExecutions(),
+		AvgDurationUs:     rs.GetAvgDurationUs(),
+		MaxDurationUs:     rs.GetMaxDurationUs(),
+		AvgCPUTimeUs:      rs.GetAvgCpuTimeUs(),
+		AvgLogicalReads:   rs.GetAvgLogicalReads(),
+		AvgPhysicalReads:  rs.GetAvgPhysicalReads(),
+		AvgRowCount:       rs.GetAvgRowcount(),
+		LastExecutionTime: optionalTime(rs.GetLastExecutionTime()),
+	}
+}
+
+func QueryStoreStatsToDomain(server common_domain.ServerMeta, timestamp time.Time, stats *collectorv1.DatabaseMetrics_QueryStoreSample) *common_domain.QueryStoreStats {
+	plans := make([]common_domain.QueryStorePlan, len(stats.GetPlans()))
+	for i, p := range stats.GetPlans() {
+		plans[i] = QueryStorePlanToDomain(p)
+	}
+	runtimeStats := make([]common_domain.QueryStoreRuntimeStats, len(stats.GetRuntimeStats()))
+	for i, rs := range stats.GetRuntimeStats() {
+		runtimeStats[i] = QueryStoreRuntimeStatsToDomain(rs)
+	}
+	return &common_domain.QueryStoreStats{Server: server, Timestamp: timestamp, Plans: plans, RuntimeStats: runtimeStats}
+}
+
+func JobRunToDomain(r *dbmv1.JobRun) common_domain.JobRun {
+	return common_domain.JobRun{
+		JobID:       r.GetJobId(),
+		JobName:     r.GetJobName(),
+		Status:      r.GetStatus(),
+		StartTime:   r.GetStartTime().AsTime(),
+		DurationMs:  r.GetDurationMs(),
+		Message:     r.GetMessage(),
+		CurrentStep: r.GetCurrentStep(),
+	}
+}
+
+func JobStatsToDomain(server common_domain.ServerMeta, timestamp time.Time, stats *collectorv1.DatabaseMetrics_JobSample) *common_domain.JobStats {
+	runs := make([]common_domain.JobRun, len(stats.GetRuns()))
+	for i, r := range stats.GetRuns() {
+		runs[i] = JobRunToDomain(r)
+	}
+	return &common_domain.JobStats{Server: server, Timestamp: timestamp, Runs: runs}
+}
+
+func ReplicaStatesToDomain(states []*dbmv1.ReplicaState) []common_domain.ReplicaState {
+	ret := make([]common_domain.ReplicaState, len(states))
+	for i, r := range states {
+		ret[i] = common_domain.ReplicaState{
+			AGName:                 r.GetAgName(),
+			ReplicaServerName:      r.GetReplicaServerName(),
+			DatabaseName:           r.GetDatabaseName(),
+			Role:                   r.GetRole(),
+			AvailabilityMode:       r.GetAvailabilityMode(),
+			FailoverMode:           r.GetFailoverMode(),
+			ConnectedState:         r.GetConnectedState(),
+			IsLocal:                r.GetIsLocal(),
+			SynchronizationState:   r.GetSynchronizationState(),
+			SynchronizationHealth:  r.GetSynchronizationHealth(),
+			IsSuspended:            r.GetIsSuspended(),
+			SuspendReason:          r.GetSuspendReason(),
+			LogSendQueueKB:         r.GetLogSendQueueKb(),
+			LogSendRateKBps:        r.GetLogSendRateKbps(),
+			RedoQueueKB:            r.GetRedoQueueKb(),
+			RedoRateKBps:           r.GetRedoRateKbps(),
+			EstimatedDataLossS:     r.GetEstimatedDataLossS(),
+			EstimatedRecoveryTimeS: r.GetEstimatedRecoveryTimeS(),
+		}
+		if r.GetLastCommitTime() != nil {
+			ret[i].LastCommitTime = r.GetLastCommitTime().AsTime()
+		}
+	}
+	return ret
+}
+
+func FileSizeStatsToDomain(server common_domain.ServerMeta, timestamp time.Time, stats *collectorv1.DatabaseMetrics_FileSizeSample) *common_domain.FileSizeStats {
+	files := make([]common_domain.DatabaseFileSize, len(stats.GetFiles()))
+	for i, f := range stats.GetFiles() {
+		files[i] = common_domain.DatabaseFileSize{
+			DatabaseName:    f.GetDatabaseName(),
+			FileID:          f.GetFileId(),
+			LogicalName:     f.GetLogicalName(),
+			PhysicalName:    f.GetPhysicalName(),
+			FileType:        f.GetFileType(),
+			SizeKB:          f.GetSizeKb(),
+			UsedKB:          f.GetUsedKb(),
+			MaxSizeKB:       f.GetMaxSizeKb(),
+			Growth:          f.GetGrowth(),
+			IsPercentGrowth: f.GetIsPercentGrowth(),
+			VolumeFreeKB:    f.GetVolumeFreeKb(),
+		}
+	}
+	events := make([]common_domain.AutogrowthEvent, len(stats.GetGrowthEvents()))
+	for i, e := range stats.GetGrowthEvents() {
+		events[i] = common_domain.AutogrowthEvent{
+			DatabaseName: e.GetDatabaseName(),
+			LogicalName:  e.GetLogicalName(),
+			FileType:     e.GetFileType(),
+			StartTime:    e.GetStartTime().AsTime(),
+			DurationMs:   e.GetDurationMs(),
+			GrowthKB:     e.GetGrowthKb(),
+		}
+	}
+	return &common_domain.FileSizeStats{Server: server, Timestamp: timestamp, Files: files, GrowthEvents: events}
+}
@@ -0,0 +1,57 @@
+package common_domain
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// DatabaseFilter selects the databases collected from a target. Entries are names or shell patterns (orders_*),
+// compared case-insensitively. No includes means every database, excludes win over includes.
+type DatabaseFilter struct {
+	Include []string
+	Exclude []string
+}
+
+func (f DatabaseFilter) Matches(database string) bool {
+	if matchesAny(f.Exclude, database) {
+		return false
+	}
+	return len(f.Include) == 0 || matchesAny(f.Include, database)
+}
+
+func matchesAny(patterns []string, database string) bool {
+	database = strings.ToLower(database)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == database {
+			return true
+		}
+		if ok, err := path.Match(pattern, database); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// DatabaseErrors are the databases a read skipped because they failed, the others are read anyway
+type DatabaseErrors map[string]error
+
+// Err joins the failures in database order, it is nil when every database was read
+func (e DatabaseErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	errs := make([]error, len(names))
+	for i, name := range names {
+		errs[i] = fmt.Errorf("database %s: %w", name, e[name])
+	}
+	return errors.Join(errs...)
+}
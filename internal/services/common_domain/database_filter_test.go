@@ -0,0 +1,39 @@
+package common_domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseFilter_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   DatabaseFilter
+		database string
+		want     bool
+	}{
+		{name: "empty filter", filter: DatabaseFilter{}, database: "orders", want: true},
+		{name: "included by name", filter: DatabaseFilter{Include: []string{"Orders"}}, database: "orders", want: true},
+		{name: "not included", filter: DatabaseFilter{Include: []string{"orders"}}, database: "billing", want: false},
+		{name: "included by pattern", filter: DatabaseFilter{Include: []string{"orders_*"}}, database: "orders_2024", want: true},
+		{name: "excluded by pattern", filter: DatabaseFilter{Exclude: []string{"tempdb", "report?"}}, database: "report1", want: false},
+		{name: "exclude wins", filter: DatabaseFilter{Include: []string{"orders_*"}, Exclude: []string{"orders_archive"}}, database: "orders_archive", want: false},
+		{name: "invalid pattern compared by name", filter: DatabaseFilter{Include: []string{"orders["}}, database: "orders[", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(tt.database))
+		})
+	}
+}
+
+func TestDatabaseErrors_Err(t *testing.T) {
+	assert.NoError(t, DatabaseErrors{}.Err())
+
+	denied := errors.New("permission denied")
+	err := DatabaseErrors{"sales": denied, "billing": errors.New("database is offline")}.Err()
+	assert.ErrorIs(t, err, denied)
+	assert.Equal(t, "database billing: database is offline\ndatabase sales: permission denied", err.Error())
+}
@@ -5,6 +5,26 @@ import "time"
 type DataBaseSnapshot struct {
 	SnapInfo SnapInfo
 	Samples  []*QuerySample
+	// Transactions are the transactions open for a while when the snapshot was taken, blocking or not
+	Transactions   []OpenTransaction
+	VersionStoreKB int64
+}
+
+// OpenTransaction is a user transaction with the session owning it and the log it holds
+type OpenTransaction struct {
+	TransactionID    int64
+	Name             string
+	Session          SessionMetadata
+	BeginTime        time.Time
+	DurationMs       int64
+	TransactionType  string
+	TransactionState string
+	// DatabaseName is the database holding most of the log of the transaction
+	DatabaseName      string
+	LogBytesUsed      int64
+	LogBytesReserved  int64
+	LogRecordCount    int64
+	HoldsVersionStore bool
 }
 
 func (s *DataBaseSnapshot) GetPlanHandles() []string {
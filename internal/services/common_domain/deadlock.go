@@ -0,0 +1,61 @@
+package common_domain
+
+import "time"
+
+type Deadlock struct {
+	ID               string
+	Server           ServerMeta
+	Timestamp        time.Time
+	VictimProcessIDs []string
+	Processes        []DeadlockProcess
+	Resources        []DeadlockResource
+	XmlData          string
+}
+
+type DeadlockProcess struct {
+	ID                     string
+	SessionID              string
+	IsVictim               bool
+	Database               DataBaseMetadata
+	LoginName              string
+	HostName               string
+	ProgramName            string
+	IsolationLevel         string
+	Status                 string
+	LockMode               string
+	WaitResource           string
+	WaitTimeMs             int64
+	TransactionName        string
+	LastTransactionStarted time.Time
+	TransactionCount       int
+	InputBuffer            string
+	Frames                 []DeadlockFrame
+}
+
+// DeadlockFrame is one entry of a process execution stack, handles and hashes use the same encoding as QuerySample's.
+type DeadlockFrame struct {
+	ProcName      string
+	Line          int
+	SqlHandle     string
+	QueryHash     string
+	QueryPlanHash string
+	PlanHandle    string
+	Text          string
+}
+
+type DeadlockResource struct {
+	ResourceType string
+	DatabaseID   string
+	ObjectName   string
+	IndexName    string
+	HobtID       string
+	Mode         string
+	Owners       []DeadlockLock
+	Waiters      []DeadlockLock
+}
+
+type DeadlockLock struct {
+	ProcessID   string
+	Mode        string
+	RequestType string
+}
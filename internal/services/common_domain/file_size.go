@@ -0,0 +1,103 @@
+package common_domain
+
+import "time"
+
+// FileSizeStats are the database file sizes of a target and the autogrowth events logged since the previous read
+type FileSizeStats struct {
+	Server       ServerMeta
+	Timestamp    time.Time
+	Files        []DatabaseFileSize
+	GrowthEvents []AutogrowthEvent
+	// LastGrowthEvent is the start of the latest autogrowth event read, the next read starts after it once the stats
+	// are uploaded. It is not shipped.
+	LastGrowthEvent time.Time
+	// Failed are the databases skipped, it is not shipped
+	Failed DatabaseErrors
+}
+
+type DatabaseFileSize struct {
+	DatabaseName string
+	FileID       int64
+	LogicalName  string
+	PhysicalName string
+	FileType     string
+	SizeKB       int64
+	UsedKB       int64
+	// MaxSizeKB is zero when the file grows until the volume is full
+	MaxSizeKB int64
+	// Growth is a percentage when IsPercentGrowth is set and KB otherwise, zero when autogrowth is off
+	Growth          int64
+	IsPercentGrowth bool
+	VolumeFreeKB    int64
+}
+
+// CapacityKB is the size the file can reach, a file without autogrowth is full at its current size
+func (f DatabaseFileSize) CapacityKB() int64 {
+	if f.Growth == 0 {
+		return f.SizeKB
+	}
+	capacity := f.SizeKB + f.VolumeFreeKB
+	if f.MaxSizeKB > 0 {
+		capacity = min(capacity, f.MaxSizeKB)
+	}
+	return max(capacity, f.SizeKB)
+}
+
+type AutogrowthEvent struct {
+	DatabaseName string
+	LogicalName  string
+	FileType     string
+	StartTime    time.Time
+	DurationMs   int64
+	GrowthKB     int64
+}
+
+type FileSizePoint struct {
+	Timestamp time.Time
+	SizeKB    int64
+	UsedKB    int64
+}
+
+type FileGrowthTrend struct {
+	DatabaseName   string
+	FileID         int64
+	LogicalName    string
+	PhysicalName   string
+	FileType       string
+	CapacityKB     int64
+	Points         []FileSizePoint
+	GrowthKBPerDay float64
+	// DaysUntilFull is -1 when the used space is not growing
+	DaysUntilFull float64
+	GrowthEvents  []AutogrowthEvent
+}
+
+// Forecast fits a line through the used space of the points (least squares) and projects when it reaches the
+// capacity, the points are expected in time order
+func (t *FileGrowthTrend) Forecast() {
+	t.GrowthKBPerDay, t.DaysUntilFull = 0, -1
+	if len(t.Points) < 2 {
+		return
+	}
+	first := t.Points[0].Timestamp
+	n := float64(len(t.Points))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, p := range t.Points {
+		x := p.Timestamp.Sub(first).Hours() / 24
+		y := float64(p.UsedKB)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return
+	}
+	t.GrowthKBPerDay = (n*sumXY - sumX*sumY) / denominator
+	if t.GrowthKBPerDay <= 0 {
+		return
+	}
+	free := t.CapacityKB - t.Points[len(t.Points)-1].UsedKB
+	t.DaysUntilFull = max(float64(free)/t.GrowthKBPerDay, 0)
+}
@@ -0,0 +1,72 @@
+package common_domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseFileSize_CapacityKB(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     DatabaseFileSize
+		expected int64
+	}{
+		{
+			name:     "no autogrowth",
+			file:     DatabaseFileSize{SizeKB: 1024, VolumeFreeKB: 1 << 20},
+			expected: 1024,
+		},
+		{
+			name:     "unlimited",
+			file:     DatabaseFileSize{SizeKB: 1024, Growth: 10, IsPercentGrowth: true, VolumeFreeKB: 4096},
+			expected: 5120,
+		},
+		{
+			name:     "max size below the volume",
+			file:     DatabaseFileSize{SizeKB: 1024, Growth: 512, MaxSizeKB: 2048, VolumeFreeKB: 4096},
+			expected: 2048,
+		},
+		{
+			name:     "max size below the current size",
+			file:     DatabaseFileSize{SizeKB: 1024, Growth: 512, MaxSizeKB: 512, VolumeFreeKB: 4096},
+			expected: 1024,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.file.CapacityKB())
+		})
+	}
+}
+
+func TestFileGrowthTrend_Forecast(t *testing.T) {
+	day := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	points := func(used ...int64) []FileSizePoint {
+		ret := make([]FileSizePoint, len(used))
+		for i, u := range used {
+			ret[i] = FileSizePoint{Timestamp: day.AddDate(0, 0, i), SizeKB: 10_000, UsedKB: u}
+		}
+		return ret
+	}
+	tests := []struct {
+		name              string
+		points            []FileSizePoint
+		expectedPerDay    float64
+		expectedUntilFull float64
+	}{
+		{name: "single point", points: points(1_000), expectedPerDay: 0, expectedUntilFull: -1},
+		{name: "not growing", points: points(3_000, 3_000, 2_000), expectedPerDay: -500, expectedUntilFull: -1},
+		{name: "growing", points: points(1_000, 2_000, 3_000, 4_000), expectedPerDay: 1_000, expectedUntilFull: 6},
+		{name: "already full", points: points(9_000, 12_000), expectedPerDay: 3_000, expectedUntilFull: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trend := FileGrowthTrend{CapacityKB: 10_000, Points: tt.points}
+			trend.Forecast()
+			assert.InDelta(t, tt.expectedPerDay, trend.GrowthKBPerDay, 0.001)
+			assert.InDelta(t, tt.expectedUntilFull, trend.DaysUntilFull, 0.001)
+		})
+	}
+}
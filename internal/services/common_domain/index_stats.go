@@ -0,0 +1,128 @@
+package common_domain
+
+import (
+	"sort"
+	"time"
+)
+
+// IndexStats are the index usage readings and missing index suggestions of a target, taken on a slow schedule
+type IndexStats struct {
+	Server         ServerMeta
+	Timestamp      time.Time
+	Indexes        []IndexUsage
+	MissingIndexes []MissingIndex
+	// Failed are the databases skipped, it is not shipped
+	Failed DatabaseErrors
+}
+
+// IndexUsage is a dm_db_index_usage_stats reading of an index with its size, the counters are cumulative since the
+// server started
+type IndexUsage struct {
+	DatabaseName   string
+	SchemaName     string
+	TableName      string
+	IndexName      string
+	IndexID        int64
+	IndexType      string
+	IsUnique       bool
+	IsPrimaryKey   bool
+	UserSeeks      int64
+	UserScans      int64
+	UserLookups    int64
+	UserUpdates    int64
+	LastUserRead   time.Time
+	LastUserUpdate time.Time
+	SizeKB         int64
+	RowCount       int64
+}
+
+func (i IndexUsage) Reads() int64 {
+	return i.UserSeeks + i.UserScans + i.UserLookups
+}
+
+func (i IndexUsage) key() string {
+	return i.DatabaseName + "." + i.SchemaName + "." + i.TableName + "." + i.IndexName
+}
+
+// IndexUsageBetween returns the activity of the indexes between two readings with the size of the last one. Counters
+// going backwards mean the server restarted, the last reading is then the activity, as it is for indexes missing
+// from the first reading.
+func IndexUsageBetween(first []IndexUsage, last []IndexUsage) []IndexUsage {
+	firstByKey := make(map[string]IndexUsage, len(first))
+	for _, i := range first {
+		firstByKey[i.key()] = i
+	}
+	ret := make([]IndexUsage, len(last))
+	for n, i := range last {
+		ret[n] = i
+		prev, ok := firstByKey[i.key()]
+		if !ok || i.Reads() < prev.Reads() || i.UserUpdates < prev.UserUpdates {
+			continue
+		}
+		ret[n].UserSeeks -= prev.UserSeeks
+		ret[n].UserScans -= prev.UserScans
+		ret[n].UserLookups -= prev.UserLookups
+		ret[n].UserUpdates -= prev.UserUpdates
+	}
+	return ret
+}
+
+// MissingIndex is a dm_db_missing_index_details suggestion with its group stats
+type MissingIndex struct {
+	DatabaseName      string
+	TableName         string
+	EqualityColumns   string
+	InequalityColumns string
+	IncludedColumns   string
+	UniqueCompiles    int64
+	UserSeeks         int64
+	UserScans         int64
+	AvgTotalUserCost  float64
+	AvgUserImpact     float64
+	LastUserSeek      time.Time
+}
+
+// ImprovementMeasure ranks the missing index suggestions by the cost of the queries, the expected gain and how often
+// they would use the index
+func (m MissingIndex) ImprovementMeasure() float64 {
+	return m.AvgTotalUserCost * m.AvgUserImpact * float64(m.UserSeeks+m.UserScans)
+}
+
+// IndexReview is the outcome of reviewing the index activity of a range
+type IndexReview struct {
+	Unused         []IndexUsage
+	WriteHeavy     []IndexUsage
+	MissingIndexes []MissingIndex
+}
+
+// ReviewIndexes lists the indexes without reads, largest first, and the ones updated at least writeRatio times per
+// read, most updated first. Heaps, clustered indexes and the indexes enforcing a key are never unused as dropping
+// them changes the table. The missing index suggestions are ranked by their improvement measure and cut to top.
+func ReviewIndexes(usage []IndexUsage, missing []MissingIndex, writeRatio float64, top int) IndexReview {
+	review := IndexReview{Unused: []IndexUsage{}, WriteHeavy: []IndexUsage{}}
+	for _, i := range usage {
+		reads := i.Reads()
+		switch {
+		case reads == 0:
+			if i.IndexID > 1 && !i.IsPrimaryKey && !i.IsUnique {
+				review.Unused = append(review.Unused, i)
+			}
+		case float64(i.UserUpdates) >= writeRatio*float64(reads):
+			review.WriteHeavy = append(review.WriteHeavy, i)
+		}
+	}
+	sort.SliceStable(review.Unused, func(a, b int) bool {
+		return review.Unused[a].SizeKB > review.Unused[b].SizeKB
+	})
+	sort.SliceStable(review.WriteHeavy, func(a, b int) bool {
+		return review.WriteHeavy[a].UserUpdates > review.WriteHeavy[b].UserUpdates
+	})
+	review.MissingIndexes = append([]MissingIndex{}, missing...)
+	sort.SliceStable(review.MissingIndexes, func(a, b int) bool {
+		return review.MissingIndexes[a].ImprovementMeasure() > review.MissingIndexes[b].ImprovementMeasure()
+	})
+	if top > 0 && len(review.MissingIndexes) > top {
+		review.MissingIndexes = review.MissingIndexes[:top]
+	}
+	return review
+}
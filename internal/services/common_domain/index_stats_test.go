@@ -0,0 +1,74 @@
+package common_domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexUsageBetween(t *testing.T) {
+	index := func(name string, seeks, scans, lookups, updates, sizeKB int64) IndexUsage {
+		return IndexUsage{DatabaseName: "sales", SchemaName: "dbo", TableName: "orders", IndexName: name,
+			UserSeeks: seeks, UserScans: scans, UserLookups: lookups, UserUpdates: updates, SizeKB: sizeKB}
+	}
+	first := []IndexUsage{
+		index("PK_orders", 1000, 10, 0, 500, 8000),
+		index("IX_orders_customer", 300, 0, 0, 500, 2000),
+		index("IX_orders_status", 50, 0, 0, 900, 1500),
+	}
+	last := []IndexUsage{
+		index("PK_orders", 1800, 12, 0, 700, 8200),
+		// not read in the range
+		index("IX_orders_customer", 300, 0, 0, 700, 2100),
+		// the server restarted
+		index("IX_orders_status", 4, 0, 0, 40, 1500),
+		// created in the range
+		index("IX_orders_date", 7, 0, 0, 200, 900),
+	}
+
+	got := IndexUsageBetween(first, last)
+	assert.Equal(t, []IndexUsage{
+		index("PK_orders", 800, 2, 0, 200, 8200),
+		index("IX_orders_customer", 0, 0, 0, 200, 2100),
+		index("IX_orders_status", 4, 0, 0, 40, 1500),
+		index("IX_orders_date", 7, 0, 0, 200, 900),
+	}, got)
+	assert.Equal(t, int64(802), got[0].Reads())
+
+	// a single reading is the activity since the server started
+	assert.Equal(t, last, IndexUsageBetween(nil, last))
+}
+
+func TestMissingIndex_ImprovementMeasure(t *testing.T) {
+	m := MissingIndex{AvgTotalUserCost: 2.5, AvgUserImpact: 80, UserSeeks: 90, UserScans: 10}
+	assert.Equal(t, 20000.0, m.ImprovementMeasure())
+}
+
+func TestReviewIndexes(t *testing.T) {
+	usage := []IndexUsage{
+		{IndexName: "PK_orders", IndexID: 1, IsPrimaryKey: true, IsUnique: true, UserUpdates: 90},
+		{IndexName: "IX_orders_status", IndexID: 2, SizeKB: 100, UserUpdates: 40},
+		{IndexName: "IX_orders_date", IndexID: 3, SizeKB: 900},
+		{IndexName: "UQ_orders_number", IndexID: 4, IsUnique: true, SizeKB: 5000},
+		{IndexName: "IX_orders_customer", IndexID: 5, UserSeeks: 10, UserUpdates: 100},
+		{IndexName: "IX_orders_total", IndexID: 6, UserSeeks: 2, UserScans: 1, UserUpdates: 300},
+		{IndexName: "IX_orders_region", IndexID: 7, UserSeeks: 50, UserUpdates: 100},
+	}
+	missing := []MissingIndex{
+		{TableName: "a", AvgTotalUserCost: 1, AvgUserImpact: 10, UserSeeks: 1},
+		{TableName: "b", AvgTotalUserCost: 1, AvgUserImpact: 90, UserSeeks: 10},
+		{TableName: "c", AvgTotalUserCost: 1, AvgUserImpact: 50, UserSeeks: 10},
+	}
+
+	review := ReviewIndexes(usage, missing, 10, 2)
+	names := func(indexes []IndexUsage) []string {
+		ret := make([]string, len(indexes))
+		for i, index := range indexes {
+			ret[i] = index.IndexName
+		}
+		return ret
+	}
+	assert.Equal(t, []string{"IX_orders_date", "IX_orders_status"}, names(review.Unused))
+	assert.Equal(t, []string{"IX_orders_total", "IX_orders_customer"}, names(review.WriteHeavy))
+	assert.Equal(t, []MissingIndex{missing[1], missing[2]}, review.MissingIndexes)
+}
@@ -0,0 +1,89 @@
+package common_domain
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// longJobRunFactor is how many times the median duration of its job a run has to take to be long running
+	longJobRunFactor = 3
+	// jobMedianMinRuns is how many successful runs a job needs before its median is trusted
+	jobMedianMinRuns = 3
+
+	JobRunSucceeded = "succeeded"
+	JobRunFailed    = "failed"
+	JobRunRetry     = "retry"
+	JobRunCanceled  = "canceled"
+	JobRunRunning   = "running"
+)
+
+// JobStats are the SQL Agent job runs of a target finished since the previous read and the runs in progress
+type JobStats struct {
+	Server    ServerMeta
+	Timestamp time.Time
+	Runs      []JobRun
+	// LastInstanceID is the latest job history entry read, the next read starts after it once the runs are uploaded.
+	// It is not shipped.
+	LastInstanceID int64
+}
+
+// JobRun is a run of a SQL Agent job, JobID and StartTime identify it while it goes from running to its outcome
+type JobRun struct {
+	JobID     string
+	JobName   string
+	Status    string
+	StartTime time.Time
+	// DurationMs is the time elapsed so far for a running job
+	DurationMs  int64
+	Message     string
+	CurrentStep string
+}
+
+func (r JobRun) EndTime() time.Time {
+	return r.StartTime.Add(time.Duration(r.DurationMs) * time.Millisecond)
+}
+
+type JobRunReview struct {
+	Run              JobRun
+	MedianDurationMs int64
+	LongRunning      bool
+}
+
+// ReviewJobRuns compares each run to the median duration of the successful runs of its job that started before it,
+// jobs with fewer than jobMedianMinRuns such runs are not flagged
+func ReviewJobRuns(runs []JobRun, history []JobRun) []JobRunReview {
+	succeededByJob := make(map[string][]JobRun)
+	for _, h := range history {
+		if h.Status != JobRunSucceeded {
+			continue
+		}
+		succeededByJob[h.JobID] = append(succeededByJob[h.JobID], h)
+	}
+	ret := make([]JobRunReview, len(runs))
+	for i, run := range runs {
+		durations := make([]int64, 0, len(succeededByJob[run.JobID]))
+		for _, h := range succeededByJob[run.JobID] {
+			if h.StartTime.Before(run.StartTime) {
+				durations = append(durations, h.DurationMs)
+			}
+		}
+		ret[i] = JobRunReview{Run: run}
+		if len(durations) < jobMedianMinRuns {
+			continue
+		}
+		ret[i].MedianDurationMs = medianDuration(durations)
+		ret[i].LongRunning = ret[i].MedianDurationMs > 0 && run.DurationMs >= longJobRunFactor*ret[i].MedianDurationMs
+	}
+	return ret
+}
+
+func medianDuration(values []int64) int64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,38 @@
+package common_domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewJobRuns(t *testing.T) {
+	day := time.Date(2025, 12, 1, 2, 0, 0, 0, time.UTC)
+	run := func(job string, daysAgo int, status string, durationMs int64) JobRun {
+		return JobRun{JobID: job, Status: status, StartTime: day.AddDate(0, 0, -daysAgo), DurationMs: durationMs}
+	}
+	history := []JobRun{
+		run("index-maintenance", 4, JobRunSucceeded, 60_000),
+		run("index-maintenance", 3, JobRunSucceeded, 90_000),
+		run("index-maintenance", 2, JobRunFailed, 1_000),
+		run("index-maintenance", 1, JobRunSucceeded, 70_000),
+		run("backup", 1, JobRunSucceeded, 10_000),
+	}
+	runs := []JobRun{
+		run("index-maintenance", 0, JobRunRunning, 300_000),
+		run("index-maintenance", 1, JobRunSucceeded, 70_000),
+		run("backup", 0, JobRunSucceeded, 100_000),
+	}
+
+	got := ReviewJobRuns(runs, append(history, runs...))
+	require.Len(t, got, 3)
+	assert.Equal(t, int64(70_000), got[0].MedianDurationMs)
+	assert.True(t, got[0].LongRunning)
+	// only two successful runs started before it
+	assert.Zero(t, got[1].MedianDurationMs)
+	assert.False(t, got[1].LongRunning)
+	// not enough history to tell
+	assert.False(t, got[2].LongRunning)
+}
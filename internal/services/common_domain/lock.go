@@ -0,0 +1,85 @@
+package common_domain
+
+const (
+	LockStatusGrant   = "GRANT"
+	LockStatusWait    = "WAIT"
+	LockStatusConvert = "CONVERT"
+)
+
+// LockDetail is a lock held or requested by a session, read from sys.dm_tran_locks
+type LockDetail struct {
+	ResourceType string
+	DatabaseID   int64
+	DatabaseName string
+	// ResourceDescription identifies the resource inside the entity, the key hash of KEY locks or file:page of PAGE
+	// locks
+	ResourceDescription string
+	// AssociatedEntityID is the hobt id of KEY, PAGE, RID and HOBT locks and the object id of OBJECT locks
+	AssociatedEntityID int64
+	Mode               string
+	Status             string
+	// ResourceObject is nil when the resource could not be decoded
+	ResourceObject *WaitResourceObject
+}
+
+// Waiting is true when the lock is requested and not granted yet
+func (l LockDetail) Waiting() bool {
+	return l.Status != LockStatusGrant
+}
+
+func (l LockDetail) SameResource(other LockDetail) bool {
+	return l.ResourceType == other.ResourceType && l.DatabaseID == other.DatabaseID &&
+		l.AssociatedEntityID == other.AssociatedEntityID && l.ResourceDescription == other.ResourceDescription
+}
+
+// lockCompatibility lists the granted modes each requested mode is compatible with
+var lockCompatibility = map[string]map[string]bool{
+	"IS":  {"IS": true, "S": true, "U": true, "IX": true, "SIX": true},
+	"S":   {"IS": true, "S": true, "U": true},
+	"U":   {"IS": true, "S": true},
+	"IX":  {"IS": true, "IX": true},
+	"SIX": {"IS": true},
+}
+
+// LockModesCompatible tells whether a lock in mode requested can be granted while another session holds held. Schema
+// stability is compatible with every mode but schema modification. Modes outside the basic matrix (update intent,
+// key-range, bulk update) are reported incompatible.
+func LockModesCompatible(requested string, held string) bool {
+	switch {
+	case requested == "Sch-M" || held == "Sch-M":
+		return false
+	case requested == "Sch-S" || held == "Sch-S":
+		return true
+	}
+	return lockCompatibility[requested][held]
+}
+
+// LockConflict is the lock a blocked session waits for and the locks its blocker holds or requested ahead of it on
+// the same resource in an incompatible mode
+type LockConflict struct {
+	WaitingLock       LockDetail
+	BlockingSessionID string
+	BlockingLocks     []LockDetail
+}
+
+// FindLockConflict matches the waiting lock of waiter with the locks of blocker, it is nil when waiter does not wait
+// for a lock
+func FindLockConflict(waiter *QuerySample, blocker *QuerySample) *LockConflict {
+	for _, lock := range waiter.Locks {
+		if !lock.Waiting() {
+			continue
+		}
+		conflict := &LockConflict{WaitingLock: lock, BlockingSessionID: waiter.Block.BlockedBy,
+			BlockingLocks: make([]LockDetail, 0)}
+		if blocker == nil {
+			return conflict
+		}
+		for _, held := range blocker.Locks {
+			if held.SameResource(lock) && !LockModesCompatible(lock.Mode, held.Mode) {
+				conflict.BlockingLocks = append(conflict.BlockingLocks, held)
+			}
+		}
+		return conflict
+	}
+	return nil
+}
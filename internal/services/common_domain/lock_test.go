@@ -0,0 +1,45 @@
+package common_domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockModesCompatible(t *testing.T) {
+	assert.True(t, LockModesCompatible("S", "S"))
+	assert.True(t, LockModesCompatible("IX", "IS"))
+	assert.True(t, LockModesCompatible("Sch-S", "X"))
+	assert.False(t, LockModesCompatible("S", "X"))
+	assert.False(t, LockModesCompatible("U", "U"))
+	assert.False(t, LockModesCompatible("IS", "Sch-M"))
+	assert.False(t, LockModesCompatible("RangeS-S", "S"))
+}
+
+func TestFindLockConflict(t *testing.T) {
+	key := LockDetail{ResourceType: "KEY", DatabaseID: 5, AssociatedEntityID: 72057594043236352,
+		ResourceDescription: "(8194443284a0)"}
+	lock := func(l LockDetail, mode string, status string) LockDetail {
+		l.Mode, l.Status = mode, status
+		return l
+	}
+	table := LockDetail{ResourceType: "OBJECT", DatabaseID: 5, AssociatedEntityID: 245575913}
+	blocker := &QuerySample{Session: SessionMetadata{SessionID: "52"}, Locks: []LockDetail{
+		lock(table, "IX", LockStatusGrant),
+		lock(key, "X", LockStatusGrant),
+	}}
+	waiter := &QuerySample{Session: SessionMetadata{SessionID: "61"}, Block: BlockMetadata{BlockedBy: "52"}, Locks: []LockDetail{
+		lock(table, "IS", LockStatusGrant),
+		lock(key, "S", LockStatusWait),
+	}}
+
+	conflict := FindLockConflict(waiter, blocker)
+	require.NotNil(t, conflict)
+	assert.Equal(t, "S", conflict.WaitingLock.Mode)
+	assert.Equal(t, "52", conflict.BlockingSessionID)
+	assert.Equal(t, []LockDetail{lock(key, "X", LockStatusGrant)}, conflict.BlockingLocks)
+
+	assert.Nil(t, FindLockConflict(blocker, nil))
+	assert.Empty(t, FindLockConflict(waiter, nil).BlockingLocks)
+}
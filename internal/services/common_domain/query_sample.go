@@ -1,6 +1,9 @@
 package common_domain
 
-import "time"
+import (
+	"time"
+	"unicode/utf16"
+)
 
 type QuerySample struct {
 	Id              string
@@ -19,6 +22,27 @@ type QuerySample struct {
 	Snapshot        SnapshotMetadata
 	TimeElapsedMs   int64
 	CommandMetadata CommandMetadata
+	// Locks are the waiting locks and the granted locks relevant to the blocking chain, only set for sessions in one
+	Locks []LockDetail
+	// IdleSession is set for sleeping sessions blocking others, their text is the last statement they ran
+	IdleSession *IdleSessionMetadata
+	// Statement is the statement of Text being run, nil when Text is not the batch of a running request
+	Statement *StatementMetadata
+	// LiveProgress is the progress of the plan operators, only read for requests running longer than a threshold
+	LiveProgress []OperatorProgress
+}
+
+// OperatorProgress is the progress of a plan operator summed over its threads
+type OperatorProgress struct {
+	NodeID            int64
+	PhysicalOperator  string
+	RowCount          int64
+	EstimatedRowCount int64
+	ElapsedTimeMs     int64
+	CPUTimeMs         int64
+	LogicalReads      int64
+	// Active is set while the operator is open and not closed yet
+	Active bool
 }
 
 func (q *QuerySample) SetBlockedIds(sessionIds []string) {
@@ -31,6 +55,81 @@ type WaitMetadata struct {
 	WaitTime     int
 	LastWaitType string
 	WaitResource string
+	// ResourceObject is nil when WaitResource could not be decoded
+	ResourceObject *WaitResourceObject
+}
+
+// ResourceDescription is the decoded object of the wait resource, or the raw resource when it was not decoded
+func (w WaitMetadata) ResourceDescription() string {
+	if w.ResourceObject != nil {
+		return w.ResourceObject.String()
+	}
+	return w.WaitResource
+}
+
+type StatementMetadata struct {
+	Text string
+	// StartOffset and EndOffset locate the statement in the batch text, in UTF-16 code units
+	StartOffset int64
+	EndOffset   int64
+	// ObjectName is the schema qualified procedure, function or trigger of the batch, empty for ad hoc batches
+	ObjectName string
+}
+
+// NewStatementMetadata extracts the statement of batch between the byte offsets of sys.dm_exec_requests, the text is
+// UTF-16 on the server and an end offset of -1 is the end of the batch. Offsets outside the batch select it whole.
+func NewStatementMetadata(batch string, startOffsetBytes int64, endOffsetBytes int64, objectName string) *StatementMetadata {
+	units := utf16.Encode([]rune(batch))
+	start, end := startOffsetBytes/2, int64(len(units))
+	if endOffsetBytes >= 0 {
+		end = endOffsetBytes/2 + 1
+	}
+	if start < 0 || start >= int64(len(units)) {
+		start = 0
+	}
+	if end > int64(len(units)) || end <= start {
+		end = int64(len(units))
+	}
+	return &StatementMetadata{
+		Text:        string(utf16.Decode(units[start:end])),
+		StartOffset: start,
+		EndOffset:   end,
+		ObjectName:  objectName,
+	}
+}
+
+// Split returns the text of batch before and after the statement
+func (s StatementMetadata) Split(batch string) (string, string) {
+	units := utf16.Encode([]rune(batch))
+	if s.StartOffset < 0 || s.EndOffset > int64(len(units)) || s.StartOffset > s.EndOffset {
+		return "", ""
+	}
+	return string(utf16.Decode(units[:s.StartOffset])), string(utf16.Decode(units[s.EndOffset:]))
+}
+
+// JoinStatement rebuilds the batch around a statement split out of it and locates the statement in it
+func JoinStatement(before string, statement string, after string, objectName string) (string, *StatementMetadata) {
+	start := int64(len(utf16.Encode([]rune(before))))
+	end := start + int64(len(utf16.Encode([]rune(statement))))
+	return before + statement + after, &StatementMetadata{Text: statement, StartOffset: start, EndOffset: end,
+		ObjectName: objectName}
+}
+
+// IdleSessionMetadata is what a sleeping session last sent and the transaction it keeps open
+type IdleSessionMetadata struct {
+	InputBuffer          string
+	InputBufferEventType string
+	OpenTransactionCount int64
+	// TransactionBeginTime is zero when the session has no transaction open
+	TransactionBeginTime time.Time
+}
+
+// TransactionDuration is how long the transaction of the session has been open at, zero without a transaction
+func (m IdleSessionMetadata) TransactionDuration(at time.Time) time.Duration {
+	if m.TransactionBeginTime.IsZero() {
+		return 0
+	}
+	return at.Sub(m.TransactionBeginTime)
 }
 
 type BlockMetadata struct {
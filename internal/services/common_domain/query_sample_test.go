@@ -0,0 +1,49 @@
+package common_domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStatementMetadata(t *testing.T) {
+	batch := "create procedure dbo.usp_orders as\nselect 'ação' as a;\nupdate dbo.orders set status = 1;"
+	// byte offsets of the UTF-16 text, the update starts at character 55
+	tests := []struct {
+		name     string
+		start    int64
+		end      int64
+		expected StatementMetadata
+	}{
+		{
+			name:     "statement at the end",
+			start:    55 * 2,
+			end:      -1,
+			expected: StatementMetadata{Text: "update dbo.orders set status = 1;", StartOffset: 55, EndOffset: 88},
+		},
+		{
+			name:     "statement in the middle",
+			start:    35 * 2,
+			end:      53 * 2,
+			expected: StatementMetadata{Text: "select 'ação' as a;", StartOffset: 35, EndOffset: 54},
+		},
+		{
+			name:     "offsets outside the batch",
+			start:    1000,
+			end:      2000,
+			expected: StatementMetadata{Text: batch, StartOffset: 0, EndOffset: 88},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.expected.ObjectName = "dbo.usp_orders"
+			got := NewStatementMetadata(batch, tt.start, tt.end, "dbo.usp_orders")
+			assert.Equal(t, &tt.expected, got)
+
+			before, after := got.Split(batch)
+			rebuilt, statement := JoinStatement(before, got.Text, after, got.ObjectName)
+			assert.Equal(t, batch, rebuilt)
+			assert.Equal(t, got, statement)
+		})
+	}
+}
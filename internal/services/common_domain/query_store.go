@@ -0,0 +1,133 @@
+package common_domain
+
+import (
+	"sort"
+	"time"
+)
+
+// regressedPlanFactor is how many times slower than the fastest plan of a query a plan has to be to be regressed
+const regressedPlanFactor = 2
+
+// QueryStoreStats are the plans and runtime stats read from the query store of the databases of a target since the
+// previous read
+type QueryStoreStats struct {
+	Server       ServerMeta
+	Timestamp    time.Time
+	Plans        []QueryStorePlan
+	RuntimeStats []QueryStoreRuntimeStats
+	// Watermarks is where the next read of each database read starts once the stats are uploaded, it is not shipped
+	Watermarks map[string]QueryStoreWatermark
+	// Failed are the databases skipped, their watermarks stay put. It is not shipped.
+	Failed DatabaseErrors
+}
+
+// QueryStoreWatermark is where a read of the query store of a database starts. The interval that was open at the
+// previous read is read again so its final numbers replace the partial ones, the plan xml is only read for the plans
+// compiled since ReadAt.
+type QueryStoreWatermark struct {
+	IntervalStart time.Time
+	ReadAt        time.Time
+}
+
+// queryStoreRuntimeStatsSize is about what a runtime stats row takes in an upload
+const queryStoreRuntimeStatsSize = 128
+
+// Split cuts the stats in chunks of about maxBytes so a large read, the first one goes back 30 days, is uploaded in
+// messages the collector accepts. A plan larger than maxBytes gets a chunk of its own.
+func (s *QueryStoreStats) Split(maxBytes int) []*QueryStoreStats {
+	chunks := make([]*QueryStoreStats, 0, 1)
+	var chunk *QueryStoreStats
+	size := 0
+	next := func(n int) {
+		if chunk == nil || (size > 0 && size+n > maxBytes) {
+			chunk = &QueryStoreStats{Server: s.Server, Timestamp: s.Timestamp}
+			chunks = append(chunks, chunk)
+			size = 0
+		}
+		size += n
+	}
+	for _, p := range s.Plans {
+		next(queryStoreRuntimeStatsSize + len(p.QueryText) + len(p.PlanXML))
+		chunk.Plans = append(chunk.Plans, p)
+	}
+	for _, rs := range s.RuntimeStats {
+		next(queryStoreRuntimeStatsSize)
+		chunk.RuntimeStats = append(chunk.RuntimeStats, rs)
+	}
+	return chunks
+}
+
+type QueryStorePlan struct {
+	DatabaseName  string
+	QueryID       int64
+	PlanID        int64
+	QueryHash     string
+	QueryPlanHash string
+	QueryText     string
+	// PlanXML is empty when the plan did not compile since the previous read
+	PlanXML           string
+	IsForcedPlan      bool
+	FirstCompileTime  time.Time
+	LastExecutionTime time.Time
+}
+
+// QueryStoreRuntimeStats are the executions of a plan in a query store interval, the interval still open is read
+// again until it closes
+type QueryStoreRuntimeStats struct {
+	DatabaseName      string
+	PlanID            int64
+	IntervalStart     time.Time
+	IntervalEnd       time.Time
+	Executions        int64
+	AbortedExecutions int64
+	AvgDurationUs     float64
+	MaxDurationUs     float64
+	AvgCPUTimeUs      float64
+	AvgLogicalReads   float64
+	AvgPhysicalReads  float64
+	AvgRowCount       float64
+	LastExecutionTime time.Time
+}
+
+// QueryPlanHistory is a plan of a query with its runtime stats in a range and their averages weighted by executions
+type QueryPlanHistory struct {
+	Plan            QueryStorePlan
+	RuntimeStats    []QueryStoreRuntimeStats
+	Executions      int64
+	AvgDurationUs   float64
+	AvgCPUTimeUs    float64
+	AvgLogicalReads float64
+	Regressed       bool
+}
+
+// SummarizePlanHistory averages the runtime stats of each plan, flags the plans at least twice as slow as the
+// fastest executed plan and orders them by last execution, most recent first
+func SummarizePlanHistory(plans []*QueryPlanHistory) []*QueryPlanHistory {
+	fastest := -1.0
+	for _, p := range plans {
+		p.Executions = 0
+		var duration, cpu, reads float64
+		for _, rs := range p.RuntimeStats {
+			p.Executions += rs.Executions
+			duration += rs.AvgDurationUs * float64(rs.Executions)
+			cpu += rs.AvgCPUTimeUs * float64(rs.Executions)
+			reads += rs.AvgLogicalReads * float64(rs.Executions)
+		}
+		if p.Executions == 0 {
+			continue
+		}
+		p.AvgDurationUs = duration / float64(p.Executions)
+		p.AvgCPUTimeUs = cpu / float64(p.Executions)
+		p.AvgLogicalReads = reads / float64(p.Executions)
+		if fastest < 0 || p.AvgDurationUs < fastest {
+			fastest = p.AvgDurationUs
+		}
+	}
+	for _, p := range plans {
+		p.Regressed = p.Executions > 0 && p.AvgDurationUs > fastest && p.AvgDurationUs >= regressedPlanFactor*fastest
+	}
+	sort.SliceStable(plans, func(i, j int) bool {
+		return plans[i].Plan.LastExecutionTime.After(plans[j].Plan.LastExecutionTime)
+	})
+	return plans
+}
@@ -0,0 +1,71 @@
+package common_domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizePlanHistory(t *testing.T) {
+	day := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	stats := func(executions int64, durationUs float64, cpuUs float64) QueryStoreRuntimeStats {
+		return QueryStoreRuntimeStats{Executions: executions, AvgDurationUs: durationUs, AvgCPUTimeUs: cpuUs}
+	}
+	plans := []*QueryPlanHistory{
+		{
+			Plan:         QueryStorePlan{PlanID: 1, LastExecutionTime: day},
+			RuntimeStats: []QueryStoreRuntimeStats{stats(30, 100, 80), stats(10, 300, 200)},
+		},
+		{
+			Plan:         QueryStorePlan{PlanID: 2, LastExecutionTime: day.Add(2 * time.Hour)},
+			RuntimeStats: []QueryStoreRuntimeStats{stats(5, 900, 850)},
+		},
+		{
+			// compiled but never executed in the range
+			Plan: QueryStorePlan{PlanID: 3, LastExecutionTime: day.Add(time.Hour)},
+		},
+	}
+
+	got := SummarizePlanHistory(plans)
+	require.Len(t, got, 3)
+	assert.Equal(t, []int64{2, 3, 1}, []int64{got[0].Plan.PlanID, got[1].Plan.PlanID, got[2].Plan.PlanID})
+	assert.Equal(t, int64(40), got[2].Executions)
+	assert.Equal(t, 150.0, got[2].AvgDurationUs)
+	assert.Equal(t, 110.0, got[2].AvgCPUTimeUs)
+	assert.False(t, got[2].Regressed)
+	assert.True(t, got[0].Regressed)
+	assert.False(t, got[1].Regressed)
+
+	single := SummarizePlanHistory([]*QueryPlanHistory{{RuntimeStats: []QueryStoreRuntimeStats{stats(1, 10, 10)}}})
+	assert.False(t, single[0].Regressed)
+}
+
+func TestQueryStoreStats_Split(t *testing.T) {
+	stats := &QueryStoreStats{Server: ServerMeta{Host: "sql01", Type: "mssql"},
+		Watermarks: map[string]QueryStoreWatermark{"sales": {}}}
+	for i := int64(1); i <= 3; i++ {
+		stats.Plans = append(stats.Plans, QueryStorePlan{PlanID: i, PlanXML: strings.Repeat("x", 400)})
+	}
+	for i := int64(1); i <= 10; i++ {
+		stats.RuntimeStats = append(stats.RuntimeStats, QueryStoreRuntimeStats{PlanID: i % 3})
+	}
+
+	// a chunk per plan, the last one shares its chunk with three runtime stats
+	chunks := stats.Split(1024)
+	require.Len(t, chunks, 4)
+	plans, runtimeStats := 0, 0
+	for _, chunk := range chunks {
+		assert.Equal(t, stats.Server, chunk.Server)
+		assert.Nil(t, chunk.Watermarks)
+		plans += len(chunk.Plans)
+		runtimeStats += len(chunk.RuntimeStats)
+	}
+	assert.Equal(t, 3, plans)
+	assert.Equal(t, 10, runtimeStats)
+
+	// rows over the limit are sent alone
+	assert.Len(t, stats.Split(100), 13)
+}
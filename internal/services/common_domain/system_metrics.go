@@ -0,0 +1,96 @@
+package common_domain
+
+import "time"
+
+// SystemMetrics are the server wide metrics of a target for one metrics interval
+type SystemMetrics struct {
+	Server    ServerMeta
+	Timestamp time.Time
+	WaitStats []WaitStat
+	Counters  []PerformanceCounter
+	FileIO    []FileIOStat
+	Replicas  []ReplicaState
+}
+
+// WaitStat is the difference between two dm_os_wait_stats readings of a wait type, MaxWaitTimeMs is the value read
+type WaitStat struct {
+	WaitType         string
+	WaitingTasks     int64
+	WaitTimeMs       int64
+	SignalWaitTimeMs int64
+	MaxWaitTimeMs    int64
+}
+
+type WaitStatSeries struct {
+	WaitType string
+	Points   []WaitStatPoint
+}
+
+type WaitStatPoint struct {
+	Timestamp time.Time
+	WaitStat
+}
+
+// PerformanceCounter is a dm_os_performance_counters reading. Value is the reading of point-in-time counters
+// (page life expectancy, user connections) and the increase in the interval of the per second ones, Rate is the
+// per second rate of the latter.
+type PerformanceCounter struct {
+	Name  string
+	Value int64
+	Rate  float64
+}
+
+type PerformanceCounterSeries struct {
+	Name   string
+	Points []PerformanceCounterPoint
+}
+
+type PerformanceCounterPoint struct {
+	Timestamp time.Time
+	Value     int64
+	Rate      float64
+}
+
+// FileIOStat is the difference between two dm_io_virtual_file_stats readings of a database file
+type FileIOStat struct {
+	DatabaseName string
+	FileID       int64
+	LogicalName  string
+	PhysicalName string
+	FileType     string
+	IntervalMs   int64
+	Reads        int64
+	BytesRead    int64
+	ReadStallMs  int64
+	Writes       int64
+	BytesWritten int64
+	WriteStallMs int64
+}
+
+func (f FileIOStat) ReadLatencyMs() float64 {
+	if f.Reads == 0 {
+		return 0
+	}
+	return float64(f.ReadStallMs) / float64(f.Reads)
+}
+
+func (f FileIOStat) WriteLatencyMs() float64 {
+	if f.Writes == 0 {
+		return 0
+	}
+	return float64(f.WriteStallMs) / float64(f.Writes)
+}
+
+type FileIOSeries struct {
+	DatabaseName string
+	FileID       int64
+	LogicalName  string
+	PhysicalName string
+	FileType     string
+	Points       []FileIOPoint
+}
+
+type FileIOPoint struct {
+	Timestamp time.Time
+	FileIOStat
+}
@@ -0,0 +1,99 @@
+package common_domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	WaitResourceTypeKey      = "KEY"
+	WaitResourceTypeHobt     = "HOBT"
+	WaitResourceTypePage     = "PAGE"
+	WaitResourceTypeRID      = "RID"
+	WaitResourceTypeObject   = "OBJECT"
+	WaitResourceTypeTab      = "TAB"
+	WaitResourceTypeDatabase = "DATABASE"
+)
+
+// WaitResourceRef is a wait_resource decoded to the ids it is made of, only the ids of its type are set
+type WaitResourceRef struct {
+	Type       string
+	DatabaseID int64
+	HobtID     int64
+	ObjectID   int64
+	FileID     int64
+	PageID     int64
+}
+
+// ParseWaitResource decodes the lock resources KEY, HOBT, PAGE, RID, OBJECT, TAB and DATABASE. Page latch waits
+// report the page without a prefix (db:file:page) and are read as PAGE.
+func ParseWaitResource(resource string) (WaitResourceRef, bool) {
+	resourceType, ids, found := strings.Cut(strings.TrimSpace(resource), ":")
+	if !found {
+		return WaitResourceRef{}, false
+	}
+	resourceType = strings.ToUpper(strings.TrimSpace(resourceType))
+	if _, err := strconv.ParseInt(resourceType, 10, 64); err == nil {
+		resourceType, ids = WaitResourceTypePage, resource
+	}
+	fields := strings.Fields(ids)
+	if len(fields) == 0 {
+		return WaitResourceRef{}, false
+	}
+	parts := strings.Split(fields[0], ":")
+	values := make([]int64, len(parts))
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return WaitResourceRef{}, false
+		}
+		values[i] = v
+	}
+	ref := WaitResourceRef{Type: resourceType, DatabaseID: values[0]}
+	switch resourceType {
+	case WaitResourceTypeKey, WaitResourceTypeHobt:
+		if len(values) < 2 {
+			return WaitResourceRef{}, false
+		}
+		ref.HobtID = values[1]
+	case WaitResourceTypePage, WaitResourceTypeRID:
+		if len(values) < 3 {
+			return WaitResourceRef{}, false
+		}
+		ref.FileID, ref.PageID = values[1], values[2]
+	case WaitResourceTypeObject, WaitResourceTypeTab:
+		if len(values) < 2 {
+			return WaitResourceRef{}, false
+		}
+		ref.ObjectID = values[1]
+	case WaitResourceTypeDatabase:
+	default:
+		return WaitResourceRef{}, false
+	}
+	return ref, true
+}
+
+// WaitResourceObject is the object a wait resource belongs to, names are empty above the resource level (a DATABASE
+// resource has no table and a TAB resource no index)
+type WaitResourceObject struct {
+	Type         string
+	DatabaseName string
+	SchemaName   string
+	TableName    string
+	IndexName    string
+}
+
+// String formats the object as schema.table (index)
+func (o WaitResourceObject) String() string {
+	if o.TableName == "" {
+		return o.DatabaseName
+	}
+	name := o.TableName
+	if o.SchemaName != "" {
+		name = o.SchemaName + "." + name
+	}
+	if o.IndexName != "" {
+		name += " (" + o.IndexName + ")"
+	}
+	return name
+}
@@ -0,0 +1,69 @@
+package common_domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWaitResource(t *testing.T) {
+	tests := []struct {
+		resource string
+		expected WaitResourceRef
+		ok       bool
+	}{
+		{
+			resource: "KEY: 5:72057594043236352 (8194443284a0)",
+			expected: WaitResourceRef{Type: WaitResourceTypeKey, DatabaseID: 5, HobtID: 72057594043236352},
+			ok:       true,
+		},
+		{
+			resource: "PAGE: 5:1:12345",
+			expected: WaitResourceRef{Type: WaitResourceTypePage, DatabaseID: 5, FileID: 1, PageID: 12345},
+			ok:       true,
+		},
+		{
+			resource: "RID: 5:1:12345:2",
+			expected: WaitResourceRef{Type: WaitResourceTypeRID, DatabaseID: 5, FileID: 1, PageID: 12345},
+			ok:       true,
+		},
+		{
+			resource: "OBJECT: 5:245575913:0 ",
+			expected: WaitResourceRef{Type: WaitResourceTypeObject, DatabaseID: 5, ObjectID: 245575913},
+			ok:       true,
+		},
+		{
+			resource: "TAB: 5:245575913:1",
+			expected: WaitResourceRef{Type: WaitResourceTypeTab, DatabaseID: 5, ObjectID: 245575913},
+			ok:       true,
+		},
+		{
+			resource: "DATABASE: 5:0",
+			expected: WaitResourceRef{Type: WaitResourceTypeDatabase, DatabaseID: 5},
+			ok:       true,
+		},
+		{
+			resource: "2:1:128",
+			expected: WaitResourceRef{Type: WaitResourceTypePage, DatabaseID: 2, FileID: 1, PageID: 128},
+			ok:       true,
+		},
+		{resource: "APPLICATION: 5:0:[lock_name]:(8a2f7d3e)"},
+		{resource: "METADATA: database_id = 5 SCHEMA(schema_id = 1)"},
+		{resource: "KEY: 5"},
+		{resource: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			got, ok := ParseWaitResource(tt.resource)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestWaitResourceObject_String(t *testing.T) {
+	assert.Equal(t, "dbo.Orders (IX_Orders_Customer)", WaitResourceObject{DatabaseName: "shop", SchemaName: "dbo",
+		TableName: "Orders", IndexName: "IX_Orders_Customer"}.String())
+	assert.Equal(t, "dbo.Orders", WaitResourceObject{DatabaseName: "shop", SchemaName: "dbo", TableName: "Orders"}.String())
+	assert.Equal(t, "shop", WaitResourceObject{Type: WaitResourceTypeDatabase, DatabaseName: "shop"}.String())
+}
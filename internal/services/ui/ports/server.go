@@ -301,8 +301,8 @@ func (s *HtmxServer) HandleServerRefresh(w http.ResponseWriter, r *http.Request)
 	for i, srv := range resp.Servers {
 		ret[i] = domain.Server{
 			Name:           srv.Name,
-			Connections:    0,
-			RequestRate:    "",
+			Connections:    int(srv.Connections),
+			RequestRate:    fmt.Sprintf("%.0f req/s", srv.RequestRate),
 			DatabaseType:   srv.Type,
 			BlockedPercent: 0,
 			WaitTypes:      nil,
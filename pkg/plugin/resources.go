@@ -302,6 +302,9 @@ func (a *App) QueryData(ctx context.Context, req *backend.QueryDataRequest) (*ba
 		case "metrics_series":
 			res := a.queryMetricsTimeSeries(ctx, req.PluginContext, q)
 			response.Responses[q.RefID] = res
+		case "file_io":
+			res := a.queryFileIO(ctx, req.PluginContext, q)
+			response.Responses[q.RefID] = res
 		}
 	}
 
@@ -714,6 +717,70 @@ func (a *App) queryMetricsTimeSeries(ctx context.Context, pCtx backend.PluginCon
 	return response
 }
 
+// queryFileIO returns the read/write latency and throughput of the database files, one frame per file
+func (a *App) queryFileIO(ctx context.Context, pCtx backend.PluginContext, query backend.DataQuery) backend.DataResponse {
+	response := backend.DataResponse{}
+	q := struct {
+		Database  string   `json:"database"`
+		Databases []string `json:"databases"`
+	}{}
+	if err := json.Unmarshal(query.JSON, &q); err != nil {
+		response.Error = err
+		return response
+	}
+	resp, err := a.client.GetFileIOTimeSeries(ctx, &dbmv1.GetFileIOTimeSeriesRequest{
+		Host:      q.Database,
+		Start:     timestamppb.New(query.TimeRange.From),
+		End:       timestamppb.New(query.TimeRange.To),
+		Databases: q.Databases,
+	})
+	if err != nil {
+		response.Error = err
+		return response
+	}
+	response.Frames = fileIOFrames(resp.GetSeries(), query.RefID)
+	return response
+}
+
+func fileIOFrames(series []*dbmv1.FileIOSeries, refID string) data.Frames {
+	frames := make(data.Frames, 0, len(series))
+	for _, s := range series {
+		size := len(s.GetPoints())
+		times := make([]time.Time, 0, size)
+		readLatency := make([]float64, 0, size)
+		writeLatency := make([]float64, 0, size)
+		readThroughput := make([]float64, 0, size)
+		writeThroughput := make([]float64, 0, size)
+		for _, p := range s.GetPoints() {
+			times = append(times, p.GetTimestamp().AsTime())
+			readLatency = append(readLatency, p.GetReadLatencyMs())
+			writeLatency = append(writeLatency, p.GetWriteLatencyMs())
+			var readRate, writeRate float64
+			if p.GetIntervalMs() > 0 {
+				seconds := float64(p.GetIntervalMs()) / 1000
+				readRate = float64(p.GetBytesRead()) / seconds
+				writeRate = float64(p.GetBytesWritten()) / seconds
+			}
+			readThroughput = append(readThroughput, readRate)
+			writeThroughput = append(writeThroughput, writeRate)
+		}
+		labels := data.Labels{"database": s.GetDatabaseName(), "file": s.GetLogicalName(), "type": s.GetFileType()}
+		frame := data.NewFrame(fmt.Sprintf("%s/%s", s.GetDatabaseName(), s.GetLogicalName()),
+			data.NewField("time", nil, times),
+			data.NewField("readLatencyMs", labels, readLatency),
+			data.NewField("writeLatencyMs", labels, writeLatency),
+			data.NewField("readBytesPerSec", labels, readThroughput),
+			data.NewField("writeBytesPerSec", labels, writeThroughput),
+		)
+		frame.RefID = refID
+		frame.Meta = &data.FrameMeta{
+			Type: data.FrameTypeTimeSeriesMulti,
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
 // DropdownOption represents a single dropdown option
 type DropdownOption struct {
 	Label string `json:"label"`
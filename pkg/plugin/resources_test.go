@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
 	dbmv1 "github.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net"
 	"net/http"
 	"testing"
@@ -141,3 +144,27 @@ func TestCallResource(t *testing.T) {
 		})
 	}
 }
+
+func TestFileIOFrames(t *testing.T) {
+	ts := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+	frames := fileIOFrames([]*dbmv1.FileIOSeries{{
+		DatabaseName: "sales",
+		LogicalName:  "sales_data",
+		FileType:     "ROWS",
+		Points: []*dbmv1.FileIOPoint{
+			{Timestamp: timestamppb.New(ts), IntervalMs: 15000, BytesRead: 1536000, BytesWritten: 30000, ReadLatencyMs: 12.5, WriteLatencyMs: 2},
+			{Timestamp: timestamppb.New(ts.Add(15 * time.Second))},
+		},
+	}}, "A")
+
+	require.Len(t, frames, 1)
+	frame := frames[0]
+	assert.Equal(t, "A", frame.RefID)
+	assert.Equal(t, data.FrameTypeTimeSeriesMulti, frame.Meta.Type)
+	assert.Equal(t, data.Labels{"database": "sales", "file": "sales_data", "type": "ROWS"}, frame.Fields[1].Labels)
+	assert.Equal(t, 2, frame.Rows())
+	assert.Equal(t, 12.5, frame.Fields[1].At(0))
+	assert.Equal(t, 102400.0, frame.Fields[3].At(0))
+	assert.Equal(t, 2000.0, frame.Fields[4].At(0))
+	assert.Equal(t, 0.0, frame.Fields[3].At(1))
+}
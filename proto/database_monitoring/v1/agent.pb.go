@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/agent.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Agent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	RegisteredAt  *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	LastSeen      *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Targets       []*AgentTarget         `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_agent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Agent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Agent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Agent) GetRegisteredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *Agent) GetLastSeen() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Agent) GetTargets() []*AgentTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type AgentTarget struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Server        *ServerMetadata         `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Tags          []string                `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	RegisteredAt  *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	LastSeen      *timestamp.Timestamp    `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Status        *TargetCollectionStatus `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentTarget) Reset() {
+	*x = AgentTarget{}
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentTarget) ProtoMessage() {}
+
+func (x *AgentTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentTarget.ProtoReflect.Descriptor instead.
+func (*AgentTarget) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AgentTarget) GetServer() *ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *AgentTarget) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AgentTarget) GetRegisteredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *AgentTarget) GetLastSeen() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *AgentTarget) GetStatus() *TargetCollectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type TargetCollectionStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastSnapshot  *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=last_snapshot,json=lastSnapshot,proto3" json:"last_snapshot,omitempty"`
+	LastMetrics   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=last_metrics,json=lastMetrics,proto3" json:"last_metrics,omitempty"`
+	LastError     string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetCollectionStatus) Reset() {
+	*x = TargetCollectionStatus{}
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCollectionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCollectionStatus) ProtoMessage() {}
+
+func (x *TargetCollectionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCollectionStatus.ProtoReflect.Descriptor instead.
+func (*TargetCollectionStatus) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_agent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TargetCollectionStatus) GetLastSnapshot() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return nil
+}
+
+func (x *TargetCollectionStatus) GetLastMetrics() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastMetrics
+	}
+	return nil
+}
+
+func (x *TargetCollectionStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *TargetCollectionStatus) GetLastErrorTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return nil
+}
+
+// TargetCollectionConfig is managed on the collector and applied by the agent collecting the target
+type TargetCollectionConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Server           *ServerMetadata        `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	SnapshotInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	MetricsInterval  *durationpb.Duration   `protobuf:"bytes,3,opt,name=metrics_interval,json=metricsInterval,proto3" json:"metrics_interval,omitempty"`
+	DeadlockInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=deadlock_interval,json=deadlockInterval,proto3" json:"deadlock_interval,omitempty"`
+	Databases        []string               `protobuf:"bytes,5,rep,name=databases,proto3" json:"databases,omitempty"`
+	CollectMetrics   bool                   `protobuf:"varint,6,opt,name=collect_metrics,json=collectMetrics,proto3" json:"collect_metrics,omitempty"`
+	CollectDeadlocks bool                   `protobuf:"varint,7,opt,name=collect_deadlocks,json=collectDeadlocks,proto3" json:"collect_deadlocks,omitempty"`
+	UpdatedAt        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// databases and exclude_databases accept shell patterns
+	ExcludeDatabases []string `protobuf:"bytes,9,rep,name=exclude_databases,json=excludeDatabases,proto3" json:"exclude_databases,omitempty"`
+	// fetch_plans and collect_lock_metrics are on when unset, clients older than the fields do not send them
+	FetchPlans         *bool                `protobuf:"varint,10,opt,name=fetch_plans,json=fetchPlans,proto3,oneof" json:"fetch_plans,omitempty"`
+	CollectLockMetrics *bool                `protobuf:"varint,11,opt,name=collect_lock_metrics,json=collectLockMetrics,proto3,oneof" json:"collect_lock_metrics,omitempty"`
+	IndexStatsInterval *durationpb.Duration `protobuf:"bytes,12,opt,name=index_stats_interval,json=indexStatsInterval,proto3" json:"index_stats_interval,omitempty"`
+	QueryStoreInterval *durationpb.Duration `protobuf:"bytes,13,opt,name=query_store_interval,json=queryStoreInterval,proto3" json:"query_store_interval,omitempty"`
+	JobInterval        *durationpb.Duration `protobuf:"bytes,14,opt,name=job_interval,json=jobInterval,proto3" json:"job_interval,omitempty"`
+	FileSizeInterval   *durationpb.Duration `protobuf:"bytes,15,opt,name=file_size_interval,json=fileSizeInterval,proto3" json:"file_size_interval,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TargetCollectionConfig) Reset() {
+	*x = TargetCollectionConfig{}
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetCollectionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetCollectionConfig) ProtoMessage() {}
+
+func (x *TargetCollectionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetCollectionConfig.ProtoReflect.Descriptor instead.
+func (*TargetCollectionConfig) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TargetCollectionConfig) GetServer() *ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetSnapshotInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetMetricsInterval() *durationpb.Duration {
+	if x != nil {
+		return x.MetricsInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetDeadlockInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DeadlockInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetDatabases() []string {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetCollectMetrics() bool {
+	if x != nil {
+		return x.CollectMetrics
+	}
+	return false
+}
+
+func (x *TargetCollectionConfig) GetCollectDeadlocks() bool {
+	if x != nil {
+		return x.CollectDeadlocks
+	}
+	return false
+}
+
+func (x *TargetCollectionConfig) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetExcludeDatabases() []string {
+	if x != nil {
+		return x.ExcludeDatabases
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetFetchPlans() bool {
+	if x != nil && x.FetchPlans != nil {
+		return *x.FetchPlans
+	}
+	return false
+}
+
+func (x *TargetCollectionConfig) GetCollectLockMetrics() bool {
+	if x != nil && x.CollectLockMetrics != nil {
+		return *x.CollectLockMetrics
+	}
+	return false
+}
+
+func (x *TargetCollectionConfig) GetIndexStatsInterval() *durationpb.Duration {
+	if x != nil {
+		return x.IndexStatsInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetQueryStoreInterval() *durationpb.Duration {
+	if x != nil {
+		return x.QueryStoreInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetJobInterval() *durationpb.Duration {
+	if x != nil {
+		return x.JobInterval
+	}
+	return nil
+}
+
+func (x *TargetCollectionConfig) GetFileSizeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FileSizeInterval
+	}
+	return nil
+}
+
+var File_database_monitoring_v1_agent_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_agent_proto_rawDesc = "" +
+	"\n" +
+	"\"database_monitoring/v1/agent.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a%database_monitoring/v1/snapshot.proto\"\xea\x01\n" +
+	"\x05Agent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12?\n" +
+	"\rregistered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x127\n" +
+	"\tlast_seen\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12=\n" +
+	"\atargets\x18\x05 \x03(\v2#.database_monitoring.v1.AgentTargetR\atargets\"\xa3\x02\n" +
+	"\vAgentTarget\x12>\n" +
+	"\x06server\x18\x01 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12?\n" +
+	"\rregistered_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x127\n" +
+	"\tlast_seen\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12F\n" +
+	"\x06status\x18\x05 \x01(\v2..database_monitoring.v1.TargetCollectionStatusR\x06status\"\xfb\x01\n" +
+	"\x16TargetCollectionStatus\x12?\n" +
+	"\rlast_snapshot\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\flastSnapshot\x12=\n" +
+	"\flast_metrics\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlastMetrics\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x12B\n" +
+	"\x0flast_error_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastErrorTime\"\xb1\a\n" +
+	"\x16TargetCollectionConfig\x12>\n" +
+	"\x06server\x18\x01 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12F\n" +
+	"\x11snapshot_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x10snapshotInterval\x12D\n" +
+	"\x10metrics_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0fmetricsInterval\x12F\n" +
+	"\x11deadlock_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x10deadlockInterval\x12\x1c\n" +
+	"\tdatabases\x18\x05 \x03(\tR\tdatabases\x12'\n" +
+	"\x0fcollect_metrics\x18\x06 \x01(\bR\x0ecollectMetrics\x12+\n" +
+	"\x11collect_deadlocks\x18\a \x01(\bR\x10collectDeadlocks\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x11exclude_databases\x18\t \x03(\tR\x10excludeDatabases\x12$\n" +
+	"\vfetch_plans\x18\n" +
+	" \x01(\bH\x00R\n" +
+	"fetchPlans\x88\x01\x01\x125\n" +
+	"\x14collect_lock_metrics\x18\v \x01(\bH\x01R\x12collectLockMetrics\x88\x01\x01\x12K\n" +
+	"\x14index_stats_interval\x18\f \x01(\v2\x19.google.protobuf.DurationR\x12indexStatsInterval\x12K\n" +
+	"\x14query_store_interval\x18\r \x01(\v2\x19.google.protobuf.DurationR\x12queryStoreInterval\x12<\n" +
+	"\fjob_interval\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\vjobInterval\x12G\n" +
+	"\x12file_size_interval\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x10fileSizeIntervalB\x0e\n" +
+	"\f_fetch_plansB\x17\n" +
+	"\x15_collect_lock_metricsBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_agent_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_agent_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_agent_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_agent_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_agent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_agent_proto_rawDesc), len(file_database_monitoring_v1_agent_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_agent_proto_rawDescData
+}
+
+var file_database_monitoring_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_database_monitoring_v1_agent_proto_goTypes = []any{
+	(*Agent)(nil),                  // 0: database_monitoring.v1.Agent
+	(*AgentTarget)(nil),            // 1: database_monitoring.v1.AgentTarget
+	(*TargetCollectionStatus)(nil), // 2: database_monitoring.v1.TargetCollectionStatus
+	(*TargetCollectionConfig)(nil), // 3: database_monitoring.v1.TargetCollectionConfig
+	(*timestamp.Timestamp)(nil),    // 4: google.protobuf.Timestamp
+	(*ServerMetadata)(nil),         // 5: database_monitoring.v1.ServerMetadata
+	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
+}
+var file_database_monitoring_v1_agent_proto_depIdxs = []int32{
+	4,  // 0: database_monitoring.v1.Agent.registered_at:type_name -> google.protobuf.Timestamp
+	4,  // 1: database_monitoring.v1.Agent.last_seen:type_name -> google.protobuf.Timestamp
+	1,  // 2: database_monitoring.v1.Agent.targets:type_name -> database_monitoring.v1.AgentTarget
+	5,  // 3: database_monitoring.v1.AgentTarget.server:type_name -> database_monitoring.v1.ServerMetadata
+	4,  // 4: database_monitoring.v1.AgentTarget.registered_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: database_monitoring.v1.AgentTarget.last_seen:type_name -> google.protobuf.Timestamp
+	2,  // 6: database_monitoring.v1.AgentTarget.status:type_name -> database_monitoring.v1.TargetCollectionStatus
+	4,  // 7: database_monitoring.v1.TargetCollectionStatus.last_snapshot:type_name -> google.protobuf.Timestamp
+	4,  // 8: database_monitoring.v1.TargetCollectionStatus.last_metrics:type_name -> google.protobuf.Timestamp
+	4,  // 9: database_monitoring.v1.TargetCollectionStatus.last_error_time:type_name -> google.protobuf.Timestamp
+	5,  // 10: database_monitoring.v1.TargetCollectionConfig.server:type_name -> database_monitoring.v1.ServerMetadata
+	6,  // 11: database_monitoring.v1.TargetCollectionConfig.snapshot_interval:type_name -> google.protobuf.Duration
+	6,  // 12: database_monitoring.v1.TargetCollectionConfig.metrics_interval:type_name -> google.protobuf.Duration
+	6,  // 13: database_monitoring.v1.TargetCollectionConfig.deadlock_interval:type_name -> google.protobuf.Duration
+	4,  // 14: database_monitoring.v1.TargetCollectionConfig.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 15: database_monitoring.v1.TargetCollectionConfig.index_stats_interval:type_name -> google.protobuf.Duration
+	6,  // 16: database_monitoring.v1.TargetCollectionConfig.query_store_interval:type_name -> google.protobuf.Duration
+	6,  // 17: database_monitoring.v1.TargetCollectionConfig.job_interval:type_name -> google.protobuf.Duration
+	6,  // 18: database_monitoring.v1.TargetCollectionConfig.file_size_interval:type_name -> google.protobuf.Duration
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_agent_proto_init() }
+func file_database_monitoring_v1_agent_proto_init() {
+	if File_database_monitoring_v1_agent_proto != nil {
+		return
+	}
+	file_database_monitoring_v1_snapshot_proto_init()
+	file_database_monitoring_v1_agent_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_agent_proto_rawDesc), len(file_database_monitoring_v1_agent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_agent_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_agent_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_agent_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_agent_proto = out.File
+	file_database_monitoring_v1_agent_proto_goTypes = nil
+	file_database_monitoring_v1_agent_proto_depIdxs = nil
+}
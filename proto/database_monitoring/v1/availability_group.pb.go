@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/availability_group.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ReplicaState is the state of an availability database on one replica, a primary reads the state of every replica
+// and a secondary only its own
+type ReplicaState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AgName            string                 `protobuf:"bytes,1,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
+	ReplicaServerName string                 `protobuf:"bytes,2,opt,name=replica_server_name,json=replicaServerName,proto3" json:"replica_server_name,omitempty"`
+	DatabaseName      string                 `protobuf:"bytes,3,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	// role is PRIMARY, SECONDARY or RESOLVING
+	Role                  string               `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	AvailabilityMode      string               `protobuf:"bytes,5,opt,name=availability_mode,json=availabilityMode,proto3" json:"availability_mode,omitempty"`
+	FailoverMode          string               `protobuf:"bytes,6,opt,name=failover_mode,json=failoverMode,proto3" json:"failover_mode,omitempty"`
+	ConnectedState        string               `protobuf:"bytes,7,opt,name=connected_state,json=connectedState,proto3" json:"connected_state,omitempty"`
+	IsLocal               bool                 `protobuf:"varint,8,opt,name=is_local,json=isLocal,proto3" json:"is_local,omitempty"`
+	SynchronizationState  string               `protobuf:"bytes,9,opt,name=synchronization_state,json=synchronizationState,proto3" json:"synchronization_state,omitempty"`
+	SynchronizationHealth string               `protobuf:"bytes,10,opt,name=synchronization_health,json=synchronizationHealth,proto3" json:"synchronization_health,omitempty"`
+	IsSuspended           bool                 `protobuf:"varint,11,opt,name=is_suspended,json=isSuspended,proto3" json:"is_suspended,omitempty"`
+	SuspendReason         string               `protobuf:"bytes,12,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	LogSendQueueKb        int64                `protobuf:"varint,13,opt,name=log_send_queue_kb,json=logSendQueueKb,proto3" json:"log_send_queue_kb,omitempty"`
+	LogSendRateKbps       int64                `protobuf:"varint,14,opt,name=log_send_rate_kbps,json=logSendRateKbps,proto3" json:"log_send_rate_kbps,omitempty"`
+	RedoQueueKb           int64                `protobuf:"varint,15,opt,name=redo_queue_kb,json=redoQueueKb,proto3" json:"redo_queue_kb,omitempty"`
+	RedoRateKbps          int64                `protobuf:"varint,16,opt,name=redo_rate_kbps,json=redoRateKbps,proto3" json:"redo_rate_kbps,omitempty"`
+	LastCommitTime        *timestamp.Timestamp `protobuf:"bytes,17,opt,name=last_commit_time,json=lastCommitTime,proto3" json:"last_commit_time,omitempty"`
+	// estimated_data_loss_s is how far the last commit of the replica is behind the primary
+	EstimatedDataLossS int64 `protobuf:"varint,18,opt,name=estimated_data_loss_s,json=estimatedDataLossS,proto3" json:"estimated_data_loss_s,omitempty"`
+	// estimated_recovery_time_s is the time to redo the redo queue at the current redo rate
+	EstimatedRecoveryTimeS int64 `protobuf:"varint,19,opt,name=estimated_recovery_time_s,json=estimatedRecoveryTimeS,proto3" json:"estimated_recovery_time_s,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReplicaState) Reset() {
+	*x = ReplicaState{}
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaState) ProtoMessage() {}
+
+func (x *ReplicaState) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaState.ProtoReflect.Descriptor instead.
+func (*ReplicaState) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_availability_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReplicaState) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetReplicaServerName() string {
+	if x != nil {
+		return x.ReplicaServerName
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetAvailabilityMode() string {
+	if x != nil {
+		return x.AvailabilityMode
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetFailoverMode() string {
+	if x != nil {
+		return x.FailoverMode
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetConnectedState() string {
+	if x != nil {
+		return x.ConnectedState
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetIsLocal() bool {
+	if x != nil {
+		return x.IsLocal
+	}
+	return false
+}
+
+func (x *ReplicaState) GetSynchronizationState() string {
+	if x != nil {
+		return x.SynchronizationState
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetSynchronizationHealth() string {
+	if x != nil {
+		return x.SynchronizationHealth
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetIsSuspended() bool {
+	if x != nil {
+		return x.IsSuspended
+	}
+	return false
+}
+
+func (x *ReplicaState) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *ReplicaState) GetLogSendQueueKb() int64 {
+	if x != nil {
+		return x.LogSendQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaState) GetLogSendRateKbps() int64 {
+	if x != nil {
+		return x.LogSendRateKbps
+	}
+	return 0
+}
+
+func (x *ReplicaState) GetRedoQueueKb() int64 {
+	if x != nil {
+		return x.RedoQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaState) GetRedoRateKbps() int64 {
+	if x != nil {
+		return x.RedoRateKbps
+	}
+	return 0
+}
+
+func (x *ReplicaState) GetLastCommitTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastCommitTime
+	}
+	return nil
+}
+
+func (x *ReplicaState) GetEstimatedDataLossS() int64 {
+	if x != nil {
+		return x.EstimatedDataLossS
+	}
+	return 0
+}
+
+func (x *ReplicaState) GetEstimatedRecoveryTimeS() int64 {
+	if x != nil {
+		return x.EstimatedRecoveryTimeS
+	}
+	return 0
+}
+
+// ReplicaStateSeries holds the lag of an availability database on one replica, one point per metrics interval
+type ReplicaStateSeries struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AgName            string                 `protobuf:"bytes,1,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
+	ReplicaServerName string                 `protobuf:"bytes,2,opt,name=replica_server_name,json=replicaServerName,proto3" json:"replica_server_name,omitempty"`
+	DatabaseName      string                 `protobuf:"bytes,3,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	Points            []*ReplicaStatePoint   `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReplicaStateSeries) Reset() {
+	*x = ReplicaStateSeries{}
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaStateSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaStateSeries) ProtoMessage() {}
+
+func (x *ReplicaStateSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaStateSeries.ProtoReflect.Descriptor instead.
+func (*ReplicaStateSeries) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_availability_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplicaStateSeries) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
+}
+
+func (x *ReplicaStateSeries) GetReplicaServerName() string {
+	if x != nil {
+		return x.ReplicaServerName
+	}
+	return ""
+}
+
+func (x *ReplicaStateSeries) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *ReplicaStateSeries) GetPoints() []*ReplicaStatePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ReplicaStatePoint struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp              *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Role                   string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	SynchronizationState   string                 `protobuf:"bytes,3,opt,name=synchronization_state,json=synchronizationState,proto3" json:"synchronization_state,omitempty"`
+	LogSendQueueKb         int64                  `protobuf:"varint,4,opt,name=log_send_queue_kb,json=logSendQueueKb,proto3" json:"log_send_queue_kb,omitempty"`
+	LogSendRateKbps        int64                  `protobuf:"varint,5,opt,name=log_send_rate_kbps,json=logSendRateKbps,proto3" json:"log_send_rate_kbps,omitempty"`
+	RedoQueueKb            int64                  `protobuf:"varint,6,opt,name=redo_queue_kb,json=redoQueueKb,proto3" json:"redo_queue_kb,omitempty"`
+	RedoRateKbps           int64                  `protobuf:"varint,7,opt,name=redo_rate_kbps,json=redoRateKbps,proto3" json:"redo_rate_kbps,omitempty"`
+	EstimatedDataLossS     int64                  `protobuf:"varint,8,opt,name=estimated_data_loss_s,json=estimatedDataLossS,proto3" json:"estimated_data_loss_s,omitempty"`
+	EstimatedRecoveryTimeS int64                  `protobuf:"varint,9,opt,name=estimated_recovery_time_s,json=estimatedRecoveryTimeS,proto3" json:"estimated_recovery_time_s,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReplicaStatePoint) Reset() {
+	*x = ReplicaStatePoint{}
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaStatePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaStatePoint) ProtoMessage() {}
+
+func (x *ReplicaStatePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaStatePoint.ProtoReflect.Descriptor instead.
+func (*ReplicaStatePoint) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_availability_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReplicaStatePoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ReplicaStatePoint) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReplicaStatePoint) GetSynchronizationState() string {
+	if x != nil {
+		return x.SynchronizationState
+	}
+	return ""
+}
+
+func (x *ReplicaStatePoint) GetLogSendQueueKb() int64 {
+	if x != nil {
+		return x.LogSendQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaStatePoint) GetLogSendRateKbps() int64 {
+	if x != nil {
+		return x.LogSendRateKbps
+	}
+	return 0
+}
+
+func (x *ReplicaStatePoint) GetRedoQueueKb() int64 {
+	if x != nil {
+		return x.RedoQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaStatePoint) GetRedoRateKbps() int64 {
+	if x != nil {
+		return x.RedoRateKbps
+	}
+	return 0
+}
+
+func (x *ReplicaStatePoint) GetEstimatedDataLossS() int64 {
+	if x != nil {
+		return x.EstimatedDataLossS
+	}
+	return 0
+}
+
+func (x *ReplicaStatePoint) GetEstimatedRecoveryTimeS() int64 {
+	if x != nil {
+		return x.EstimatedRecoveryTimeS
+	}
+	return 0
+}
+
+// AvailabilityGroupTopology is the latest state of the replicas of an availability group
+type AvailabilityGroupTopology struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgName        string                 `protobuf:"bytes,1,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
+	CollectedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Replicas      []*AvailabilityReplica `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityGroupTopology) Reset() {
+	*x = AvailabilityGroupTopology{}
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityGroupTopology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityGroupTopology) ProtoMessage() {}
+
+func (x *AvailabilityGroupTopology) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityGroupTopology.ProtoReflect.Descriptor instead.
+func (*AvailabilityGroupTopology) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_availability_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AvailabilityGroupTopology) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
+}
+
+func (x *AvailabilityGroupTopology) GetCollectedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *AvailabilityGroupTopology) GetReplicas() []*AvailabilityReplica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+type AvailabilityReplica struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaServerName string                 `protobuf:"bytes,1,opt,name=replica_server_name,json=replicaServerName,proto3" json:"replica_server_name,omitempty"`
+	Role              string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	AvailabilityMode  string                 `protobuf:"bytes,3,opt,name=availability_mode,json=availabilityMode,proto3" json:"availability_mode,omitempty"`
+	FailoverMode      string                 `protobuf:"bytes,4,opt,name=failover_mode,json=failoverMode,proto3" json:"failover_mode,omitempty"`
+	ConnectedState    string                 `protobuf:"bytes,5,opt,name=connected_state,json=connectedState,proto3" json:"connected_state,omitempty"`
+	// synchronization_health is the worst health of the databases of the replica
+	SynchronizationHealth string          `protobuf:"bytes,6,opt,name=synchronization_health,json=synchronizationHealth,proto3" json:"synchronization_health,omitempty"`
+	Databases             []*ReplicaState `protobuf:"bytes,7,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AvailabilityReplica) Reset() {
+	*x = AvailabilityReplica{}
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityReplica) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityReplica) ProtoMessage() {}
+
+func (x *AvailabilityReplica) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_availability_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityReplica.ProtoReflect.Descriptor instead.
+func (*AvailabilityReplica) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_availability_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AvailabilityReplica) GetReplicaServerName() string {
+	if x != nil {
+		return x.ReplicaServerName
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetAvailabilityMode() string {
+	if x != nil {
+		return x.AvailabilityMode
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetFailoverMode() string {
+	if x != nil {
+		return x.FailoverMode
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetConnectedState() string {
+	if x != nil {
+		return x.ConnectedState
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetSynchronizationHealth() string {
+	if x != nil {
+		return x.SynchronizationHealth
+	}
+	return ""
+}
+
+func (x *AvailabilityReplica) GetDatabases() []*ReplicaState {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+var File_database_monitoring_v1_availability_group_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_availability_group_proto_rawDesc = "" +
+	"\n" +
+	"/database_monitoring/v1/availability_group.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x06\n" +
+	"\fReplicaState\x12\x17\n" +
+	"\aag_name\x18\x01 \x01(\tR\x06agName\x12.\n" +
+	"\x13replica_server_name\x18\x02 \x01(\tR\x11replicaServerName\x12#\n" +
+	"\rdatabase_name\x18\x03 \x01(\tR\fdatabaseName\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12+\n" +
+	"\x11availability_mode\x18\x05 \x01(\tR\x10availabilityMode\x12#\n" +
+	"\rfailover_mode\x18\x06 \x01(\tR\ffailoverMode\x12'\n" +
+	"\x0fconnected_state\x18\a \x01(\tR\x0econnectedState\x12\x19\n" +
+	"\bis_local\x18\b \x01(\bR\aisLocal\x123\n" +
+	"\x15synchronization_state\x18\t \x01(\tR\x14synchronizationState\x125\n" +
+	"\x16synchronization_health\x18\n" +
+	" \x01(\tR\x15synchronizationHealth\x12!\n" +
+	"\fis_suspended\x18\v \x01(\bR\visSuspended\x12%\n" +
+	"\x0esuspend_reason\x18\f \x01(\tR\rsuspendReason\x12)\n" +
+	"\x11log_send_queue_kb\x18\r \x01(\x03R\x0elogSendQueueKb\x12+\n" +
+	"\x12log_send_rate_kbps\x18\x0e \x01(\x03R\x0flogSendRateKbps\x12\"\n" +
+	"\rredo_queue_kb\x18\x0f \x01(\x03R\vredoQueueKb\x12$\n" +
+	"\x0eredo_rate_kbps\x18\x10 \x01(\x03R\fredoRateKbps\x12D\n" +
+	"\x10last_commit_time\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastCommitTime\x121\n" +
+	"\x15estimated_data_loss_s\x18\x12 \x01(\x03R\x12estimatedDataLossS\x129\n" +
+	"\x19estimated_recovery_time_s\x18\x13 \x01(\x03R\x16estimatedRecoveryTimeS\"\xc5\x01\n" +
+	"\x12ReplicaStateSeries\x12\x17\n" +
+	"\aag_name\x18\x01 \x01(\tR\x06agName\x12.\n" +
+	"\x13replica_server_name\x18\x02 \x01(\tR\x11replicaServerName\x12#\n" +
+	"\rdatabase_name\x18\x03 \x01(\tR\fdatabaseName\x12A\n" +
+	"\x06points\x18\x04 \x03(\v2).database_monitoring.v1.ReplicaStatePointR\x06points\"\xa6\x03\n" +
+	"\x11ReplicaStatePoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x123\n" +
+	"\x15synchronization_state\x18\x03 \x01(\tR\x14synchronizationState\x12)\n" +
+	"\x11log_send_queue_kb\x18\x04 \x01(\x03R\x0elogSendQueueKb\x12+\n" +
+	"\x12log_send_rate_kbps\x18\x05 \x01(\x03R\x0flogSendRateKbps\x12\"\n" +
+	"\rredo_queue_kb\x18\x06 \x01(\x03R\vredoQueueKb\x12$\n" +
+	"\x0eredo_rate_kbps\x18\a \x01(\x03R\fredoRateKbps\x121\n" +
+	"\x15estimated_data_loss_s\x18\b \x01(\x03R\x12estimatedDataLossS\x129\n" +
+	"\x19estimated_recovery_time_s\x18\t \x01(\x03R\x16estimatedRecoveryTimeS\"\xbc\x01\n" +
+	"\x19AvailabilityGroupTopology\x12\x17\n" +
+	"\aag_name\x18\x01 \x01(\tR\x06agName\x12=\n" +
+	"\fcollected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12G\n" +
+	"\breplicas\x18\x03 \x03(\v2+.database_monitoring.v1.AvailabilityReplicaR\breplicas\"\xcf\x02\n" +
+	"\x13AvailabilityReplica\x12.\n" +
+	"\x13replica_server_name\x18\x01 \x01(\tR\x11replicaServerName\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12+\n" +
+	"\x11availability_mode\x18\x03 \x01(\tR\x10availabilityMode\x12#\n" +
+	"\rfailover_mode\x18\x04 \x01(\tR\ffailoverMode\x12'\n" +
+	"\x0fconnected_state\x18\x05 \x01(\tR\x0econnectedState\x125\n" +
+	"\x16synchronization_health\x18\x06 \x01(\tR\x15synchronizationHealth\x12B\n" +
+	"\tdatabases\x18\a \x03(\v2$.database_monitoring.v1.ReplicaStateR\tdatabasesBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_availability_group_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_availability_group_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_availability_group_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_availability_group_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_availability_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_availability_group_proto_rawDesc), len(file_database_monitoring_v1_availability_group_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_availability_group_proto_rawDescData
+}
+
+var file_database_monitoring_v1_availability_group_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_database_monitoring_v1_availability_group_proto_goTypes = []any{
+	(*ReplicaState)(nil),              // 0: database_monitoring.v1.ReplicaState
+	(*ReplicaStateSeries)(nil),        // 1: database_monitoring.v1.ReplicaStateSeries
+	(*ReplicaStatePoint)(nil),         // 2: database_monitoring.v1.ReplicaStatePoint
+	(*AvailabilityGroupTopology)(nil), // 3: database_monitoring.v1.AvailabilityGroupTopology
+	(*AvailabilityReplica)(nil),       // 4: database_monitoring.v1.AvailabilityReplica
+	(*timestamp.Timestamp)(nil),       // 5: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_availability_group_proto_depIdxs = []int32{
+	5, // 0: database_monitoring.v1.ReplicaState.last_commit_time:type_name -> google.protobuf.Timestamp
+	2, // 1: database_monitoring.v1.ReplicaStateSeries.points:type_name -> database_monitoring.v1.ReplicaStatePoint
+	5, // 2: database_monitoring.v1.ReplicaStatePoint.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 3: database_monitoring.v1.AvailabilityGroupTopology.collected_at:type_name -> google.protobuf.Timestamp
+	4, // 4: database_monitoring.v1.AvailabilityGroupTopology.replicas:type_name -> database_monitoring.v1.AvailabilityReplica
+	0, // 5: database_monitoring.v1.AvailabilityReplica.databases:type_name -> database_monitoring.v1.ReplicaState
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_availability_group_proto_init() }
+func file_database_monitoring_v1_availability_group_proto_init() {
+	if File_database_monitoring_v1_availability_group_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_availability_group_proto_rawDesc), len(file_database_monitoring_v1_availability_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_availability_group_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_availability_group_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_availability_group_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_availability_group_proto = out.File
+	file_database_monitoring_v1_availability_group_proto_goTypes = nil
+	file_database_monitoring_v1_availability_group_proto_depIdxs = nil
+}
@@ -22,6 +22,286 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetCollectionConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *v1.ServerMetadata     `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	AgentId       string                 `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionConfigRequest) Reset() {
+	*x = GetCollectionConfigRequest{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionConfigRequest) ProtoMessage() {}
+
+func (x *GetCollectionConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionConfigRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCollectionConfigRequest) GetServer() *v1.ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *GetCollectionConfigRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type GetCollectionConfigResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Config        *v1.TargetCollectionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionConfigResponse) Reset() {
+	*x = GetCollectionConfigResponse{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionConfigResponse) ProtoMessage() {}
+
+func (x *GetCollectionConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionConfigResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCollectionConfigResponse) GetConfig() *v1.TargetCollectionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	AgentId       string                     `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Server        *v1.ServerMetadata         `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Status        *v1.TargetCollectionStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HeartbeatRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetServer() *v1.ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *HeartbeatRequest) GetStatus() *v1.TargetCollectionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{3}
+}
+
+type IngestDeadlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deadlocks     []*v1.Deadlock         `protobuf:"bytes,1,rep,name=deadlocks,proto3" json:"deadlocks,omitempty"`
+	Server        *v1.ServerMetadata     `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestDeadlocksRequest) Reset() {
+	*x = IngestDeadlocksRequest{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestDeadlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestDeadlocksRequest) ProtoMessage() {}
+
+func (x *IngestDeadlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestDeadlocksRequest.ProtoReflect.Descriptor instead.
+func (*IngestDeadlocksRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IngestDeadlocksRequest) GetDeadlocks() []*v1.Deadlock {
+	if x != nil {
+		return x.Deadlocks
+	}
+	return nil
+}
+
+func (x *IngestDeadlocksRequest) GetServer() *v1.ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type IngestDeadlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestDeadlocksResponse) Reset() {
+	*x = IngestDeadlocksResponse{}
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestDeadlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestDeadlocksResponse) ProtoMessage() {}
+
+func (x *IngestDeadlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestDeadlocksResponse.ProtoReflect.Descriptor instead.
+func (*IngestDeadlocksResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{5}
+}
+
 type GetKnownWarningsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *v1.ServerMetadata     `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
@@ -33,7 +313,7 @@ type GetKnownWarningsRequest struct {
 
 func (x *GetKnownWarningsRequest) Reset() {
 	*x = GetKnownWarningsRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[0]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -45,7 +325,7 @@ func (x *GetKnownWarningsRequest) String() string {
 func (*GetKnownWarningsRequest) ProtoMessage() {}
 
 func (x *GetKnownWarningsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[0]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +338,7 @@ func (x *GetKnownWarningsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnownWarningsRequest.ProtoReflect.Descriptor instead.
 func (*GetKnownWarningsRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{0}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetKnownWarningsRequest) GetServer() *v1.ServerMetadata {
@@ -94,7 +374,7 @@ type GetKnownWarningsResponse struct {
 
 func (x *GetKnownWarningsResponse) Reset() {
 	*x = GetKnownWarningsResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +386,7 @@ func (x *GetKnownWarningsResponse) String() string {
 func (*GetKnownWarningsResponse) ProtoMessage() {}
 
 func (x *GetKnownWarningsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +399,7 @@ func (x *GetKnownWarningsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnownWarningsResponse.ProtoReflect.Descriptor instead.
 func (*GetKnownWarningsResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{1}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetKnownWarningsResponse) GetWarnings() []*v1.Warning {
@@ -160,7 +440,7 @@ type IngestWarningsRequest struct {
 
 func (x *IngestWarningsRequest) Reset() {
 	*x = IngestWarningsRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +452,7 @@ func (x *IngestWarningsRequest) String() string {
 func (*IngestWarningsRequest) ProtoMessage() {}
 
 func (x *IngestWarningsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +465,7 @@ func (x *IngestWarningsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestWarningsRequest.ProtoReflect.Descriptor instead.
 func (*IngestWarningsRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{2}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IngestWarningsRequest) GetWarnings() []*v1.Warning {
@@ -210,7 +490,7 @@ type IngestWarningsResponse struct {
 
 func (x *IngestWarningsResponse) Reset() {
 	*x = IngestWarningsResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +502,7 @@ func (x *IngestWarningsResponse) String() string {
 func (*IngestWarningsResponse) ProtoMessage() {}
 
 func (x *IngestWarningsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +515,7 @@ func (x *IngestWarningsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestWarningsResponse.ProtoReflect.Descriptor instead.
 func (*IngestWarningsResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{3}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{9}
 }
 
 type GetKnownPlanHandlesRequest struct {
@@ -249,7 +529,7 @@ type GetKnownPlanHandlesRequest struct {
 
 func (x *GetKnownPlanHandlesRequest) Reset() {
 	*x = GetKnownPlanHandlesRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +541,7 @@ func (x *GetKnownPlanHandlesRequest) String() string {
 func (*GetKnownPlanHandlesRequest) ProtoMessage() {}
 
 func (x *GetKnownPlanHandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +554,7 @@ func (x *GetKnownPlanHandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnownPlanHandlesRequest.ProtoReflect.Descriptor instead.
 func (*GetKnownPlanHandlesRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{4}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetKnownPlanHandlesRequest) GetServer() *v1.ServerMetadata {
@@ -310,7 +590,7 @@ type GetKnownPlanHandlesResponse struct {
 
 func (x *GetKnownPlanHandlesResponse) Reset() {
 	*x = GetKnownPlanHandlesResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +602,7 @@ func (x *GetKnownPlanHandlesResponse) String() string {
 func (*GetKnownPlanHandlesResponse) ProtoMessage() {}
 
 func (x *GetKnownPlanHandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +615,7 @@ func (x *GetKnownPlanHandlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnownPlanHandlesResponse.ProtoReflect.Descriptor instead.
 func (*GetKnownPlanHandlesResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{5}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetKnownPlanHandlesResponse) GetHandles() []string {
@@ -375,7 +655,7 @@ type IngestExecutionPlansRequest struct {
 
 func (x *IngestExecutionPlansRequest) Reset() {
 	*x = IngestExecutionPlansRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +667,7 @@ func (x *IngestExecutionPlansRequest) String() string {
 func (*IngestExecutionPlansRequest) ProtoMessage() {}
 
 func (x *IngestExecutionPlansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +680,7 @@ func (x *IngestExecutionPlansRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestExecutionPlansRequest.ProtoReflect.Descriptor instead.
 func (*IngestExecutionPlansRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{6}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *IngestExecutionPlansRequest) GetPlans() []*v1.ExecutionPlan {
@@ -418,7 +698,7 @@ type IngestExecutionPlansResponse struct {
 
 func (x *IngestExecutionPlansResponse) Reset() {
 	*x = IngestExecutionPlansResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +710,7 @@ func (x *IngestExecutionPlansResponse) String() string {
 func (*IngestExecutionPlansResponse) ProtoMessage() {}
 
 func (x *IngestExecutionPlansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +723,7 @@ func (x *IngestExecutionPlansResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestExecutionPlansResponse.ProtoReflect.Descriptor instead.
 func (*IngestExecutionPlansResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{7}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{13}
 }
 
 type RegisterAgentRequest struct {
@@ -452,13 +732,14 @@ type RegisterAgentRequest struct {
 	TargetType    string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
 	AgentVersion  string                 `protobuf:"bytes,3,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
 	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	AgentId       string                 `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RegisterAgentRequest) Reset() {
 	*x = RegisterAgentRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[8]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +751,7 @@ func (x *RegisterAgentRequest) String() string {
 func (*RegisterAgentRequest) ProtoMessage() {}
 
 func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[8]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +764,7 @@ func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{8}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RegisterAgentRequest) GetTargetHost() string {
@@ -514,6 +795,13 @@ func (x *RegisterAgentRequest) GetTags() []string {
 	return nil
 }
 
+func (x *RegisterAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
 type RegisterAgentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -522,7 +810,7 @@ type RegisterAgentResponse struct {
 
 func (x *RegisterAgentResponse) Reset() {
 	*x = RegisterAgentResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[9]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +822,7 @@ func (x *RegisterAgentResponse) String() string {
 func (*RegisterAgentResponse) ProtoMessage() {}
 
 func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[9]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +835,7 @@ func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
 func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{9}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{15}
 }
 
 type IngestMetricsResponse struct {
@@ -560,7 +848,7 @@ type IngestMetricsResponse struct {
 
 func (x *IngestMetricsResponse) Reset() {
 	*x = IngestMetricsResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[10]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +860,7 @@ func (x *IngestMetricsResponse) String() string {
 func (*IngestMetricsResponse) ProtoMessage() {}
 
 func (x *IngestMetricsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[10]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +873,7 @@ func (x *IngestMetricsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestMetricsResponse.ProtoReflect.Descriptor instead.
 func (*IngestMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{10}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *IngestMetricsResponse) GetSuccess() bool {
@@ -611,7 +899,7 @@ type IngestSnapshotRequest struct {
 
 func (x *IngestSnapshotRequest) Reset() {
 	*x = IngestSnapshotRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[11]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +911,7 @@ func (x *IngestSnapshotRequest) String() string {
 func (*IngestSnapshotRequest) ProtoMessage() {}
 
 func (x *IngestSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[11]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +924,7 @@ func (x *IngestSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*IngestSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{11}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IngestSnapshotRequest) GetSnapshot() *v1.DBSnapshot {
@@ -654,7 +942,7 @@ type IngestSnapshotResponse struct {
 
 func (x *IngestSnapshotResponse) Reset() {
 	*x = IngestSnapshotResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[12]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +954,7 @@ func (x *IngestSnapshotResponse) String() string {
 func (*IngestSnapshotResponse) ProtoMessage() {}
 
 func (x *IngestSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[12]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +967,7 @@ func (x *IngestSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*IngestSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{12}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{18}
 }
 
 type IngestSnapshotSamplesRequest struct {
@@ -692,7 +980,7 @@ type IngestSnapshotSamplesRequest struct {
 
 func (x *IngestSnapshotSamplesRequest) Reset() {
 	*x = IngestSnapshotSamplesRequest{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[13]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +992,7 @@ func (x *IngestSnapshotSamplesRequest) String() string {
 func (*IngestSnapshotSamplesRequest) ProtoMessage() {}
 
 func (x *IngestSnapshotSamplesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[13]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +1005,7 @@ func (x *IngestSnapshotSamplesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestSnapshotSamplesRequest.ProtoReflect.Descriptor instead.
 func (*IngestSnapshotSamplesRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{13}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *IngestSnapshotSamplesRequest) GetId() string {
@@ -742,7 +1030,7 @@ type IngestSnapshotSamplesResponse struct {
 
 func (x *IngestSnapshotSamplesResponse) Reset() {
 	*x = IngestSnapshotSamplesResponse{}
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[14]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -754,7 +1042,7 @@ func (x *IngestSnapshotSamplesResponse) String() string {
 func (*IngestSnapshotSamplesResponse) ProtoMessage() {}
 
 func (x *IngestSnapshotSamplesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[14]
+	mi := &file_database_monitoring_v1_collector_collector_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,14 +1055,28 @@ func (x *IngestSnapshotSamplesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IngestSnapshotSamplesResponse.ProtoReflect.Descriptor instead.
 func (*IngestSnapshotSamplesResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{14}
+	return file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP(), []int{20}
 }
 
 var File_database_monitoring_v1_collector_collector_api_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_collector_collector_api_proto_rawDesc = "" +
 	"\n" +
-	"4database_monitoring/v1/collector/collector_api.proto\x12\x16database_monitoring.v1\x1a.database_monitoring/v1/collector/metrics.proto\x1a%database_monitoring/v1/snapshot.proto\x1a+database_monitoring/v1/execution_plan.proto\x1a#database_monitoring/v1/sample.proto\x1a$database_monitoring/v1/warning.proto\"\x97\x01\n" +
+	"4database_monitoring/v1/collector/collector_api.proto\x12\x16database_monitoring.v1\x1a.database_monitoring/v1/collector/metrics.proto\x1a%database_monitoring/v1/snapshot.proto\x1a+database_monitoring/v1/execution_plan.proto\x1a#database_monitoring/v1/sample.proto\x1a$database_monitoring/v1/warning.proto\x1a%database_monitoring/v1/deadlock.proto\x1a\"database_monitoring/v1/agent.proto\"w\n" +
+	"\x1aGetCollectionConfigRequest\x12>\n" +
+	"\x06server\x18\x01 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12\x19\n" +
+	"\bagent_id\x18\x02 \x01(\tR\aagentId\"e\n" +
+	"\x1bGetCollectionConfigResponse\x12F\n" +
+	"\x06config\x18\x01 \x01(\v2..database_monitoring.v1.TargetCollectionConfigR\x06config\"\xb5\x01\n" +
+	"\x10HeartbeatRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12>\n" +
+	"\x06server\x18\x02 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12F\n" +
+	"\x06status\x18\x03 \x01(\v2..database_monitoring.v1.TargetCollectionStatusR\x06status\"\x13\n" +
+	"\x11HeartbeatResponse\"\x98\x01\n" +
+	"\x16IngestDeadlocksRequest\x12>\n" +
+	"\tdeadlocks\x18\x01 \x03(\v2 .database_monitoring.v1.DeadlockR\tdeadlocks\x12>\n" +
+	"\x06server\x18\x02 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\"\x19\n" +
+	"\x17IngestDeadlocksResponse\"\x97\x01\n" +
 	"\x17GetKnownWarningsRequest\x12>\n" +
 	"\x06server\x18\x01 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
@@ -805,14 +1107,15 @@ const file_database_monitoring_v1_collector_collector_api_proto_rawDesc = "" +
 	"totalPages\"Z\n" +
 	"\x1bIngestExecutionPlansRequest\x12;\n" +
 	"\x05plans\x18\x01 \x03(\v2%.database_monitoring.v1.ExecutionPlanR\x05plans\"\x1e\n" +
-	"\x1cIngestExecutionPlansResponse\"\x91\x01\n" +
+	"\x1cIngestExecutionPlansResponse\"\xac\x01\n" +
 	"\x14RegisterAgentRequest\x12\x1f\n" +
 	"\vtarget_host\x18\x01 \x01(\tR\n" +
 	"targetHost\x12\x1f\n" +
 	"\vtarget_type\x18\x02 \x01(\tR\n" +
 	"targetType\x12#\n" +
 	"\ragent_version\x18\x03 \x01(\tR\fagentVersion\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\"\x17\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x19\n" +
+	"\bagent_id\x18\x05 \x01(\tR\aagentId\"\x17\n" +
 	"\x15RegisterAgentResponse\"K\n" +
 	"\x15IngestMetricsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
@@ -823,7 +1126,8 @@ const file_database_monitoring_v1_collector_collector_api_proto_rawDesc = "" +
 	"\x1cIngestSnapshotSamplesRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
 	"\asamples\x18\x05 \x03(\v2#.database_monitoring.v1.QuerySampleR\asamples\"\x1f\n" +
-	"\x1dIngestSnapshotSamplesResponse2\xcd\a\n" +
+	"\x1dIngestSnapshotSamplesResponse2\xa3\n" +
+	"\n" +
 	"\x10IngestionService\x12l\n" +
 	"\rRegisterAgent\x12,.database_monitoring.v1.RegisterAgentRequest\x1a-.database_monitoring.v1.RegisterAgentResponse\x12g\n" +
 	"\rIngestMetrics\x12'.database_monitoring.v1.DatabaseMetrics\x1a-.database_monitoring.v1.IngestMetricsResponse\x12o\n" +
@@ -832,7 +1136,10 @@ const file_database_monitoring_v1_collector_collector_api_proto_rawDesc = "" +
 	"\x14IngestExecutionPlans\x123.database_monitoring.v1.IngestExecutionPlansRequest\x1a4.database_monitoring.v1.IngestExecutionPlansResponse\x12~\n" +
 	"\x13GetKnownPlanHandles\x122.database_monitoring.v1.GetKnownPlanHandlesRequest\x1a3.database_monitoring.v1.GetKnownPlanHandlesResponse\x12o\n" +
 	"\x0eIngestWarnings\x12-.database_monitoring.v1.IngestWarningsRequest\x1a..database_monitoring.v1.IngestWarningsResponse\x12u\n" +
-	"\x10GetKnownWarnings\x12/.database_monitoring.v1.GetKnownWarningsRequest\x1a0.database_monitoring.v1.GetKnownWarningsResponseBeZcgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1/collector;collectorv1b\x06proto3"
+	"\x10GetKnownWarnings\x12/.database_monitoring.v1.GetKnownWarningsRequest\x1a0.database_monitoring.v1.GetKnownWarningsResponse\x12r\n" +
+	"\x0fIngestDeadlocks\x12..database_monitoring.v1.IngestDeadlocksRequest\x1a/.database_monitoring.v1.IngestDeadlocksResponse\x12`\n" +
+	"\tHeartbeat\x12(.database_monitoring.v1.HeartbeatRequest\x1a).database_monitoring.v1.HeartbeatResponse\x12~\n" +
+	"\x13GetCollectionConfig\x122.database_monitoring.v1.GetCollectionConfigRequest\x1a3.database_monitoring.v1.GetCollectionConfigResponseBeZcgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1/collector;collectorv1b\x06proto3"
 
 var (
 	file_database_monitoring_v1_collector_collector_api_proto_rawDescOnce sync.Once
@@ -846,60 +1153,81 @@ func file_database_monitoring_v1_collector_collector_api_proto_rawDescGZIP() []b
 	return file_database_monitoring_v1_collector_collector_api_proto_rawDescData
 }
 
-var file_database_monitoring_v1_collector_collector_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_database_monitoring_v1_collector_collector_api_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_database_monitoring_v1_collector_collector_api_proto_goTypes = []any{
-	(*GetKnownWarningsRequest)(nil),       // 0: database_monitoring.v1.GetKnownWarningsRequest
-	(*GetKnownWarningsResponse)(nil),      // 1: database_monitoring.v1.GetKnownWarningsResponse
-	(*IngestWarningsRequest)(nil),         // 2: database_monitoring.v1.IngestWarningsRequest
-	(*IngestWarningsResponse)(nil),        // 3: database_monitoring.v1.IngestWarningsResponse
-	(*GetKnownPlanHandlesRequest)(nil),    // 4: database_monitoring.v1.GetKnownPlanHandlesRequest
-	(*GetKnownPlanHandlesResponse)(nil),   // 5: database_monitoring.v1.GetKnownPlanHandlesResponse
-	(*IngestExecutionPlansRequest)(nil),   // 6: database_monitoring.v1.IngestExecutionPlansRequest
-	(*IngestExecutionPlansResponse)(nil),  // 7: database_monitoring.v1.IngestExecutionPlansResponse
-	(*RegisterAgentRequest)(nil),          // 8: database_monitoring.v1.RegisterAgentRequest
-	(*RegisterAgentResponse)(nil),         // 9: database_monitoring.v1.RegisterAgentResponse
-	(*IngestMetricsResponse)(nil),         // 10: database_monitoring.v1.IngestMetricsResponse
-	(*IngestSnapshotRequest)(nil),         // 11: database_monitoring.v1.IngestSnapshotRequest
-	(*IngestSnapshotResponse)(nil),        // 12: database_monitoring.v1.IngestSnapshotResponse
-	(*IngestSnapshotSamplesRequest)(nil),  // 13: database_monitoring.v1.IngestSnapshotSamplesRequest
-	(*IngestSnapshotSamplesResponse)(nil), // 14: database_monitoring.v1.IngestSnapshotSamplesResponse
-	(*v1.ServerMetadata)(nil),             // 15: database_monitoring.v1.ServerMetadata
-	(*v1.Warning)(nil),                    // 16: database_monitoring.v1.Warning
-	(*v1.ExecutionPlan)(nil),              // 17: database_monitoring.v1.ExecutionPlan
-	(*v1.DBSnapshot)(nil),                 // 18: database_monitoring.v1.DBSnapshot
-	(*v1.QuerySample)(nil),                // 19: database_monitoring.v1.QuerySample
-	(*DatabaseMetrics)(nil),               // 20: database_monitoring.v1.DatabaseMetrics
+	(*GetCollectionConfigRequest)(nil),    // 0: database_monitoring.v1.GetCollectionConfigRequest
+	(*GetCollectionConfigResponse)(nil),   // 1: database_monitoring.v1.GetCollectionConfigResponse
+	(*HeartbeatRequest)(nil),              // 2: database_monitoring.v1.HeartbeatRequest
+	(*HeartbeatResponse)(nil),             // 3: database_monitoring.v1.HeartbeatResponse
+	(*IngestDeadlocksRequest)(nil),        // 4: database_monitoring.v1.IngestDeadlocksRequest
+	(*IngestDeadlocksResponse)(nil),       // 5: database_monitoring.v1.IngestDeadlocksResponse
+	(*GetKnownWarningsRequest)(nil),       // 6: database_monitoring.v1.GetKnownWarningsRequest
+	(*GetKnownWarningsResponse)(nil),      // 7: database_monitoring.v1.GetKnownWarningsResponse
+	(*IngestWarningsRequest)(nil),         // 8: database_monitoring.v1.IngestWarningsRequest
+	(*IngestWarningsResponse)(nil),        // 9: database_monitoring.v1.IngestWarningsResponse
+	(*GetKnownPlanHandlesRequest)(nil),    // 10: database_monitoring.v1.GetKnownPlanHandlesRequest
+	(*GetKnownPlanHandlesResponse)(nil),   // 11: database_monitoring.v1.GetKnownPlanHandlesResponse
+	(*IngestExecutionPlansRequest)(nil),   // 12: database_monitoring.v1.IngestExecutionPlansRequest
+	(*IngestExecutionPlansResponse)(nil),  // 13: database_monitoring.v1.IngestExecutionPlansResponse
+	(*RegisterAgentRequest)(nil),          // 14: database_monitoring.v1.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),         // 15: database_monitoring.v1.RegisterAgentResponse
+	(*IngestMetricsResponse)(nil),         // 16: database_monitoring.v1.IngestMetricsResponse
+	(*IngestSnapshotRequest)(nil),         // 17: database_monitoring.v1.IngestSnapshotRequest
+	(*IngestSnapshotResponse)(nil),        // 18: database_monitoring.v1.IngestSnapshotResponse
+	(*IngestSnapshotSamplesRequest)(nil),  // 19: database_monitoring.v1.IngestSnapshotSamplesRequest
+	(*IngestSnapshotSamplesResponse)(nil), // 20: database_monitoring.v1.IngestSnapshotSamplesResponse
+	(*v1.ServerMetadata)(nil),             // 21: database_monitoring.v1.ServerMetadata
+	(*v1.TargetCollectionConfig)(nil),     // 22: database_monitoring.v1.TargetCollectionConfig
+	(*v1.TargetCollectionStatus)(nil),     // 23: database_monitoring.v1.TargetCollectionStatus
+	(*v1.Deadlock)(nil),                   // 24: database_monitoring.v1.Deadlock
+	(*v1.Warning)(nil),                    // 25: database_monitoring.v1.Warning
+	(*v1.ExecutionPlan)(nil),              // 26: database_monitoring.v1.ExecutionPlan
+	(*v1.DBSnapshot)(nil),                 // 27: database_monitoring.v1.DBSnapshot
+	(*v1.QuerySample)(nil),                // 28: database_monitoring.v1.QuerySample
+	(*DatabaseMetrics)(nil),               // 29: database_monitoring.v1.DatabaseMetrics
 }
 var file_database_monitoring_v1_collector_collector_api_proto_depIdxs = []int32{
-	15, // 0: database_monitoring.v1.GetKnownWarningsRequest.server:type_name -> database_monitoring.v1.ServerMetadata
-	16, // 1: database_monitoring.v1.GetKnownWarningsResponse.warnings:type_name -> database_monitoring.v1.Warning
-	16, // 2: database_monitoring.v1.IngestWarningsRequest.warnings:type_name -> database_monitoring.v1.Warning
-	15, // 3: database_monitoring.v1.IngestWarningsRequest.server:type_name -> database_monitoring.v1.ServerMetadata
-	15, // 4: database_monitoring.v1.GetKnownPlanHandlesRequest.server:type_name -> database_monitoring.v1.ServerMetadata
-	17, // 5: database_monitoring.v1.IngestExecutionPlansRequest.plans:type_name -> database_monitoring.v1.ExecutionPlan
-	18, // 6: database_monitoring.v1.IngestSnapshotRequest.snapshot:type_name -> database_monitoring.v1.DBSnapshot
-	19, // 7: database_monitoring.v1.IngestSnapshotSamplesRequest.samples:type_name -> database_monitoring.v1.QuerySample
-	8,  // 8: database_monitoring.v1.IngestionService.RegisterAgent:input_type -> database_monitoring.v1.RegisterAgentRequest
-	20, // 9: database_monitoring.v1.IngestionService.IngestMetrics:input_type -> database_monitoring.v1.DatabaseMetrics
-	11, // 10: database_monitoring.v1.IngestionService.IngestSnapshot:input_type -> database_monitoring.v1.IngestSnapshotRequest
-	13, // 11: database_monitoring.v1.IngestionService.IngestSnapshotSamples:input_type -> database_monitoring.v1.IngestSnapshotSamplesRequest
-	6,  // 12: database_monitoring.v1.IngestionService.IngestExecutionPlans:input_type -> database_monitoring.v1.IngestExecutionPlansRequest
-	4,  // 13: database_monitoring.v1.IngestionService.GetKnownPlanHandles:input_type -> database_monitoring.v1.GetKnownPlanHandlesRequest
-	2,  // 14: database_monitoring.v1.IngestionService.IngestWarnings:input_type -> database_monitoring.v1.IngestWarningsRequest
-	0,  // 15: database_monitoring.v1.IngestionService.GetKnownWarnings:input_type -> database_monitoring.v1.GetKnownWarningsRequest
-	9,  // 16: database_monitoring.v1.IngestionService.RegisterAgent:output_type -> database_monitoring.v1.RegisterAgentResponse
-	10, // 17: database_monitoring.v1.IngestionService.IngestMetrics:output_type -> database_monitoring.v1.IngestMetricsResponse
-	12, // 18: database_monitoring.v1.IngestionService.IngestSnapshot:output_type -> database_monitoring.v1.IngestSnapshotResponse
-	14, // 19: database_monitoring.v1.IngestionService.IngestSnapshotSamples:output_type -> database_monitoring.v1.IngestSnapshotSamplesResponse
-	7,  // 20: database_monitoring.v1.IngestionService.IngestExecutionPlans:output_type -> database_monitoring.v1.IngestExecutionPlansResponse
-	5,  // 21: database_monitoring.v1.IngestionService.GetKnownPlanHandles:output_type -> database_monitoring.v1.GetKnownPlanHandlesResponse
-	3,  // 22: database_monitoring.v1.IngestionService.IngestWarnings:output_type -> database_monitoring.v1.IngestWarningsResponse
-	1,  // 23: database_monitoring.v1.IngestionService.GetKnownWarnings:output_type -> database_monitoring.v1.GetKnownWarningsResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	21, // 0: database_monitoring.v1.GetCollectionConfigRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	22, // 1: database_monitoring.v1.GetCollectionConfigResponse.config:type_name -> database_monitoring.v1.TargetCollectionConfig
+	21, // 2: database_monitoring.v1.HeartbeatRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	23, // 3: database_monitoring.v1.HeartbeatRequest.status:type_name -> database_monitoring.v1.TargetCollectionStatus
+	24, // 4: database_monitoring.v1.IngestDeadlocksRequest.deadlocks:type_name -> database_monitoring.v1.Deadlock
+	21, // 5: database_monitoring.v1.IngestDeadlocksRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	21, // 6: database_monitoring.v1.GetKnownWarningsRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	25, // 7: database_monitoring.v1.GetKnownWarningsResponse.warnings:type_name -> database_monitoring.v1.Warning
+	25, // 8: database_monitoring.v1.IngestWarningsRequest.warnings:type_name -> database_monitoring.v1.Warning
+	21, // 9: database_monitoring.v1.IngestWarningsRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	21, // 10: database_monitoring.v1.GetKnownPlanHandlesRequest.server:type_name -> database_monitoring.v1.ServerMetadata
+	26, // 11: database_monitoring.v1.IngestExecutionPlansRequest.plans:type_name -> database_monitoring.v1.ExecutionPlan
+	27, // 12: database_monitoring.v1.IngestSnapshotRequest.snapshot:type_name -> database_monitoring.v1.DBSnapshot
+	28, // 13: database_monitoring.v1.IngestSnapshotSamplesRequest.samples:type_name -> database_monitoring.v1.QuerySample
+	14, // 14: database_monitoring.v1.IngestionService.RegisterAgent:input_type -> database_monitoring.v1.RegisterAgentRequest
+	29, // 15: database_monitoring.v1.IngestionService.IngestMetrics:input_type -> database_monitoring.v1.DatabaseMetrics
+	17, // 16: database_monitoring.v1.IngestionService.IngestSnapshot:input_type -> database_monitoring.v1.IngestSnapshotRequest
+	19, // 17: database_monitoring.v1.IngestionService.IngestSnapshotSamples:input_type -> database_monitoring.v1.IngestSnapshotSamplesRequest
+	12, // 18: database_monitoring.v1.IngestionService.IngestExecutionPlans:input_type -> database_monitoring.v1.IngestExecutionPlansRequest
+	10, // 19: database_monitoring.v1.IngestionService.GetKnownPlanHandles:input_type -> database_monitoring.v1.GetKnownPlanHandlesRequest
+	8,  // 20: database_monitoring.v1.IngestionService.IngestWarnings:input_type -> database_monitoring.v1.IngestWarningsRequest
+	6,  // 21: database_monitoring.v1.IngestionService.GetKnownWarnings:input_type -> database_monitoring.v1.GetKnownWarningsRequest
+	4,  // 22: database_monitoring.v1.IngestionService.IngestDeadlocks:input_type -> database_monitoring.v1.IngestDeadlocksRequest
+	2,  // 23: database_monitoring.v1.IngestionService.Heartbeat:input_type -> database_monitoring.v1.HeartbeatRequest
+	0,  // 24: database_monitoring.v1.IngestionService.GetCollectionConfig:input_type -> database_monitoring.v1.GetCollectionConfigRequest
+	15, // 25: database_monitoring.v1.IngestionService.RegisterAgent:output_type -> database_monitoring.v1.RegisterAgentResponse
+	16, // 26: database_monitoring.v1.IngestionService.IngestMetrics:output_type -> database_monitoring.v1.IngestMetricsResponse
+	18, // 27: database_monitoring.v1.IngestionService.IngestSnapshot:output_type -> database_monitoring.v1.IngestSnapshotResponse
+	20, // 28: database_monitoring.v1.IngestionService.IngestSnapshotSamples:output_type -> database_monitoring.v1.IngestSnapshotSamplesResponse
+	13, // 29: database_monitoring.v1.IngestionService.IngestExecutionPlans:output_type -> database_monitoring.v1.IngestExecutionPlansResponse
+	11, // 30: database_monitoring.v1.IngestionService.GetKnownPlanHandles:output_type -> database_monitoring.v1.GetKnownPlanHandlesResponse
+	9,  // 31: database_monitoring.v1.IngestionService.IngestWarnings:output_type -> database_monitoring.v1.IngestWarningsResponse
+	7,  // 32: database_monitoring.v1.IngestionService.GetKnownWarnings:output_type -> database_monitoring.v1.GetKnownWarningsResponse
+	5,  // 33: database_monitoring.v1.IngestionService.IngestDeadlocks:output_type -> database_monitoring.v1.IngestDeadlocksResponse
+	3,  // 34: database_monitoring.v1.IngestionService.Heartbeat:output_type -> database_monitoring.v1.HeartbeatResponse
+	1,  // 35: database_monitoring.v1.IngestionService.GetCollectionConfig:output_type -> database_monitoring.v1.GetCollectionConfigResponse
+	25, // [25:36] is the sub-list for method output_type
+	14, // [14:25] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_collector_collector_api_proto_init() }
@@ -914,7 +1242,7 @@ func file_database_monitoring_v1_collector_collector_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_collector_collector_api_proto_rawDesc), len(file_database_monitoring_v1_collector_collector_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
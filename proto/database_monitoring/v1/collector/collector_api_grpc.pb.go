@@ -27,6 +27,9 @@ const (
 	IngestionService_GetKnownPlanHandles_FullMethodName   = "/database_monitoring.v1.IngestionService/GetKnownPlanHandles"
 	IngestionService_IngestWarnings_FullMethodName        = "/database_monitoring.v1.IngestionService/IngestWarnings"
 	IngestionService_GetKnownWarnings_FullMethodName      = "/database_monitoring.v1.IngestionService/GetKnownWarnings"
+	IngestionService_IngestDeadlocks_FullMethodName       = "/database_monitoring.v1.IngestionService/IngestDeadlocks"
+	IngestionService_Heartbeat_FullMethodName             = "/database_monitoring.v1.IngestionService/Heartbeat"
+	IngestionService_GetCollectionConfig_FullMethodName   = "/database_monitoring.v1.IngestionService/GetCollectionConfig"
 )
 
 // IngestionServiceClient is the client API for IngestionService service.
@@ -41,6 +44,9 @@ type IngestionServiceClient interface {
 	GetKnownPlanHandles(ctx context.Context, in *GetKnownPlanHandlesRequest, opts ...grpc.CallOption) (*GetKnownPlanHandlesResponse, error)
 	IngestWarnings(ctx context.Context, in *IngestWarningsRequest, opts ...grpc.CallOption) (*IngestWarningsResponse, error)
 	GetKnownWarnings(ctx context.Context, in *GetKnownWarningsRequest, opts ...grpc.CallOption) (*GetKnownWarningsResponse, error)
+	IngestDeadlocks(ctx context.Context, in *IngestDeadlocksRequest, opts ...grpc.CallOption) (*IngestDeadlocksResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	GetCollectionConfig(ctx context.Context, in *GetCollectionConfigRequest, opts ...grpc.CallOption) (*GetCollectionConfigResponse, error)
 }
 
 type ingestionServiceClient struct {
@@ -131,6 +137,36 @@ func (c *ingestionServiceClient) GetKnownWarnings(ctx context.Context, in *GetKn
 	return out, nil
 }
 
+func (c *ingestionServiceClient) IngestDeadlocks(ctx context.Context, in *IngestDeadlocksRequest, opts ...grpc.CallOption) (*IngestDeadlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestDeadlocksResponse)
+	err := c.cc.Invoke(ctx, IngestionService_IngestDeadlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, IngestionService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionServiceClient) GetCollectionConfig(ctx context.Context, in *GetCollectionConfigRequest, opts ...grpc.CallOption) (*GetCollectionConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionConfigResponse)
+	err := c.cc.Invoke(ctx, IngestionService_GetCollectionConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IngestionServiceServer is the server API for IngestionService service.
 // All implementations must embed UnimplementedIngestionServiceServer
 // for forward compatibility.
@@ -143,6 +179,9 @@ type IngestionServiceServer interface {
 	GetKnownPlanHandles(context.Context, *GetKnownPlanHandlesRequest) (*GetKnownPlanHandlesResponse, error)
 	IngestWarnings(context.Context, *IngestWarningsRequest) (*IngestWarningsResponse, error)
 	GetKnownWarnings(context.Context, *GetKnownWarningsRequest) (*GetKnownWarningsResponse, error)
+	IngestDeadlocks(context.Context, *IngestDeadlocksRequest) (*IngestDeadlocksResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	GetCollectionConfig(context.Context, *GetCollectionConfigRequest) (*GetCollectionConfigResponse, error)
 	mustEmbedUnimplementedIngestionServiceServer()
 }
 
@@ -177,6 +216,15 @@ func (UnimplementedIngestionServiceServer) IngestWarnings(context.Context, *Inge
 func (UnimplementedIngestionServiceServer) GetKnownWarnings(context.Context, *GetKnownWarningsRequest) (*GetKnownWarningsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetKnownWarnings not implemented")
 }
+func (UnimplementedIngestionServiceServer) IngestDeadlocks(context.Context, *IngestDeadlocksRequest) (*IngestDeadlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IngestDeadlocks not implemented")
+}
+func (UnimplementedIngestionServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedIngestionServiceServer) GetCollectionConfig(context.Context, *GetCollectionConfigRequest) (*GetCollectionConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollectionConfig not implemented")
+}
 func (UnimplementedIngestionServiceServer) mustEmbedUnimplementedIngestionServiceServer() {}
 func (UnimplementedIngestionServiceServer) testEmbeddedByValue()                          {}
 
@@ -342,6 +390,60 @@ func _IngestionService_GetKnownWarnings_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IngestionService_IngestDeadlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestDeadlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).IngestDeadlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_IngestDeadlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).IngestDeadlocks(ctx, req.(*IngestDeadlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IngestionService_GetCollectionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServiceServer).GetCollectionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IngestionService_GetCollectionConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServiceServer).GetCollectionConfig(ctx, req.(*GetCollectionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IngestionService_ServiceDesc is the grpc.ServiceDesc for IngestionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +483,18 @@ var IngestionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKnownWarnings",
 			Handler:    _IngestionService_GetKnownWarnings_Handler,
 		},
+		{
+			MethodName: "IngestDeadlocks",
+			Handler:    _IngestionService_IngestDeadlocks_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _IngestionService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "GetCollectionConfig",
+			Handler:    _IngestionService_GetCollectionConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "database_monitoring/v1/collector/collector_api.proto",
@@ -31,6 +31,10 @@ type DatabaseMetrics struct {
 	//
 	//	*DatabaseMetrics_QueryMetrics
 	//	*DatabaseMetrics_SystemMetrics
+	//	*DatabaseMetrics_IndexStats
+	//	*DatabaseMetrics_QueryStore
+	//	*DatabaseMetrics_Jobs
+	//	*DatabaseMetrics_FileSizes
 	Metrics       isDatabaseMetrics_Metrics `protobuf_oneof:"metrics"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -105,6 +109,42 @@ func (x *DatabaseMetrics) GetSystemMetrics() *SystemMetrics {
 	return nil
 }
 
+func (x *DatabaseMetrics) GetIndexStats() *DatabaseMetrics_IndexStatsSample {
+	if x != nil {
+		if x, ok := x.Metrics.(*DatabaseMetrics_IndexStats); ok {
+			return x.IndexStats
+		}
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics) GetQueryStore() *DatabaseMetrics_QueryStoreSample {
+	if x != nil {
+		if x, ok := x.Metrics.(*DatabaseMetrics_QueryStore); ok {
+			return x.QueryStore
+		}
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics) GetJobs() *DatabaseMetrics_JobSample {
+	if x != nil {
+		if x, ok := x.Metrics.(*DatabaseMetrics_Jobs); ok {
+			return x.Jobs
+		}
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics) GetFileSizes() *DatabaseMetrics_FileSizeSample {
+	if x != nil {
+		if x, ok := x.Metrics.(*DatabaseMetrics_FileSizes); ok {
+			return x.FileSizes
+		}
+	}
+	return nil
+}
+
 type isDatabaseMetrics_Metrics interface {
 	isDatabaseMetrics_Metrics()
 }
@@ -117,10 +157,34 @@ type DatabaseMetrics_SystemMetrics struct {
 	SystemMetrics *SystemMetrics `protobuf:"bytes,4,opt,name=system_metrics,json=systemMetrics,proto3,oneof"`
 }
 
+type DatabaseMetrics_IndexStats struct {
+	IndexStats *DatabaseMetrics_IndexStatsSample `protobuf:"bytes,5,opt,name=index_stats,json=indexStats,proto3,oneof"`
+}
+
+type DatabaseMetrics_QueryStore struct {
+	QueryStore *DatabaseMetrics_QueryStoreSample `protobuf:"bytes,6,opt,name=query_store,json=queryStore,proto3,oneof"`
+}
+
+type DatabaseMetrics_Jobs struct {
+	Jobs *DatabaseMetrics_JobSample `protobuf:"bytes,7,opt,name=jobs,proto3,oneof"`
+}
+
+type DatabaseMetrics_FileSizes struct {
+	FileSizes *DatabaseMetrics_FileSizeSample `protobuf:"bytes,8,opt,name=file_sizes,json=fileSizes,proto3,oneof"`
+}
+
 func (*DatabaseMetrics_QueryMetrics) isDatabaseMetrics_Metrics() {}
 
 func (*DatabaseMetrics_SystemMetrics) isDatabaseMetrics_Metrics() {}
 
+func (*DatabaseMetrics_IndexStats) isDatabaseMetrics_Metrics() {}
+
+func (*DatabaseMetrics_QueryStore) isDatabaseMetrics_Metrics() {}
+
+func (*DatabaseMetrics_Jobs) isDatabaseMetrics_Metrics() {}
+
+func (*DatabaseMetrics_FileSizes) isDatabaseMetrics_Metrics() {}
+
 type SystemMetrics struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	CpuUsage          float64                `protobuf:"fixed64,1,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
@@ -129,8 +193,14 @@ type SystemMetrics struct {
 	DiskIoRate        float64                `protobuf:"fixed64,4,opt,name=disk_io_rate,json=diskIoRate,proto3" json:"disk_io_rate,omitempty"`
 	NetworkIoRate     float64                `protobuf:"fixed64,5,opt,name=network_io_rate,json=networkIoRate,proto3" json:"network_io_rate,omitempty"`
 	Counters          []*PerformanceCounters `protobuf:"bytes,6,rep,name=counters,proto3" json:"counters,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// wait_stats are the deltas since the previous metrics interval
+	WaitStats []*WaitStatDelta `protobuf:"bytes,7,rep,name=wait_stats,json=waitStats,proto3" json:"wait_stats,omitempty"`
+	// file_io are the dm_io_virtual_file_stats deltas since the previous metrics interval
+	FileIo []*FileIOStatDelta `protobuf:"bytes,8,rep,name=file_io,json=fileIo,proto3" json:"file_io,omitempty"`
+	// replicas are the availability group replica states read at the end of the metrics interval
+	Replicas      []*v1.ReplicaState `protobuf:"bytes,9,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SystemMetrics) Reset() {
@@ -205,6 +275,239 @@ func (x *SystemMetrics) GetCounters() []*PerformanceCounters {
 	return nil
 }
 
+func (x *SystemMetrics) GetWaitStats() []*WaitStatDelta {
+	if x != nil {
+		return x.WaitStats
+	}
+	return nil
+}
+
+func (x *SystemMetrics) GetFileIo() []*FileIOStatDelta {
+	if x != nil {
+		return x.FileIo
+	}
+	return nil
+}
+
+func (x *SystemMetrics) GetReplicas() []*v1.ReplicaState {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+type FileIOStatDelta struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	FileId       int64                  `protobuf:"varint,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	LogicalName  string                 `protobuf:"bytes,3,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	PhysicalName string                 `protobuf:"bytes,4,opt,name=physical_name,json=physicalName,proto3" json:"physical_name,omitempty"`
+	// file_type is ROWS or LOG
+	FileType      string `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	IntervalMs    int64  `protobuf:"varint,6,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	Reads         int64  `protobuf:"varint,7,opt,name=reads,proto3" json:"reads,omitempty"`
+	BytesRead     int64  `protobuf:"varint,8,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	ReadStallMs   int64  `protobuf:"varint,9,opt,name=read_stall_ms,json=readStallMs,proto3" json:"read_stall_ms,omitempty"`
+	Writes        int64  `protobuf:"varint,10,opt,name=writes,proto3" json:"writes,omitempty"`
+	BytesWritten  int64  `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	WriteStallMs  int64  `protobuf:"varint,12,opt,name=write_stall_ms,json=writeStallMs,proto3" json:"write_stall_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileIOStatDelta) Reset() {
+	*x = FileIOStatDelta{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileIOStatDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIOStatDelta) ProtoMessage() {}
+
+func (x *FileIOStatDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIOStatDelta.ProtoReflect.Descriptor instead.
+func (*FileIOStatDelta) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FileIOStatDelta) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *FileIOStatDelta) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetLogicalName() string {
+	if x != nil {
+		return x.LogicalName
+	}
+	return ""
+}
+
+func (x *FileIOStatDelta) GetPhysicalName() string {
+	if x != nil {
+		return x.PhysicalName
+	}
+	return ""
+}
+
+func (x *FileIOStatDelta) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *FileIOStatDelta) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetReads() int64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetBytesRead() int64 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetReadStallMs() int64 {
+	if x != nil {
+		return x.ReadStallMs
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *FileIOStatDelta) GetWriteStallMs() int64 {
+	if x != nil {
+		return x.WriteStallMs
+	}
+	return 0
+}
+
+type WaitStatDelta struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WaitType         string                 `protobuf:"bytes,1,opt,name=wait_type,json=waitType,proto3" json:"wait_type,omitempty"`
+	WaitingTasks     int64                  `protobuf:"varint,2,opt,name=waiting_tasks,json=waitingTasks,proto3" json:"waiting_tasks,omitempty"`
+	WaitTimeMs       int64                  `protobuf:"varint,3,opt,name=wait_time_ms,json=waitTimeMs,proto3" json:"wait_time_ms,omitempty"`
+	SignalWaitTimeMs int64                  `protobuf:"varint,4,opt,name=signal_wait_time_ms,json=signalWaitTimeMs,proto3" json:"signal_wait_time_ms,omitempty"`
+	// max_wait_time_ms is the max since the stats were last reset, it is not a delta
+	MaxWaitTimeMs int64 `protobuf:"varint,5,opt,name=max_wait_time_ms,json=maxWaitTimeMs,proto3" json:"max_wait_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitStatDelta) Reset() {
+	*x = WaitStatDelta{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitStatDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitStatDelta) ProtoMessage() {}
+
+func (x *WaitStatDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitStatDelta.ProtoReflect.Descriptor instead.
+func (*WaitStatDelta) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WaitStatDelta) GetWaitType() string {
+	if x != nil {
+		return x.WaitType
+	}
+	return ""
+}
+
+func (x *WaitStatDelta) GetWaitingTasks() int64 {
+	if x != nil {
+		return x.WaitingTasks
+	}
+	return 0
+}
+
+func (x *WaitStatDelta) GetWaitTimeMs() int64 {
+	if x != nil {
+		return x.WaitTimeMs
+	}
+	return 0
+}
+
+func (x *WaitStatDelta) GetSignalWaitTimeMs() int64 {
+	if x != nil {
+		return x.SignalWaitTimeMs
+	}
+	return 0
+}
+
+func (x *WaitStatDelta) GetMaxWaitTimeMs() int64 {
+	if x != nil {
+		return x.MaxWaitTimeMs
+	}
+	return 0
+}
+
+// PerformanceCounters is a dm_os_performance_counters reading, counter_rate is set for the per second counters and
+// counter_value is then the increase since the previous metrics interval
 type PerformanceCounters struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CounterName   string                 `protobuf:"bytes,1,opt,name=counter_name,json=counterName,proto3" json:"counter_name,omitempty"`
@@ -216,7 +519,7 @@ type PerformanceCounters struct {
 
 func (x *PerformanceCounters) Reset() {
 	*x = PerformanceCounters{}
-	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +531,7 @@ func (x *PerformanceCounters) String() string {
 func (*PerformanceCounters) ProtoMessage() {}
 
 func (x *PerformanceCounters) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +544,7 @@ func (x *PerformanceCounters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PerformanceCounters.ProtoReflect.Descriptor instead.
 func (*PerformanceCounters) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{2}
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PerformanceCounters) GetCounterName() string {
@@ -274,7 +577,7 @@ type DatabaseMetrics_QueryMetricSample struct {
 
 func (x *DatabaseMetrics_QueryMetricSample) Reset() {
 	*x = DatabaseMetrics_QueryMetricSample{}
-	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +589,7 @@ func (x *DatabaseMetrics_QueryMetricSample) String() string {
 func (*DatabaseMetrics_QueryMetricSample) ProtoMessage() {}
 
 func (x *DatabaseMetrics_QueryMetricSample) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,19 +612,240 @@ func (x *DatabaseMetrics_QueryMetricSample) GetQueryMetrics() []*v1.QueryMetric
 	return nil
 }
 
+type DatabaseMetrics_IndexStatsSample struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Indexes        []*v1.IndexUsage       `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	MissingIndexes []*v1.MissingIndex     `protobuf:"bytes,2,rep,name=missing_indexes,json=missingIndexes,proto3" json:"missing_indexes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatabaseMetrics_IndexStatsSample) Reset() {
+	*x = DatabaseMetrics_IndexStatsSample{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseMetrics_IndexStatsSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseMetrics_IndexStatsSample) ProtoMessage() {}
+
+func (x *DatabaseMetrics_IndexStatsSample) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseMetrics_IndexStatsSample.ProtoReflect.Descriptor instead.
+func (*DatabaseMetrics_IndexStatsSample) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *DatabaseMetrics_IndexStatsSample) GetIndexes() []*v1.IndexUsage {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics_IndexStatsSample) GetMissingIndexes() []*v1.MissingIndex {
+	if x != nil {
+		return x.MissingIndexes
+	}
+	return nil
+}
+
+type DatabaseMetrics_QueryStoreSample struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Plans         []*v1.QueryStorePlan         `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	RuntimeStats  []*v1.QueryStoreRuntimeStats `protobuf:"bytes,2,rep,name=runtime_stats,json=runtimeStats,proto3" json:"runtime_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseMetrics_QueryStoreSample) Reset() {
+	*x = DatabaseMetrics_QueryStoreSample{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseMetrics_QueryStoreSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseMetrics_QueryStoreSample) ProtoMessage() {}
+
+func (x *DatabaseMetrics_QueryStoreSample) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseMetrics_QueryStoreSample.ProtoReflect.Descriptor instead.
+func (*DatabaseMetrics_QueryStoreSample) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *DatabaseMetrics_QueryStoreSample) GetPlans() []*v1.QueryStorePlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics_QueryStoreSample) GetRuntimeStats() []*v1.QueryStoreRuntimeStats {
+	if x != nil {
+		return x.RuntimeStats
+	}
+	return nil
+}
+
+type DatabaseMetrics_JobSample struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// runs are the runs finished since the previous read and the runs in progress
+	Runs          []*v1.JobRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseMetrics_JobSample) Reset() {
+	*x = DatabaseMetrics_JobSample{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseMetrics_JobSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseMetrics_JobSample) ProtoMessage() {}
+
+func (x *DatabaseMetrics_JobSample) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseMetrics_JobSample.ProtoReflect.Descriptor instead.
+func (*DatabaseMetrics_JobSample) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *DatabaseMetrics_JobSample) GetRuns() []*v1.JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// FileSizeSample holds the size of the database files and the autogrowth events logged since the previous read
+type DatabaseMetrics_FileSizeSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*v1.DatabaseFileSize `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	GrowthEvents  []*v1.AutogrowthEvent  `protobuf:"bytes,2,rep,name=growth_events,json=growthEvents,proto3" json:"growth_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseMetrics_FileSizeSample) Reset() {
+	*x = DatabaseMetrics_FileSizeSample{}
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseMetrics_FileSizeSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseMetrics_FileSizeSample) ProtoMessage() {}
+
+func (x *DatabaseMetrics_FileSizeSample) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_collector_metrics_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseMetrics_FileSizeSample.ProtoReflect.Descriptor instead.
+func (*DatabaseMetrics_FileSizeSample) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *DatabaseMetrics_FileSizeSample) GetFiles() []*v1.DatabaseFileSize {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *DatabaseMetrics_FileSizeSample) GetGrowthEvents() []*v1.AutogrowthEvent {
+	if x != nil {
+		return x.GrowthEvents
+	}
+	return nil
+}
+
 var File_database_monitoring_v1_collector_metrics_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_collector_metrics_proto_rawDesc = "" +
 	"\n" +
-	".database_monitoring/v1/collector/metrics.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#database_monitoring/v1/sample.proto\x1a%database_monitoring/v1/snapshot.proto\"\xa7\x03\n" +
+	".database_monitoring/v1/collector/metrics.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#database_monitoring/v1/sample.proto\x1a%database_monitoring/v1/snapshot.proto\x1a(database_monitoring/v1/index_stats.proto\x1a(database_monitoring/v1/query_store.proto\x1a database_monitoring/v1/job.proto\x1a/database_monitoring/v1/availability_group.proto\x1a&database_monitoring/v1/file_size.proto\"\xaf\n" +
+	"\n" +
 	"\x0fDatabaseMetrics\x12>\n" +
 	"\x06server\x18\x01 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12`\n" +
 	"\rquery_metrics\x18\x03 \x01(\v29.database_monitoring.v1.DatabaseMetrics.QueryMetricSampleH\x00R\fqueryMetrics\x12N\n" +
-	"\x0esystem_metrics\x18\x04 \x01(\v2%.database_monitoring.v1.SystemMetricsH\x00R\rsystemMetrics\x1a]\n" +
+	"\x0esystem_metrics\x18\x04 \x01(\v2%.database_monitoring.v1.SystemMetricsH\x00R\rsystemMetrics\x12[\n" +
+	"\vindex_stats\x18\x05 \x01(\v28.database_monitoring.v1.DatabaseMetrics.IndexStatsSampleH\x00R\n" +
+	"indexStats\x12[\n" +
+	"\vquery_store\x18\x06 \x01(\v28.database_monitoring.v1.DatabaseMetrics.QueryStoreSampleH\x00R\n" +
+	"queryStore\x12G\n" +
+	"\x04jobs\x18\a \x01(\v21.database_monitoring.v1.DatabaseMetrics.JobSampleH\x00R\x04jobs\x12W\n" +
+	"\n" +
+	"file_sizes\x18\b \x01(\v26.database_monitoring.v1.DatabaseMetrics.FileSizeSampleH\x00R\tfileSizes\x1a]\n" +
 	"\x11QueryMetricSample\x12H\n" +
-	"\rquery_metrics\x18\x01 \x03(\v2#.database_monitoring.v1.QueryMetricR\fqueryMetricsB\t\n" +
-	"\ametrics\"\x91\x02\n" +
+	"\rquery_metrics\x18\x01 \x03(\v2#.database_monitoring.v1.QueryMetricR\fqueryMetrics\x1a\x9f\x01\n" +
+	"\x10IndexStatsSample\x12<\n" +
+	"\aindexes\x18\x01 \x03(\v2\".database_monitoring.v1.IndexUsageR\aindexes\x12M\n" +
+	"\x0fmissing_indexes\x18\x02 \x03(\v2$.database_monitoring.v1.MissingIndexR\x0emissingIndexes\x1a\xa5\x01\n" +
+	"\x10QueryStoreSample\x12<\n" +
+	"\x05plans\x18\x01 \x03(\v2&.database_monitoring.v1.QueryStorePlanR\x05plans\x12S\n" +
+	"\rruntime_stats\x18\x02 \x03(\v2..database_monitoring.v1.QueryStoreRuntimeStatsR\fruntimeStats\x1a?\n" +
+	"\tJobSample\x122\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1e.database_monitoring.v1.JobRunR\x04runs\x1a\x9e\x01\n" +
+	"\x0eFileSizeSample\x12>\n" +
+	"\x05files\x18\x01 \x03(\v2(.database_monitoring.v1.DatabaseFileSizeR\x05files\x12L\n" +
+	"\rgrowth_events\x18\x02 \x03(\v2'.database_monitoring.v1.AutogrowthEventR\fgrowthEventsB\t\n" +
+	"\ametrics\"\xdb\x03\n" +
 	"\rSystemMetrics\x12\x1b\n" +
 	"\tcpu_usage\x18\x01 \x01(\x01R\bcpuUsage\x12!\n" +
 	"\fmemory_usage\x18\x02 \x01(\x01R\vmemoryUsage\x12-\n" +
@@ -329,7 +853,34 @@ const file_database_monitoring_v1_collector_metrics_proto_rawDesc = "" +
 	"\fdisk_io_rate\x18\x04 \x01(\x01R\n" +
 	"diskIoRate\x12&\n" +
 	"\x0fnetwork_io_rate\x18\x05 \x01(\x01R\rnetworkIoRate\x12G\n" +
-	"\bcounters\x18\x06 \x03(\v2+.database_monitoring.v1.PerformanceCountersR\bcounters\"\x80\x01\n" +
+	"\bcounters\x18\x06 \x03(\v2+.database_monitoring.v1.PerformanceCountersR\bcounters\x12D\n" +
+	"\n" +
+	"wait_stats\x18\a \x03(\v2%.database_monitoring.v1.WaitStatDeltaR\twaitStats\x12@\n" +
+	"\afile_io\x18\b \x03(\v2'.database_monitoring.v1.FileIOStatDeltaR\x06fileIo\x12@\n" +
+	"\breplicas\x18\t \x03(\v2$.database_monitoring.v1.ReplicaStateR\breplicas\"\x91\x03\n" +
+	"\x0fFileIOStatDelta\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\x03R\x06fileId\x12!\n" +
+	"\flogical_name\x18\x03 \x01(\tR\vlogicalName\x12#\n" +
+	"\rphysical_name\x18\x04 \x01(\tR\fphysicalName\x12\x1b\n" +
+	"\tfile_type\x18\x05 \x01(\tR\bfileType\x12\x1f\n" +
+	"\vinterval_ms\x18\x06 \x01(\x03R\n" +
+	"intervalMs\x12\x14\n" +
+	"\x05reads\x18\a \x01(\x03R\x05reads\x12\x1d\n" +
+	"\n" +
+	"bytes_read\x18\b \x01(\x03R\tbytesRead\x12\"\n" +
+	"\rread_stall_ms\x18\t \x01(\x03R\vreadStallMs\x12\x16\n" +
+	"\x06writes\x18\n" +
+	" \x01(\x03R\x06writes\x12#\n" +
+	"\rbytes_written\x18\v \x01(\x03R\fbytesWritten\x12$\n" +
+	"\x0ewrite_stall_ms\x18\f \x01(\x03R\fwriteStallMs\"\xcb\x01\n" +
+	"\rWaitStatDelta\x12\x1b\n" +
+	"\twait_type\x18\x01 \x01(\tR\bwaitType\x12#\n" +
+	"\rwaiting_tasks\x18\x02 \x01(\x03R\fwaitingTasks\x12 \n" +
+	"\fwait_time_ms\x18\x03 \x01(\x03R\n" +
+	"waitTimeMs\x12-\n" +
+	"\x13signal_wait_time_ms\x18\x04 \x01(\x03R\x10signalWaitTimeMs\x12'\n" +
+	"\x10max_wait_time_ms\x18\x05 \x01(\x03R\rmaxWaitTimeMs\"\x80\x01\n" +
 	"\x13PerformanceCounters\x12!\n" +
 	"\fcounter_name\x18\x01 \x01(\tR\vcounterName\x12#\n" +
 	"\rcounter_value\x18\x02 \x01(\x03R\fcounterValue\x12!\n" +
@@ -347,28 +898,56 @@ func file_database_monitoring_v1_collector_metrics_proto_rawDescGZIP() []byte {
 	return file_database_monitoring_v1_collector_metrics_proto_rawDescData
 }
 
-var file_database_monitoring_v1_collector_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_database_monitoring_v1_collector_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_database_monitoring_v1_collector_metrics_proto_goTypes = []any{
 	(*DatabaseMetrics)(nil),                   // 0: database_monitoring.v1.DatabaseMetrics
 	(*SystemMetrics)(nil),                     // 1: database_monitoring.v1.SystemMetrics
-	(*PerformanceCounters)(nil),               // 2: database_monitoring.v1.PerformanceCounters
-	(*DatabaseMetrics_QueryMetricSample)(nil), // 3: database_monitoring.v1.DatabaseMetrics.QueryMetricSample
-	(*v1.ServerMetadata)(nil),                 // 4: database_monitoring.v1.ServerMetadata
-	(*timestamp.Timestamp)(nil),               // 5: google.protobuf.Timestamp
-	(*v1.QueryMetric)(nil),                    // 6: database_monitoring.v1.QueryMetric
+	(*FileIOStatDelta)(nil),                   // 2: database_monitoring.v1.FileIOStatDelta
+	(*WaitStatDelta)(nil),                     // 3: database_monitoring.v1.WaitStatDelta
+	(*PerformanceCounters)(nil),               // 4: database_monitoring.v1.PerformanceCounters
+	(*DatabaseMetrics_QueryMetricSample)(nil), // 5: database_monitoring.v1.DatabaseMetrics.QueryMetricSample
+	(*DatabaseMetrics_IndexStatsSample)(nil),  // 6: database_monitoring.v1.DatabaseMetrics.IndexStatsSample
+	(*DatabaseMetrics_QueryStoreSample)(nil),  // 7: database_monitoring.v1.DatabaseMetrics.QueryStoreSample
+	(*DatabaseMetrics_JobSample)(nil),         // 8: database_monitoring.v1.DatabaseMetrics.JobSample
+	(*DatabaseMetrics_FileSizeSample)(nil),    // 9: database_monitoring.v1.DatabaseMetrics.FileSizeSample
+	(*v1.ServerMetadata)(nil),                 // 10: database_monitoring.v1.ServerMetadata
+	(*timestamp.Timestamp)(nil),               // 11: google.protobuf.Timestamp
+	(*v1.ReplicaState)(nil),                   // 12: database_monitoring.v1.ReplicaState
+	(*v1.QueryMetric)(nil),                    // 13: database_monitoring.v1.QueryMetric
+	(*v1.IndexUsage)(nil),                     // 14: database_monitoring.v1.IndexUsage
+	(*v1.MissingIndex)(nil),                   // 15: database_monitoring.v1.MissingIndex
+	(*v1.QueryStorePlan)(nil),                 // 16: database_monitoring.v1.QueryStorePlan
+	(*v1.QueryStoreRuntimeStats)(nil),         // 17: database_monitoring.v1.QueryStoreRuntimeStats
+	(*v1.JobRun)(nil),                         // 18: database_monitoring.v1.JobRun
+	(*v1.DatabaseFileSize)(nil),               // 19: database_monitoring.v1.DatabaseFileSize
+	(*v1.AutogrowthEvent)(nil),                // 20: database_monitoring.v1.AutogrowthEvent
 }
 var file_database_monitoring_v1_collector_metrics_proto_depIdxs = []int32{
-	4, // 0: database_monitoring.v1.DatabaseMetrics.server:type_name -> database_monitoring.v1.ServerMetadata
-	5, // 1: database_monitoring.v1.DatabaseMetrics.timestamp:type_name -> google.protobuf.Timestamp
-	3, // 2: database_monitoring.v1.DatabaseMetrics.query_metrics:type_name -> database_monitoring.v1.DatabaseMetrics.QueryMetricSample
-	1, // 3: database_monitoring.v1.DatabaseMetrics.system_metrics:type_name -> database_monitoring.v1.SystemMetrics
-	2, // 4: database_monitoring.v1.SystemMetrics.counters:type_name -> database_monitoring.v1.PerformanceCounters
-	6, // 5: database_monitoring.v1.DatabaseMetrics.QueryMetricSample.query_metrics:type_name -> database_monitoring.v1.QueryMetric
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	10, // 0: database_monitoring.v1.DatabaseMetrics.server:type_name -> database_monitoring.v1.ServerMetadata
+	11, // 1: database_monitoring.v1.DatabaseMetrics.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 2: database_monitoring.v1.DatabaseMetrics.query_metrics:type_name -> database_monitoring.v1.DatabaseMetrics.QueryMetricSample
+	1,  // 3: database_monitoring.v1.DatabaseMetrics.system_metrics:type_name -> database_monitoring.v1.SystemMetrics
+	6,  // 4: database_monitoring.v1.DatabaseMetrics.index_stats:type_name -> database_monitoring.v1.DatabaseMetrics.IndexStatsSample
+	7,  // 5: database_monitoring.v1.DatabaseMetrics.query_store:type_name -> database_monitoring.v1.DatabaseMetrics.QueryStoreSample
+	8,  // 6: database_monitoring.v1.DatabaseMetrics.jobs:type_name -> database_monitoring.v1.DatabaseMetrics.JobSample
+	9,  // 7: database_monitoring.v1.DatabaseMetrics.file_sizes:type_name -> database_monitoring.v1.DatabaseMetrics.FileSizeSample
+	4,  // 8: database_monitoring.v1.SystemMetrics.counters:type_name -> database_monitoring.v1.PerformanceCounters
+	3,  // 9: database_monitoring.v1.SystemMetrics.wait_stats:type_name -> database_monitoring.v1.WaitStatDelta
+	2,  // 10: database_monitoring.v1.SystemMetrics.file_io:type_name -> database_monitoring.v1.FileIOStatDelta
+	12, // 11: database_monitoring.v1.SystemMetrics.replicas:type_name -> database_monitoring.v1.ReplicaState
+	13, // 12: database_monitoring.v1.DatabaseMetrics.QueryMetricSample.query_metrics:type_name -> database_monitoring.v1.QueryMetric
+	14, // 13: database_monitoring.v1.DatabaseMetrics.IndexStatsSample.indexes:type_name -> database_monitoring.v1.IndexUsage
+	15, // 14: database_monitoring.v1.DatabaseMetrics.IndexStatsSample.missing_indexes:type_name -> database_monitoring.v1.MissingIndex
+	16, // 15: database_monitoring.v1.DatabaseMetrics.QueryStoreSample.plans:type_name -> database_monitoring.v1.QueryStorePlan
+	17, // 16: database_monitoring.v1.DatabaseMetrics.QueryStoreSample.runtime_stats:type_name -> database_monitoring.v1.QueryStoreRuntimeStats
+	18, // 17: database_monitoring.v1.DatabaseMetrics.JobSample.runs:type_name -> database_monitoring.v1.JobRun
+	19, // 18: database_monitoring.v1.DatabaseMetrics.FileSizeSample.files:type_name -> database_monitoring.v1.DatabaseFileSize
+	20, // 19: database_monitoring.v1.DatabaseMetrics.FileSizeSample.growth_events:type_name -> database_monitoring.v1.AutogrowthEvent
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_collector_metrics_proto_init() }
@@ -379,6 +958,10 @@ func file_database_monitoring_v1_collector_metrics_proto_init() {
 	file_database_monitoring_v1_collector_metrics_proto_msgTypes[0].OneofWrappers = []any{
 		(*DatabaseMetrics_QueryMetrics)(nil),
 		(*DatabaseMetrics_SystemMetrics)(nil),
+		(*DatabaseMetrics_IndexStats)(nil),
+		(*DatabaseMetrics_QueryStore)(nil),
+		(*DatabaseMetrics_Jobs)(nil),
+		(*DatabaseMetrics_FileSizes)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -386,7 +969,7 @@ func file_database_monitoring_v1_collector_metrics_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_collector_metrics_proto_rawDesc), len(file_database_monitoring_v1_collector_metrics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1207,29 +1207,1514 @@ func (x *BlockChain) GetRoots() []*BlockChain_BlockingNode {
 }
 
 type GetSampleDetailsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	QuerySample *QuerySample           `protobuf:"bytes,1,opt,name=query_sample,json=querySample,proto3" json:"query_sample,omitempty"`
+	ParsedPlan  *ParsedExecutionPlan   `protobuf:"bytes,2,opt,name=parsed_plan,json=parsedPlan,proto3" json:"parsed_plan,omitempty"`
+	BlockChain  *BlockChain            `protobuf:"bytes,3,opt,name=block_chain,json=blockChain,proto3" json:"block_chain,omitempty"`
+	// lock_conflict is the lock the sample waits for and the incompatible locks of its blocker, unset when not blocked
+	LockConflict  *LockConflict `protobuf:"bytes,4,opt,name=lock_conflict,json=lockConflict,proto3" json:"lock_conflict,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSampleDetailsResponse) Reset() {
+	*x = GetSampleDetailsResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSampleDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSampleDetailsResponse) ProtoMessage() {}
+
+func (x *GetSampleDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSampleDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetSampleDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSampleDetailsResponse) GetQuerySample() *QuerySample {
+	if x != nil {
+		return x.QuerySample
+	}
+	return nil
+}
+
+func (x *GetSampleDetailsResponse) GetParsedPlan() *ParsedExecutionPlan {
+	if x != nil {
+		return x.ParsedPlan
+	}
+	return nil
+}
+
+func (x *GetSampleDetailsResponse) GetBlockChain() *BlockChain {
+	if x != nil {
+		return x.BlockChain
+	}
+	return nil
+}
+
+func (x *GetSampleDetailsResponse) GetLockConflict() *LockConflict {
+	if x != nil {
+		return x.LockConflict
+	}
+	return nil
+}
+
+type GetNormalizedQueryDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueryHash     string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNormalizedQueryDetailsRequest) Reset() {
+	*x = GetNormalizedQueryDetailsRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNormalizedQueryDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNormalizedQueryDetailsRequest) ProtoMessage() {}
+
+func (x *GetNormalizedQueryDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNormalizedQueryDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetNormalizedQueryDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetNormalizedQueryDetailsRequest) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
+func (x *GetNormalizedQueryDetailsRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetNormalizedQueryDetailsRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetNormalizedQueryDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNormalizedQueryDetailsResponse) Reset() {
+	*x = GetNormalizedQueryDetailsResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNormalizedQueryDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNormalizedQueryDetailsResponse) ProtoMessage() {}
+
+func (x *GetNormalizedQueryDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNormalizedQueryDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetNormalizedQueryDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{22}
+}
+
+type GetNormalizedQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueryHash     string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNormalizedQueryRequest) Reset() {
+	*x = GetNormalizedQueryRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNormalizedQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNormalizedQueryRequest) ProtoMessage() {}
+
+func (x *GetNormalizedQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNormalizedQueryRequest.ProtoReflect.Descriptor instead.
+func (*GetNormalizedQueryRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetNormalizedQueryRequest) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
+func (x *GetNormalizedQueryRequest) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetNormalizedQueryRequest) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetNormalizedQueryResponse struct {
+	state               protoimpl.MessageState                             `protogen:"open.v1"`
+	ConnectionsOverTime []*GetNormalizedQueryResponse_ConnectionsDataPoint `protobuf:"bytes,1,rep,name=connections_over_time,json=connectionsOverTime,proto3" json:"connections_over_time,omitempty"`
+	ExecutionPlans      []*GetNormalizedQueryResponse_ExecutionPlanUsage   `protobuf:"bytes,2,rep,name=execution_plans,json=executionPlans,proto3" json:"execution_plans,omitempty"`
+	QueryMetrics        []*QueryMetric                                     `protobuf:"bytes,3,rep,name=query_metrics,json=queryMetrics,proto3" json:"query_metrics,omitempty"`
+	BlockingActivity    []*BlockChain                                      `protobuf:"bytes,4,rep,name=blocking_activity,json=blockingActivity,proto3" json:"blocking_activity,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetNormalizedQueryResponse) Reset() {
+	*x = GetNormalizedQueryResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNormalizedQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNormalizedQueryResponse) ProtoMessage() {}
+
+func (x *GetNormalizedQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNormalizedQueryResponse.ProtoReflect.Descriptor instead.
+func (*GetNormalizedQueryResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetNormalizedQueryResponse) GetConnectionsOverTime() []*GetNormalizedQueryResponse_ConnectionsDataPoint {
+	if x != nil {
+		return x.ConnectionsOverTime
+	}
+	return nil
+}
+
+func (x *GetNormalizedQueryResponse) GetExecutionPlans() []*GetNormalizedQueryResponse_ExecutionPlanUsage {
+	if x != nil {
+		return x.ExecutionPlans
+	}
+	return nil
+}
+
+func (x *GetNormalizedQueryResponse) GetQueryMetrics() []*QueryMetric {
+	if x != nil {
+		return x.QueryMetrics
+	}
+	return nil
+}
+
+func (x *GetNormalizedQueryResponse) GetBlockingActivity() []*BlockChain {
+	if x != nil {
+		return x.BlockingActivity
+	}
+	return nil
+}
+
+type ListDeadlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadlocksRequest) Reset() {
+	*x = ListDeadlocksRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadlocksRequest) ProtoMessage() {}
+
+func (x *ListDeadlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadlocksRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDeadlocksRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListDeadlocksRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ListDeadlocksRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ListDeadlocksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadlocksRequest) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type ListDeadlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deadlocks     []*Deadlock            `protobuf:"bytes,1,rep,name=deadlocks,proto3" json:"deadlocks,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadlocksResponse) Reset() {
+	*x = ListDeadlocksResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadlocksResponse) ProtoMessage() {}
+
+func (x *ListDeadlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadlocksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadlocksResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDeadlocksResponse) GetDeadlocks() []*Deadlock {
+	if x != nil {
+		return x.Deadlocks
+	}
+	return nil
+}
+
+func (x *ListDeadlocksResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListDeadlocksResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetDeadlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadlockRequest) Reset() {
+	*x = GetDeadlockRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadlockRequest) ProtoMessage() {}
+
+func (x *GetDeadlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadlockRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadlockRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDeadlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDeadlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deadlock      *Deadlock              `protobuf:"bytes,1,opt,name=deadlock,proto3" json:"deadlock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeadlockResponse) Reset() {
+	*x = GetDeadlockResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeadlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeadlockResponse) ProtoMessage() {}
+
+func (x *GetDeadlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeadlockResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadlockResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDeadlockResponse) GetDeadlock() *Deadlock {
+	if x != nil {
+		return x.Deadlock
+	}
+	return nil
+}
+
+type ListAgentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsRequest) Reset() {
+	*x = ListAgentsRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsRequest) ProtoMessage() {}
+
+func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{29}
+}
+
+type ListAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsResponse) Reset() {
+	*x = ListAgentsResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsResponse) ProtoMessage() {}
+
+func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAgentsResponse) GetAgents() []*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type GetAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentRequest) Reset() {
+	*x = GetAgentRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentRequest) ProtoMessage() {}
+
+func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAgentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentResponse) Reset() {
+	*x = GetAgentResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentResponse) ProtoMessage() {}
+
+func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAgentResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+type GetTargetCollectionConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetCollectionConfigRequest) Reset() {
+	*x = GetTargetCollectionConfigRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetCollectionConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetCollectionConfigRequest) ProtoMessage() {}
+
+func (x *GetTargetCollectionConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetCollectionConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetTargetCollectionConfigRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTargetCollectionConfigRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type GetTargetCollectionConfigResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Config        *TargetCollectionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTargetCollectionConfigResponse) Reset() {
+	*x = GetTargetCollectionConfigResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTargetCollectionConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTargetCollectionConfigResponse) ProtoMessage() {}
+
+func (x *GetTargetCollectionConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTargetCollectionConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetTargetCollectionConfigResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTargetCollectionConfigResponse) GetConfig() *TargetCollectionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetTargetCollectionConfigRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Config        *TargetCollectionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTargetCollectionConfigRequest) Reset() {
+	*x = SetTargetCollectionConfigRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTargetCollectionConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTargetCollectionConfigRequest) ProtoMessage() {}
+
+func (x *SetTargetCollectionConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTargetCollectionConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetTargetCollectionConfigRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTargetCollectionConfigRequest) GetConfig() *TargetCollectionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetTargetCollectionConfigResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Config        *TargetCollectionConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTargetCollectionConfigResponse) Reset() {
+	*x = SetTargetCollectionConfigResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTargetCollectionConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTargetCollectionConfigResponse) ProtoMessage() {}
+
+func (x *SetTargetCollectionConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTargetCollectionConfigResponse.ProtoReflect.Descriptor instead.
+func (*SetTargetCollectionConfigResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetTargetCollectionConfigResponse) GetConfig() *TargetCollectionConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetWaitStatsTimeSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Start *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// wait_types selects the series, when empty the top wait types by wait time in the range are returned
+	WaitTypes []string `protobuf:"bytes,4,rep,name=wait_types,json=waitTypes,proto3" json:"wait_types,omitempty"`
+	// top defaults to 10
+	Top           int32 `protobuf:"varint,5,opt,name=top,proto3" json:"top,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) Reset() {
+	*x = GetWaitStatsTimeSeriesRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitStatsTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetWaitStatsTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitStatsTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitStatsTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) GetWaitTypes() []string {
+	if x != nil {
+		return x.WaitTypes
+	}
+	return nil
+}
+
+func (x *GetWaitStatsTimeSeriesRequest) GetTop() int32 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+type GetWaitStatsTimeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*WaitStatSeries      `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitStatsTimeSeriesResponse) Reset() {
+	*x = GetWaitStatsTimeSeriesResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitStatsTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitStatsTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetWaitStatsTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitStatsTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitStatsTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetWaitStatsTimeSeriesResponse) GetSeries() []*WaitStatSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetPerformanceCountersTimeSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Start *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// counter_names selects the series, when empty every counter collected in the range is returned
+	CounterNames  []string `protobuf:"bytes,4,rep,name=counter_names,json=counterNames,proto3" json:"counter_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) Reset() {
+	*x = GetPerformanceCountersTimeSeriesRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPerformanceCountersTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPerformanceCountersTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetPerformanceCountersTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetPerformanceCountersTimeSeriesRequest) GetCounterNames() []string {
+	if x != nil {
+		return x.CounterNames
+	}
+	return nil
+}
+
+type GetPerformanceCountersTimeSeriesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Series        []*PerformanceCounterSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPerformanceCountersTimeSeriesResponse) Reset() {
+	*x = GetPerformanceCountersTimeSeriesResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPerformanceCountersTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPerformanceCountersTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetPerformanceCountersTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPerformanceCountersTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetPerformanceCountersTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPerformanceCountersTimeSeriesResponse) GetSeries() []*PerformanceCounterSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetFileIOTimeSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Start *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// databases selects the series, when empty the files of every database are returned
+	Databases     []string `protobuf:"bytes,4,rep,name=databases,proto3" json:"databases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileIOTimeSeriesRequest) Reset() {
+	*x = GetFileIOTimeSeriesRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileIOTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileIOTimeSeriesRequest) ProtoMessage() {}
+
+func (x *GetFileIOTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileIOTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetFileIOTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetFileIOTimeSeriesRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetFileIOTimeSeriesRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetFileIOTimeSeriesRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetFileIOTimeSeriesRequest) GetDatabases() []string {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type GetFileIOTimeSeriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QuerySample   *QuerySample           `protobuf:"bytes,1,opt,name=query_sample,json=querySample,proto3" json:"query_sample,omitempty"`
-	ParsedPlan    *ParsedExecutionPlan   `protobuf:"bytes,2,opt,name=parsed_plan,json=parsedPlan,proto3" json:"parsed_plan,omitempty"`
-	BlockChain    *BlockChain            `protobuf:"bytes,3,opt,name=block_chain,json=blockChain,proto3" json:"block_chain,omitempty"`
+	Series        []*FileIOSeries        `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileIOTimeSeriesResponse) Reset() {
+	*x = GetFileIOTimeSeriesResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileIOTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileIOTimeSeriesResponse) ProtoMessage() {}
+
+func (x *GetFileIOTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileIOTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetFileIOTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetFileIOTimeSeriesResponse) GetSeries() []*FileIOSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetIndexReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// database selects the database, when empty every database of the host is reviewed
+	Database string               `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Start    *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// write_ratio is the updates per read above which an index is write-heavy, defaults to 10
+	WriteRatio float64 `protobuf:"fixed64,5,opt,name=write_ratio,json=writeRatio,proto3" json:"write_ratio,omitempty"`
+	// top_missing limits the missing index candidates, defaults to 25
+	TopMissing    int32 `protobuf:"varint,6,opt,name=top_missing,json=topMissing,proto3" json:"top_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexReviewRequest) Reset() {
+	*x = GetIndexReviewRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexReviewRequest) ProtoMessage() {}
+
+func (x *GetIndexReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexReviewRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetIndexReviewRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetIndexReviewRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *GetIndexReviewRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetIndexReviewRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetIndexReviewRequest) GetWriteRatio() float64 {
+	if x != nil {
+		return x.WriteRatio
+	}
+	return 0
+}
+
+func (x *GetIndexReviewRequest) GetTopMissing() int32 {
+	if x != nil {
+		return x.TopMissing
+	}
+	return 0
+}
+
+type GetIndexReviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unused are the indexes without reads in the range, largest first
+	Unused []*IndexUsage `protobuf:"bytes,1,rep,name=unused,proto3" json:"unused,omitempty"`
+	// write_heavy are the indexes updated more than write_ratio times per read, most updated first
+	WriteHeavy     []*IndexUsage   `protobuf:"bytes,2,rep,name=write_heavy,json=writeHeavy,proto3" json:"write_heavy,omitempty"`
+	MissingIndexes []*MissingIndex `protobuf:"bytes,3,rep,name=missing_indexes,json=missingIndexes,proto3" json:"missing_indexes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetIndexReviewResponse) Reset() {
+	*x = GetIndexReviewResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexReviewResponse) ProtoMessage() {}
+
+func (x *GetIndexReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexReviewResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetIndexReviewResponse) GetUnused() []*IndexUsage {
+	if x != nil {
+		return x.Unused
+	}
+	return nil
+}
+
+func (x *GetIndexReviewResponse) GetWriteHeavy() []*IndexUsage {
+	if x != nil {
+		return x.WriteHeavy
+	}
+	return nil
+}
+
+func (x *GetIndexReviewResponse) GetMissingIndexes() []*MissingIndex {
+	if x != nil {
+		return x.MissingIndexes
+	}
+	return nil
+}
+
+type GetQueryPlanHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// query_hash is the base64 query hash of the samples and query metrics
+	QueryHash string               `protobuf:"bytes,2,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	Start     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End       *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// database selects the database, when empty the query is looked up in every database of the host
+	Database      string `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueryPlanHistoryRequest) Reset() {
+	*x = GetQueryPlanHistoryRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueryPlanHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryPlanHistoryRequest) ProtoMessage() {}
+
+func (x *GetQueryPlanHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryPlanHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetQueryPlanHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetQueryPlanHistoryRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetQueryPlanHistoryRequest) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
+func (x *GetQueryPlanHistoryRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetQueryPlanHistoryRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetQueryPlanHistoryRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+type GetQueryPlanHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// plans are ordered by their last execution, most recent first
+	Plans         []*QueryPlanHistory `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSampleDetailsResponse) Reset() {
-	*x = GetSampleDetailsResponse{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[20]
+func (x *GetQueryPlanHistoryResponse) Reset() {
+	*x = GetQueryPlanHistoryResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueryPlanHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryPlanHistoryResponse) ProtoMessage() {}
+
+func (x *GetQueryPlanHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryPlanHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetQueryPlanHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetQueryPlanHistoryResponse) GetPlans() []*QueryPlanHistory {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// ListJobRunsRequest selects the job runs overlapping the range, pass the range of a snapshot to see the jobs running
+// while it was taken
+type ListJobRunsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Start *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// long_running_only leaves out the runs within the usual duration of their job
+	LongRunningOnly bool `protobuf:"varint,4,opt,name=long_running_only,json=longRunningOnly,proto3" json:"long_running_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSampleDetailsResponse) String() string {
+func (x *ListJobRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSampleDetailsResponse) ProtoMessage() {}
+func (*ListJobRunsRequest) ProtoMessage() {}
 
-func (x *GetSampleDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[20]
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,56 +2725,62 @@ func (x *GetSampleDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSampleDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetSampleDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetSampleDetailsResponse) GetQuerySample() *QuerySample {
+func (x *ListJobRunsRequest) GetHost() string {
 	if x != nil {
-		return x.QuerySample
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSampleDetailsResponse) GetParsedPlan() *ParsedExecutionPlan {
+func (x *ListJobRunsRequest) GetStart() *timestamp.Timestamp {
 	if x != nil {
-		return x.ParsedPlan
+		return x.Start
 	}
 	return nil
 }
 
-func (x *GetSampleDetailsResponse) GetBlockChain() *BlockChain {
+func (x *ListJobRunsRequest) GetEnd() *timestamp.Timestamp {
 	if x != nil {
-		return x.BlockChain
+		return x.End
 	}
 	return nil
 }
 
-type GetNormalizedQueryDetailsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueryHash     string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
-	StartTime     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+func (x *ListJobRunsRequest) GetLongRunningOnly() bool {
+	if x != nil {
+		return x.LongRunningOnly
+	}
+	return false
+}
+
+type ListJobRunsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// runs are ordered by start time
+	Runs          []*JobRunReview `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNormalizedQueryDetailsRequest) Reset() {
-	*x = GetNormalizedQueryDetailsRequest{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[21]
+func (x *ListJobRunsResponse) Reset() {
+	*x = ListJobRunsResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNormalizedQueryDetailsRequest) String() string {
+func (x *ListJobRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNormalizedQueryDetailsRequest) ProtoMessage() {}
+func (*ListJobRunsResponse) ProtoMessage() {}
 
-func (x *GetNormalizedQueryDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[21]
+func (x *ListJobRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,53 +2791,93 @@ func (x *GetNormalizedQueryDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNormalizedQueryDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetNormalizedQueryDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ListJobRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRunsResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetNormalizedQueryDetailsRequest) GetQueryHash() string {
+func (x *ListJobRunsResponse) GetRuns() []*JobRunReview {
 	if x != nil {
-		return x.QueryHash
+		return x.Runs
 	}
-	return ""
+	return nil
 }
 
-func (x *GetNormalizedQueryDetailsRequest) GetStartTime() *timestamp.Timestamp {
+type GetAvailabilityGroupTopologyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// ag_name selects the availability group, when empty every availability group of the host is returned
+	AgName        string `protobuf:"bytes,2,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityGroupTopologyRequest) Reset() {
+	*x = GetAvailabilityGroupTopologyRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityGroupTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityGroupTopologyRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityGroupTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[49]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetNormalizedQueryDetailsRequest) GetEndTime() *timestamp.Timestamp {
+// Deprecated: Use GetAvailabilityGroupTopologyRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityGroupTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetAvailabilityGroupTopologyRequest) GetHost() string {
 	if x != nil {
-		return x.EndTime
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-type GetNormalizedQueryDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAvailabilityGroupTopologyRequest) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
 }
 
-func (x *GetNormalizedQueryDetailsResponse) Reset() {
-	*x = GetNormalizedQueryDetailsResponse{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[22]
+type GetAvailabilityGroupTopologyResponse struct {
+	state              protoimpl.MessageState       `protogen:"open.v1"`
+	AvailabilityGroups []*AvailabilityGroupTopology `protobuf:"bytes,1,rep,name=availability_groups,json=availabilityGroups,proto3" json:"availability_groups,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityGroupTopologyResponse) Reset() {
+	*x = GetAvailabilityGroupTopologyResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNormalizedQueryDetailsResponse) String() string {
+func (x *GetAvailabilityGroupTopologyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNormalizedQueryDetailsResponse) ProtoMessage() {}
+func (*GetAvailabilityGroupTopologyResponse) ProtoMessage() {}
 
-func (x *GetNormalizedQueryDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[22]
+func (x *GetAvailabilityGroupTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1357,35 +2888,44 @@ func (x *GetNormalizedQueryDetailsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNormalizedQueryDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetNormalizedQueryDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetAvailabilityGroupTopologyResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityGroupTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{50}
 }
 
-type GetNormalizedQueryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueryHash     string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
-	StartTime     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+func (x *GetAvailabilityGroupTopologyResponse) GetAvailabilityGroups() []*AvailabilityGroupTopology {
+	if x != nil {
+		return x.AvailabilityGroups
+	}
+	return nil
+}
+
+type GetReplicaStatesTimeSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Start *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// ag_name selects the availability group, when empty the replicas of every availability group are returned
+	AgName        string `protobuf:"bytes,4,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNormalizedQueryRequest) Reset() {
-	*x = GetNormalizedQueryRequest{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[23]
+func (x *GetReplicaStatesTimeSeriesRequest) Reset() {
+	*x = GetReplicaStatesTimeSeriesRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNormalizedQueryRequest) String() string {
+func (x *GetReplicaStatesTimeSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNormalizedQueryRequest) ProtoMessage() {}
+func (*GetReplicaStatesTimeSeriesRequest) ProtoMessage() {}
 
-func (x *GetNormalizedQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[23]
+func (x *GetReplicaStatesTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,57 +2936,61 @@ func (x *GetNormalizedQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNormalizedQueryRequest.ProtoReflect.Descriptor instead.
-func (*GetNormalizedQueryRequest) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetReplicaStatesTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicaStatesTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetNormalizedQueryRequest) GetQueryHash() string {
+func (x *GetReplicaStatesTimeSeriesRequest) GetHost() string {
 	if x != nil {
-		return x.QueryHash
+		return x.Host
 	}
 	return ""
 }
 
-func (x *GetNormalizedQueryRequest) GetStartTime() *timestamp.Timestamp {
+func (x *GetReplicaStatesTimeSeriesRequest) GetStart() *timestamp.Timestamp {
 	if x != nil {
-		return x.StartTime
+		return x.Start
 	}
 	return nil
 }
 
-func (x *GetNormalizedQueryRequest) GetEndTime() *timestamp.Timestamp {
+func (x *GetReplicaStatesTimeSeriesRequest) GetEnd() *timestamp.Timestamp {
 	if x != nil {
-		return x.EndTime
+		return x.End
 	}
 	return nil
 }
 
-type GetNormalizedQueryResponse struct {
-	state               protoimpl.MessageState                             `protogen:"open.v1"`
-	ConnectionsOverTime []*GetNormalizedQueryResponse_ConnectionsDataPoint `protobuf:"bytes,1,rep,name=connections_over_time,json=connectionsOverTime,proto3" json:"connections_over_time,omitempty"`
-	ExecutionPlans      []*GetNormalizedQueryResponse_ExecutionPlanUsage   `protobuf:"bytes,2,rep,name=execution_plans,json=executionPlans,proto3" json:"execution_plans,omitempty"`
-	QueryMetrics        []*QueryMetric                                     `protobuf:"bytes,3,rep,name=query_metrics,json=queryMetrics,proto3" json:"query_metrics,omitempty"`
-	BlockingActivity    []*BlockChain                                      `protobuf:"bytes,4,rep,name=blocking_activity,json=blockingActivity,proto3" json:"blocking_activity,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *GetReplicaStatesTimeSeriesRequest) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
 }
 
-func (x *GetNormalizedQueryResponse) Reset() {
-	*x = GetNormalizedQueryResponse{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[24]
+type GetReplicaStatesTimeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*ReplicaStateSeries  `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicaStatesTimeSeriesResponse) Reset() {
+	*x = GetReplicaStatesTimeSeriesResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNormalizedQueryResponse) String() string {
+func (x *GetReplicaStatesTimeSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNormalizedQueryResponse) ProtoMessage() {}
+func (*GetReplicaStatesTimeSeriesResponse) ProtoMessage() {}
 
-func (x *GetNormalizedQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[24]
+func (x *GetReplicaStatesTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,50 +3001,147 @@ func (x *GetNormalizedQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNormalizedQueryResponse.ProtoReflect.Descriptor instead.
-func (*GetNormalizedQueryResponse) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetReplicaStatesTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicaStatesTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetNormalizedQueryResponse) GetConnectionsOverTime() []*GetNormalizedQueryResponse_ConnectionsDataPoint {
+func (x *GetReplicaStatesTimeSeriesResponse) GetSeries() []*ReplicaStateSeries {
 	if x != nil {
-		return x.ConnectionsOverTime
+		return x.Series
 	}
 	return nil
 }
 
-func (x *GetNormalizedQueryResponse) GetExecutionPlans() []*GetNormalizedQueryResponse_ExecutionPlanUsage {
+// GetFileGrowthTrendRequest selects the size history the forecast is computed on, the longer the range the steadier
+// the forecast
+type GetFileGrowthTrendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Host  string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// database selects the database, when empty the files of every database are returned
+	Database      string               `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Start         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileGrowthTrendRequest) Reset() {
+	*x = GetFileGrowthTrendRequest{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileGrowthTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileGrowthTrendRequest) ProtoMessage() {}
+
+func (x *GetFileGrowthTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[53]
 	if x != nil {
-		return x.ExecutionPlans
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileGrowthTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetFileGrowthTrendRequest) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetFileGrowthTrendRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetFileGrowthTrendRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *GetFileGrowthTrendRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
 	}
 	return nil
 }
 
-func (x *GetNormalizedQueryResponse) GetQueryMetrics() []*QueryMetric {
+func (x *GetFileGrowthTrendRequest) GetEnd() *timestamp.Timestamp {
 	if x != nil {
-		return x.QueryMetrics
+		return x.End
 	}
 	return nil
 }
 
-func (x *GetNormalizedQueryResponse) GetBlockingActivity() []*BlockChain {
+type GetFileGrowthTrendResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// trends are ordered by days until full, files filling up first
+	Trends        []*FileGrowthTrend `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileGrowthTrendResponse) Reset() {
+	*x = GetFileGrowthTrendResponse{}
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileGrowthTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileGrowthTrendResponse) ProtoMessage() {}
+
+func (x *GetFileGrowthTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[54]
 	if x != nil {
-		return x.BlockingActivity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileGrowthTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetFileGrowthTrendResponse) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_dbm_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetFileGrowthTrendResponse) GetTrends() []*FileGrowthTrend {
+	if x != nil {
+		return x.Trends
 	}
 	return nil
 }
 
 type BlockChain_BlockingNode struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	QuerySample   *QuerySample               `protobuf:"bytes,1,opt,name=query_sample,json=querySample,proto3" json:"query_sample,omitempty"`
-	ChildNodes    []*BlockChain_BlockingNode `protobuf:"bytes,2,rep,name=child_nodes,json=childNodes,proto3" json:"child_nodes,omitempty"`
+	state       protoimpl.MessageState     `protogen:"open.v1"`
+	QuerySample *QuerySample               `protobuf:"bytes,1,opt,name=query_sample,json=querySample,proto3" json:"query_sample,omitempty"`
+	ChildNodes  []*BlockChain_BlockingNode `protobuf:"bytes,2,rep,name=child_nodes,json=childNodes,proto3" json:"child_nodes,omitempty"`
+	// lock_conflict is the lock the session waits for on its parent node, unset on the roots
+	LockConflict  *LockConflict `protobuf:"bytes,3,opt,name=lock_conflict,json=lockConflict,proto3" json:"lock_conflict,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BlockChain_BlockingNode) Reset() {
 	*x = BlockChain_BlockingNode{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[28]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1512,7 +3153,7 @@ func (x *BlockChain_BlockingNode) String() string {
 func (*BlockChain_BlockingNode) ProtoMessage() {}
 
 func (x *BlockChain_BlockingNode) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[28]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,6 +3183,13 @@ func (x *BlockChain_BlockingNode) GetChildNodes() []*BlockChain_BlockingNode {
 	return nil
 }
 
+func (x *BlockChain_BlockingNode) GetLockConflict() *LockConflict {
+	if x != nil {
+		return x.LockConflict
+	}
+	return nil
+}
+
 type GetNormalizedQueryResponse_ConnectionsDataPoint struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	ConnectionsByWaitType map[string]int64       `protobuf:"bytes,1,rep,name=connections_by_wait_type,json=connectionsByWaitType,proto3" json:"connections_by_wait_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
@@ -1552,7 +3200,7 @@ type GetNormalizedQueryResponse_ConnectionsDataPoint struct {
 
 func (x *GetNormalizedQueryResponse_ConnectionsDataPoint) Reset() {
 	*x = GetNormalizedQueryResponse_ConnectionsDataPoint{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[29]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +3212,7 @@ func (x *GetNormalizedQueryResponse_ConnectionsDataPoint) String() string {
 func (*GetNormalizedQueryResponse_ConnectionsDataPoint) ProtoMessage() {}
 
 func (x *GetNormalizedQueryResponse_ConnectionsDataPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[29]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,7 +3252,7 @@ type GetNormalizedQueryResponse_ExecutionPlanUsage struct {
 
 func (x *GetNormalizedQueryResponse_ExecutionPlanUsage) Reset() {
 	*x = GetNormalizedQueryResponse_ExecutionPlanUsage{}
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[30]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1616,7 +3264,7 @@ func (x *GetNormalizedQueryResponse_ExecutionPlanUsage) String() string {
 func (*GetNormalizedQueryResponse_ExecutionPlanUsage) ProtoMessage() {}
 
 func (x *GetNormalizedQueryResponse_ExecutionPlanUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[30]
+	mi := &file_database_monitoring_v1_dbm_api_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +3298,7 @@ var File_database_monitoring_v1_dbm_api_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_dbm_api_proto_rawDesc = "" +
 	"\n" +
-	"$database_monitoring/v1/dbm_api.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%database_monitoring/v1/snapshot.proto\x1a#database_monitoring/v1/sample.proto\x1a+database_monitoring/v1/execution_plan.proto\"\x96\x01\n" +
+	"$database_monitoring/v1/dbm_api.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%database_monitoring/v1/snapshot.proto\x1a#database_monitoring/v1/sample.proto\x1a+database_monitoring/v1/execution_plan.proto\x1a%database_monitoring/v1/deadlock.proto\x1a\"database_monitoring/v1/agent.proto\x1a+database_monitoring/v1/system_metrics.proto\x1a(database_monitoring/v1/index_stats.proto\x1a(database_monitoring/v1/query_store.proto\x1a database_monitoring/v1/job.proto\x1a/database_monitoring/v1/availability_group.proto\x1a&database_monitoring/v1/file_size.proto\"\x96\x01\n" +
 	"\x1cListSnapshotSummariesRequest\x120\n" +
 	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
 	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x16\n" +
@@ -1742,20 +3390,22 @@ const file_database_monitoring_v1_dbm_api_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"O\n" +
 	"\x17GetSampleDetailsRequest\x12\x1b\n" +
 	"\tsample_id\x18\x01 \x01(\tR\bsampleId\x12\x17\n" +
-	"\asnap_id\x18\x02 \x01(\tR\x06snapId\"\xfe\x01\n" +
+	"\asnap_id\x18\x02 \x01(\tR\x06snapId\"\xc9\x02\n" +
 	"\n" +
 	"BlockChain\x12E\n" +
-	"\x05roots\x18\x01 \x03(\v2/.database_monitoring.v1.BlockChain.BlockingNodeR\x05roots\x1a\xa8\x01\n" +
+	"\x05roots\x18\x01 \x03(\v2/.database_monitoring.v1.BlockChain.BlockingNodeR\x05roots\x1a\xf3\x01\n" +
 	"\fBlockingNode\x12F\n" +
 	"\fquery_sample\x18\x01 \x01(\v2#.database_monitoring.v1.QuerySampleR\vquerySample\x12P\n" +
 	"\vchild_nodes\x18\x02 \x03(\v2/.database_monitoring.v1.BlockChain.BlockingNodeR\n" +
-	"childNodes\"\xf5\x01\n" +
+	"childNodes\x12I\n" +
+	"\rlock_conflict\x18\x03 \x01(\v2$.database_monitoring.v1.LockConflictR\flockConflict\"\xc0\x02\n" +
 	"\x18GetSampleDetailsResponse\x12F\n" +
 	"\fquery_sample\x18\x01 \x01(\v2#.database_monitoring.v1.QuerySampleR\vquerySample\x12L\n" +
 	"\vparsed_plan\x18\x02 \x01(\v2+.database_monitoring.v1.ParsedExecutionPlanR\n" +
 	"parsedPlan\x12C\n" +
 	"\vblock_chain\x18\x03 \x01(\v2\".database_monitoring.v1.BlockChainR\n" +
-	"blockChain\"\xb3\x01\n" +
+	"blockChain\x12I\n" +
+	"\rlock_conflict\x18\x04 \x01(\v2$.database_monitoring.v1.LockConflictR\flockConflict\"\xb3\x01\n" +
 	" GetNormalizedQueryDetailsRequest\x12\x1d\n" +
 	"\n" +
 	"query_hash\x18\x01 \x01(\tR\tqueryHash\x129\n" +
@@ -1782,7 +3432,111 @@ const file_database_monitoring_v1_dbm_api_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a\x84\x01\n" +
 	"\x12ExecutionPlanUsage\x12B\n" +
 	"\texec_plan\x18\x01 \x01(\v2%.database_monitoring.v1.ExecutionPlanR\bexecPlan\x12*\n" +
-	"\x11number_of_samples\x18\x02 \x01(\x03R\x0fnumberOfSamples2\xb9\t\n" +
+	"\x11number_of_samples\x18\x02 \x01(\x03R\x0fnumberOfSamples\"\xc8\x01\n" +
+	"\x14ListDeadlocksRequest\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x05 \x01(\x03R\n" +
+	"pageNumber\"\x99\x01\n" +
+	"\x15ListDeadlocksResponse\x12>\n" +
+	"\tdeadlocks\x18\x01 \x03(\v2 .database_monitoring.v1.DeadlockR\tdeadlocks\x12\x1f\n" +
+	"\vpage_number\x18\x02 \x01(\x03R\n" +
+	"pageNumber\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"$\n" +
+	"\x12GetDeadlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
+	"\x13GetDeadlockResponse\x12<\n" +
+	"\bdeadlock\x18\x01 \x01(\v2 .database_monitoring.v1.DeadlockR\bdeadlock\"\x13\n" +
+	"\x11ListAgentsRequest\"K\n" +
+	"\x12ListAgentsResponse\x125\n" +
+	"\x06agents\x18\x01 \x03(\v2\x1d.database_monitoring.v1.AgentR\x06agents\"!\n" +
+	"\x0fGetAgentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x10GetAgentResponse\x123\n" +
+	"\x05agent\x18\x01 \x01(\v2\x1d.database_monitoring.v1.AgentR\x05agent\"6\n" +
+	" GetTargetCollectionConfigRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"k\n" +
+	"!GetTargetCollectionConfigResponse\x12F\n" +
+	"\x06config\x18\x01 \x01(\v2..database_monitoring.v1.TargetCollectionConfigR\x06config\"j\n" +
+	" SetTargetCollectionConfigRequest\x12F\n" +
+	"\x06config\x18\x01 \x01(\v2..database_monitoring.v1.TargetCollectionConfigR\x06config\"k\n" +
+	"!SetTargetCollectionConfigResponse\x12F\n" +
+	"\x06config\x18\x01 \x01(\v2..database_monitoring.v1.TargetCollectionConfigR\x06config\"\xc4\x01\n" +
+	"\x1dGetWaitStatsTimeSeriesRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1d\n" +
+	"\n" +
+	"wait_types\x18\x04 \x03(\tR\twaitTypes\x12\x10\n" +
+	"\x03top\x18\x05 \x01(\x05R\x03top\"`\n" +
+	"\x1eGetWaitStatsTimeSeriesResponse\x12>\n" +
+	"\x06series\x18\x01 \x03(\v2&.database_monitoring.v1.WaitStatSeriesR\x06series\"\xc2\x01\n" +
+	"'GetPerformanceCountersTimeSeriesRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12#\n" +
+	"\rcounter_names\x18\x04 \x03(\tR\fcounterNames\"t\n" +
+	"(GetPerformanceCountersTimeSeriesResponse\x12H\n" +
+	"\x06series\x18\x01 \x03(\v20.database_monitoring.v1.PerformanceCounterSeriesR\x06series\"\xae\x01\n" +
+	"\x1aGetFileIOTimeSeriesRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1c\n" +
+	"\tdatabases\x18\x04 \x03(\tR\tdatabases\"[\n" +
+	"\x1bGetFileIOTimeSeriesResponse\x12<\n" +
+	"\x06series\x18\x01 \x03(\v2$.database_monitoring.v1.FileIOSeriesR\x06series\"\xe9\x01\n" +
+	"\x15GetIndexReviewRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1f\n" +
+	"\vwrite_ratio\x18\x05 \x01(\x01R\n" +
+	"writeRatio\x12\x1f\n" +
+	"\vtop_missing\x18\x06 \x01(\x05R\n" +
+	"topMissing\"\xe8\x01\n" +
+	"\x16GetIndexReviewResponse\x12:\n" +
+	"\x06unused\x18\x01 \x03(\v2\".database_monitoring.v1.IndexUsageR\x06unused\x12C\n" +
+	"\vwrite_heavy\x18\x02 \x03(\v2\".database_monitoring.v1.IndexUsageR\n" +
+	"writeHeavy\x12M\n" +
+	"\x0fmissing_indexes\x18\x03 \x03(\v2$.database_monitoring.v1.MissingIndexR\x0emissingIndexes\"\xcb\x01\n" +
+	"\x1aGetQueryPlanHistoryRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1d\n" +
+	"\n" +
+	"query_hash\x18\x02 \x01(\tR\tqueryHash\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1a\n" +
+	"\bdatabase\x18\x05 \x01(\tR\bdatabase\"]\n" +
+	"\x1bGetQueryPlanHistoryResponse\x12>\n" +
+	"\x05plans\x18\x01 \x03(\v2(.database_monitoring.v1.QueryPlanHistoryR\x05plans\"\xb4\x01\n" +
+	"\x12ListJobRunsRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12*\n" +
+	"\x11long_running_only\x18\x04 \x01(\bR\x0flongRunningOnly\"O\n" +
+	"\x13ListJobRunsResponse\x128\n" +
+	"\x04runs\x18\x01 \x03(\v2$.database_monitoring.v1.JobRunReviewR\x04runs\"R\n" +
+	"#GetAvailabilityGroupTopologyRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x17\n" +
+	"\aag_name\x18\x02 \x01(\tR\x06agName\"\x8a\x01\n" +
+	"$GetAvailabilityGroupTopologyResponse\x12b\n" +
+	"\x13availability_groups\x18\x01 \x03(\v21.database_monitoring.v1.AvailabilityGroupTopologyR\x12availabilityGroups\"\xb0\x01\n" +
+	"!GetReplicaStatesTimeSeriesRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x17\n" +
+	"\aag_name\x18\x04 \x01(\tR\x06agName\"h\n" +
+	"\"GetReplicaStatesTimeSeriesResponse\x12B\n" +
+	"\x06series\x18\x01 \x03(\v2*.database_monitoring.v1.ReplicaStateSeriesR\x06series\"\xab\x01\n" +
+	"\x19GetFileGrowthTrendRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bdatabase\x18\x02 \x01(\tR\bdatabase\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"]\n" +
+	"\x1aGetFileGrowthTrendResponse\x12?\n" +
+	"\x06trends\x18\x01 \x03(\v2'.database_monitoring.v1.FileGrowthTrendR\x06trends2\xb3\x18\n" +
 	"\x06DBMApi\x12l\n" +
 	"\rListSnapshots\x12,.database_monitoring.v1.ListSnapshotsRequest\x1a-.database_monitoring.v1.ListSnapshotsResponse\x12\x84\x01\n" +
 	"\x15ListSnapshotSummaries\x124.database_monitoring.v1.ListSnapshotSummariesRequest\x1a5.database_monitoring.v1.ListSnapshotSummariesResponse\x12f\n" +
@@ -1793,7 +3547,23 @@ const file_database_monitoring_v1_dbm_api_proto_rawDesc = "" +
 	"\x0fGetQueryMetrics\x12..database_monitoring.v1.GetQueryMetricsRequest\x1a/.database_monitoring.v1.GetQueryMetricsResponse\x12\x90\x01\n" +
 	"\x19GetQueryMetricsTimeSeries\x128.database_monitoring.v1.GetQueryMetricsTimeSeriesRequest\x1a9.database_monitoring.v1.GetQueryMetricsTimeSeriesResponse\x12u\n" +
 	"\x10GetSampleDetails\x12/.database_monitoring.v1.GetSampleDetailsRequest\x1a0.database_monitoring.v1.GetSampleDetailsResponse\x12{\n" +
-	"\x12GetNormalizedQuery\x121.database_monitoring.v1.GetNormalizedQueryRequest\x1a2.database_monitoring.v1.GetNormalizedQueryResponseBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+	"\x12GetNormalizedQuery\x121.database_monitoring.v1.GetNormalizedQueryRequest\x1a2.database_monitoring.v1.GetNormalizedQueryResponse\x12l\n" +
+	"\rListDeadlocks\x12,.database_monitoring.v1.ListDeadlocksRequest\x1a-.database_monitoring.v1.ListDeadlocksResponse\x12f\n" +
+	"\vGetDeadlock\x12*.database_monitoring.v1.GetDeadlockRequest\x1a+.database_monitoring.v1.GetDeadlockResponse\x12c\n" +
+	"\n" +
+	"ListAgents\x12).database_monitoring.v1.ListAgentsRequest\x1a*.database_monitoring.v1.ListAgentsResponse\x12]\n" +
+	"\bGetAgent\x12'.database_monitoring.v1.GetAgentRequest\x1a(.database_monitoring.v1.GetAgentResponse\x12\x90\x01\n" +
+	"\x19GetTargetCollectionConfig\x128.database_monitoring.v1.GetTargetCollectionConfigRequest\x1a9.database_monitoring.v1.GetTargetCollectionConfigResponse\x12\x90\x01\n" +
+	"\x19SetTargetCollectionConfig\x128.database_monitoring.v1.SetTargetCollectionConfigRequest\x1a9.database_monitoring.v1.SetTargetCollectionConfigResponse\x12\x87\x01\n" +
+	"\x16GetWaitStatsTimeSeries\x125.database_monitoring.v1.GetWaitStatsTimeSeriesRequest\x1a6.database_monitoring.v1.GetWaitStatsTimeSeriesResponse\x12\xa5\x01\n" +
+	" GetPerformanceCountersTimeSeries\x12?.database_monitoring.v1.GetPerformanceCountersTimeSeriesRequest\x1a@.database_monitoring.v1.GetPerformanceCountersTimeSeriesResponse\x12~\n" +
+	"\x13GetFileIOTimeSeries\x122.database_monitoring.v1.GetFileIOTimeSeriesRequest\x1a3.database_monitoring.v1.GetFileIOTimeSeriesResponse\x12o\n" +
+	"\x0eGetIndexReview\x12-.database_monitoring.v1.GetIndexReviewRequest\x1a..database_monitoring.v1.GetIndexReviewResponse\x12~\n" +
+	"\x13GetQueryPlanHistory\x122.database_monitoring.v1.GetQueryPlanHistoryRequest\x1a3.database_monitoring.v1.GetQueryPlanHistoryResponse\x12f\n" +
+	"\vListJobRuns\x12*.database_monitoring.v1.ListJobRunsRequest\x1a+.database_monitoring.v1.ListJobRunsResponse\x12\x99\x01\n" +
+	"\x1cGetAvailabilityGroupTopology\x12;.database_monitoring.v1.GetAvailabilityGroupTopologyRequest\x1a<.database_monitoring.v1.GetAvailabilityGroupTopologyResponse\x12\x93\x01\n" +
+	"\x1aGetReplicaStatesTimeSeries\x129.database_monitoring.v1.GetReplicaStatesTimeSeriesRequest\x1a:.database_monitoring.v1.GetReplicaStatesTimeSeriesResponse\x12{\n" +
+	"\x12GetFileGrowthTrend\x121.database_monitoring.v1.GetFileGrowthTrendRequest\x1a2.database_monitoring.v1.GetFileGrowthTrendResponseBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
 
 var (
 	file_database_monitoring_v1_dbm_api_proto_rawDescOnce sync.Once
@@ -1807,7 +3577,7 @@ func file_database_monitoring_v1_dbm_api_proto_rawDescGZIP() []byte {
 	return file_database_monitoring_v1_dbm_api_proto_rawDescData
 }
 
-var file_database_monitoring_v1_dbm_api_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_database_monitoring_v1_dbm_api_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_database_monitoring_v1_dbm_api_proto_goTypes = []any{
 	(*ListSnapshotSummariesRequest)(nil),                    // 0: database_monitoring.v1.ListSnapshotSummariesRequest
 	(*SnapshotSummary)(nil),                                 // 1: database_monitoring.v1.SnapshotSummary
@@ -1834,91 +3604,203 @@ var file_database_monitoring_v1_dbm_api_proto_goTypes = []any{
 	(*GetNormalizedQueryDetailsResponse)(nil),               // 22: database_monitoring.v1.GetNormalizedQueryDetailsResponse
 	(*GetNormalizedQueryRequest)(nil),                       // 23: database_monitoring.v1.GetNormalizedQueryRequest
 	(*GetNormalizedQueryResponse)(nil),                      // 24: database_monitoring.v1.GetNormalizedQueryResponse
-	nil,                                                     // 25: database_monitoring.v1.SnapshotSummary.ConnectionsByWaitEventEntry
-	nil,                                                     // 26: database_monitoring.v1.SnapshotSummary.TimeMsByWaitEventEntry
-	nil,                                                     // 27: database_monitoring.v1.ServerSummary.ConnectionsByWaitGroupEntry
-	(*BlockChain_BlockingNode)(nil),                         // 28: database_monitoring.v1.BlockChain.BlockingNode
-	(*GetNormalizedQueryResponse_ConnectionsDataPoint)(nil), // 29: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint
-	(*GetNormalizedQueryResponse_ExecutionPlanUsage)(nil),   // 30: database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage
-	nil,                         // 31: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.ConnectionsByWaitTypeEntry
-	(*timestamp.Timestamp)(nil), // 32: google.protobuf.Timestamp
-	(*ServerMetadata)(nil),      // 33: database_monitoring.v1.ServerMetadata
-	(*QueryMetric)(nil),         // 34: database_monitoring.v1.QueryMetric
-	(*DBSnapshot)(nil),          // 35: database_monitoring.v1.DBSnapshot
-	(*QuerySample)(nil),         // 36: database_monitoring.v1.QuerySample
-	(*ParsedExecutionPlan)(nil), // 37: database_monitoring.v1.ParsedExecutionPlan
-	(*ExecutionPlan)(nil),       // 38: database_monitoring.v1.ExecutionPlan
+	(*ListDeadlocksRequest)(nil),                            // 25: database_monitoring.v1.ListDeadlocksRequest
+	(*ListDeadlocksResponse)(nil),                           // 26: database_monitoring.v1.ListDeadlocksResponse
+	(*GetDeadlockRequest)(nil),                              // 27: database_monitoring.v1.GetDeadlockRequest
+	(*GetDeadlockResponse)(nil),                             // 28: database_monitoring.v1.GetDeadlockResponse
+	(*ListAgentsRequest)(nil),                               // 29: database_monitoring.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),                              // 30: database_monitoring.v1.ListAgentsResponse
+	(*GetAgentRequest)(nil),                                 // 31: database_monitoring.v1.GetAgentRequest
+	(*GetAgentResponse)(nil),                                // 32: database_monitoring.v1.GetAgentResponse
+	(*GetTargetCollectionConfigRequest)(nil),                // 33: database_monitoring.v1.GetTargetCollectionConfigRequest
+	(*GetTargetCollectionConfigResponse)(nil),               // 34: database_monitoring.v1.GetTargetCollectionConfigResponse
+	(*SetTargetCollectionConfigRequest)(nil),                // 35: database_monitoring.v1.SetTargetCollectionConfigRequest
+	(*SetTargetCollectionConfigResponse)(nil),               // 36: database_monitoring.v1.SetTargetCollectionConfigResponse
+	(*GetWaitStatsTimeSeriesRequest)(nil),                   // 37: database_monitoring.v1.GetWaitStatsTimeSeriesRequest
+	(*GetWaitStatsTimeSeriesResponse)(nil),                  // 38: database_monitoring.v1.GetWaitStatsTimeSeriesResponse
+	(*GetPerformanceCountersTimeSeriesRequest)(nil),         // 39: database_monitoring.v1.GetPerformanceCountersTimeSeriesRequest
+	(*GetPerformanceCountersTimeSeriesResponse)(nil),        // 40: database_monitoring.v1.GetPerformanceCountersTimeSeriesResponse
+	(*GetFileIOTimeSeriesRequest)(nil),                      // 41: database_monitoring.v1.GetFileIOTimeSeriesRequest
+	(*GetFileIOTimeSeriesResponse)(nil),                     // 42: database_monitoring.v1.GetFileIOTimeSeriesResponse
+	(*GetIndexReviewRequest)(nil),                           // 43: database_monitoring.v1.GetIndexReviewRequest
+	(*GetIndexReviewResponse)(nil),                          // 44: database_monitoring.v1.GetIndexReviewResponse
+	(*GetQueryPlanHistoryRequest)(nil),                      // 45: database_monitoring.v1.GetQueryPlanHistoryRequest
+	(*GetQueryPlanHistoryResponse)(nil),                     // 46: database_monitoring.v1.GetQueryPlanHistoryResponse
+	(*ListJobRunsRequest)(nil),                              // 47: database_monitoring.v1.ListJobRunsRequest
+	(*ListJobRunsResponse)(nil),                             // 48: database_monitoring.v1.ListJobRunsResponse
+	(*GetAvailabilityGroupTopologyRequest)(nil),             // 49: database_monitoring.v1.GetAvailabilityGroupTopologyRequest
+	(*GetAvailabilityGroupTopologyResponse)(nil),            // 50: database_monitoring.v1.GetAvailabilityGroupTopologyResponse
+	(*GetReplicaStatesTimeSeriesRequest)(nil),               // 51: database_monitoring.v1.GetReplicaStatesTimeSeriesRequest
+	(*GetReplicaStatesTimeSeriesResponse)(nil),              // 52: database_monitoring.v1.GetReplicaStatesTimeSeriesResponse
+	(*GetFileGrowthTrendRequest)(nil),                       // 53: database_monitoring.v1.GetFileGrowthTrendRequest
+	(*GetFileGrowthTrendResponse)(nil),                      // 54: database_monitoring.v1.GetFileGrowthTrendResponse
+	nil,                                                     // 55: database_monitoring.v1.SnapshotSummary.ConnectionsByWaitEventEntry
+	nil,                                                     // 56: database_monitoring.v1.SnapshotSummary.TimeMsByWaitEventEntry
+	nil,                                                     // 57: database_monitoring.v1.ServerSummary.ConnectionsByWaitGroupEntry
+	(*BlockChain_BlockingNode)(nil),                         // 58: database_monitoring.v1.BlockChain.BlockingNode
+	(*GetNormalizedQueryResponse_ConnectionsDataPoint)(nil), // 59: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint
+	(*GetNormalizedQueryResponse_ExecutionPlanUsage)(nil),   // 60: database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage
+	nil,                               // 61: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.ConnectionsByWaitTypeEntry
+	(*timestamp.Timestamp)(nil),       // 62: google.protobuf.Timestamp
+	(*ServerMetadata)(nil),            // 63: database_monitoring.v1.ServerMetadata
+	(*QueryMetric)(nil),               // 64: database_monitoring.v1.QueryMetric
+	(*DBSnapshot)(nil),                // 65: database_monitoring.v1.DBSnapshot
+	(*QuerySample)(nil),               // 66: database_monitoring.v1.QuerySample
+	(*ParsedExecutionPlan)(nil),       // 67: database_monitoring.v1.ParsedExecutionPlan
+	(*LockConflict)(nil),              // 68: database_monitoring.v1.LockConflict
+	(*Deadlock)(nil),                  // 69: database_monitoring.v1.Deadlock
+	(*Agent)(nil),                     // 70: database_monitoring.v1.Agent
+	(*TargetCollectionConfig)(nil),    // 71: database_monitoring.v1.TargetCollectionConfig
+	(*WaitStatSeries)(nil),            // 72: database_monitoring.v1.WaitStatSeries
+	(*PerformanceCounterSeries)(nil),  // 73: database_monitoring.v1.PerformanceCounterSeries
+	(*FileIOSeries)(nil),              // 74: database_monitoring.v1.FileIOSeries
+	(*IndexUsage)(nil),                // 75: database_monitoring.v1.IndexUsage
+	(*MissingIndex)(nil),              // 76: database_monitoring.v1.MissingIndex
+	(*QueryPlanHistory)(nil),          // 77: database_monitoring.v1.QueryPlanHistory
+	(*JobRunReview)(nil),              // 78: database_monitoring.v1.JobRunReview
+	(*AvailabilityGroupTopology)(nil), // 79: database_monitoring.v1.AvailabilityGroupTopology
+	(*ReplicaStateSeries)(nil),        // 80: database_monitoring.v1.ReplicaStateSeries
+	(*FileGrowthTrend)(nil),           // 81: database_monitoring.v1.FileGrowthTrend
+	(*ExecutionPlan)(nil),             // 82: database_monitoring.v1.ExecutionPlan
 }
 var file_database_monitoring_v1_dbm_api_proto_depIdxs = []int32{
-	32, // 0: database_monitoring.v1.ListSnapshotSummariesRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 1: database_monitoring.v1.ListSnapshotSummariesRequest.end:type_name -> google.protobuf.Timestamp
-	32, // 2: database_monitoring.v1.SnapshotSummary.timestamp:type_name -> google.protobuf.Timestamp
-	33, // 3: database_monitoring.v1.SnapshotSummary.server:type_name -> database_monitoring.v1.ServerMetadata
-	25, // 4: database_monitoring.v1.SnapshotSummary.connections_by_wait_event:type_name -> database_monitoring.v1.SnapshotSummary.ConnectionsByWaitEventEntry
-	26, // 5: database_monitoring.v1.SnapshotSummary.time_ms_by_wait_event:type_name -> database_monitoring.v1.SnapshotSummary.TimeMsByWaitEventEntry
-	1,  // 6: database_monitoring.v1.ListSnapshotSummariesResponse.snap_summaries:type_name -> database_monitoring.v1.SnapshotSummary
-	32, // 7: database_monitoring.v1.ListQueryMetricsRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 8: database_monitoring.v1.ListQueryMetricsRequest.end:type_name -> google.protobuf.Timestamp
-	34, // 9: database_monitoring.v1.ListQueryMetricsResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
-	32, // 10: database_monitoring.v1.GetQueryMetricsRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 11: database_monitoring.v1.GetQueryMetricsRequest.end:type_name -> google.protobuf.Timestamp
-	34, // 12: database_monitoring.v1.GetQueryMetricsResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
-	32, // 13: database_monitoring.v1.GetQueryMetricsTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 14: database_monitoring.v1.GetQueryMetricsTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
-	34, // 15: database_monitoring.v1.GetQueryMetricsTimeSeriesResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
-	35, // 16: database_monitoring.v1.GetSnapshotResponse.snapshot:type_name -> database_monitoring.v1.DBSnapshot
-	32, // 17: database_monitoring.v1.ListSnapshotsRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 18: database_monitoring.v1.ListSnapshotsRequest.end:type_name -> google.protobuf.Timestamp
-	35, // 19: database_monitoring.v1.ListSnapshotsResponse.snapshots:type_name -> database_monitoring.v1.DBSnapshot
-	32, // 20: database_monitoring.v1.ListServerSummaryRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 21: database_monitoring.v1.ListServerSummaryRequest.end:type_name -> google.protobuf.Timestamp
-	17, // 22: database_monitoring.v1.ListServerSummaryResponse.servers:type_name -> database_monitoring.v1.ServerSummary
-	32, // 23: database_monitoring.v1.ListServersRequest.start:type_name -> google.protobuf.Timestamp
-	32, // 24: database_monitoring.v1.ListServersRequest.end:type_name -> google.protobuf.Timestamp
-	33, // 25: database_monitoring.v1.ListServersResponse.servers:type_name -> database_monitoring.v1.ServerMetadata
-	27, // 26: database_monitoring.v1.ServerSummary.connections_by_wait_group:type_name -> database_monitoring.v1.ServerSummary.ConnectionsByWaitGroupEntry
-	28, // 27: database_monitoring.v1.BlockChain.roots:type_name -> database_monitoring.v1.BlockChain.BlockingNode
-	36, // 28: database_monitoring.v1.GetSampleDetailsResponse.query_sample:type_name -> database_monitoring.v1.QuerySample
-	37, // 29: database_monitoring.v1.GetSampleDetailsResponse.parsed_plan:type_name -> database_monitoring.v1.ParsedExecutionPlan
-	19, // 30: database_monitoring.v1.GetSampleDetailsResponse.block_chain:type_name -> database_monitoring.v1.BlockChain
-	32, // 31: database_monitoring.v1.GetNormalizedQueryDetailsRequest.start_time:type_name -> google.protobuf.Timestamp
-	32, // 32: database_monitoring.v1.GetNormalizedQueryDetailsRequest.end_time:type_name -> google.protobuf.Timestamp
-	32, // 33: database_monitoring.v1.GetNormalizedQueryRequest.start_time:type_name -> google.protobuf.Timestamp
-	32, // 34: database_monitoring.v1.GetNormalizedQueryRequest.end_time:type_name -> google.protobuf.Timestamp
-	29, // 35: database_monitoring.v1.GetNormalizedQueryResponse.connections_over_time:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint
-	30, // 36: database_monitoring.v1.GetNormalizedQueryResponse.execution_plans:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage
-	34, // 37: database_monitoring.v1.GetNormalizedQueryResponse.query_metrics:type_name -> database_monitoring.v1.QueryMetric
-	19, // 38: database_monitoring.v1.GetNormalizedQueryResponse.blocking_activity:type_name -> database_monitoring.v1.BlockChain
-	36, // 39: database_monitoring.v1.BlockChain.BlockingNode.query_sample:type_name -> database_monitoring.v1.QuerySample
-	28, // 40: database_monitoring.v1.BlockChain.BlockingNode.child_nodes:type_name -> database_monitoring.v1.BlockChain.BlockingNode
-	31, // 41: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.connections_by_wait_type:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.ConnectionsByWaitTypeEntry
-	32, // 42: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.timestamp:type_name -> google.protobuf.Timestamp
-	38, // 43: database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage.exec_plan:type_name -> database_monitoring.v1.ExecutionPlan
-	11, // 44: database_monitoring.v1.DBMApi.ListSnapshots:input_type -> database_monitoring.v1.ListSnapshotsRequest
-	0,  // 45: database_monitoring.v1.DBMApi.ListSnapshotSummaries:input_type -> database_monitoring.v1.ListSnapshotSummariesRequest
-	9,  // 46: database_monitoring.v1.DBMApi.GetSnapshot:input_type -> database_monitoring.v1.GetSnapshotRequest
-	13, // 47: database_monitoring.v1.DBMApi.ListServerSummary:input_type -> database_monitoring.v1.ListServerSummaryRequest
-	15, // 48: database_monitoring.v1.DBMApi.ListServers:input_type -> database_monitoring.v1.ListServersRequest
-	3,  // 49: database_monitoring.v1.DBMApi.ListQueryMetrics:input_type -> database_monitoring.v1.ListQueryMetricsRequest
-	5,  // 50: database_monitoring.v1.DBMApi.GetQueryMetrics:input_type -> database_monitoring.v1.GetQueryMetricsRequest
-	7,  // 51: database_monitoring.v1.DBMApi.GetQueryMetricsTimeSeries:input_type -> database_monitoring.v1.GetQueryMetricsTimeSeriesRequest
-	18, // 52: database_monitoring.v1.DBMApi.GetSampleDetails:input_type -> database_monitoring.v1.GetSampleDetailsRequest
-	23, // 53: database_monitoring.v1.DBMApi.GetNormalizedQuery:input_type -> database_monitoring.v1.GetNormalizedQueryRequest
-	12, // 54: database_monitoring.v1.DBMApi.ListSnapshots:output_type -> database_monitoring.v1.ListSnapshotsResponse
-	2,  // 55: database_monitoring.v1.DBMApi.ListSnapshotSummaries:output_type -> database_monitoring.v1.ListSnapshotSummariesResponse
-	10, // 56: database_monitoring.v1.DBMApi.GetSnapshot:output_type -> database_monitoring.v1.GetSnapshotResponse
-	14, // 57: database_monitoring.v1.DBMApi.ListServerSummary:output_type -> database_monitoring.v1.ListServerSummaryResponse
-	16, // 58: database_monitoring.v1.DBMApi.ListServers:output_type -> database_monitoring.v1.ListServersResponse
-	4,  // 59: database_monitoring.v1.DBMApi.ListQueryMetrics:output_type -> database_monitoring.v1.ListQueryMetricsResponse
-	6,  // 60: database_monitoring.v1.DBMApi.GetQueryMetrics:output_type -> database_monitoring.v1.GetQueryMetricsResponse
-	8,  // 61: database_monitoring.v1.DBMApi.GetQueryMetricsTimeSeries:output_type -> database_monitoring.v1.GetQueryMetricsTimeSeriesResponse
-	20, // 62: database_monitoring.v1.DBMApi.GetSampleDetails:output_type -> database_monitoring.v1.GetSampleDetailsResponse
-	24, // 63: database_monitoring.v1.DBMApi.GetNormalizedQuery:output_type -> database_monitoring.v1.GetNormalizedQueryResponse
-	54, // [54:64] is the sub-list for method output_type
-	44, // [44:54] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	62,  // 0: database_monitoring.v1.ListSnapshotSummariesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 1: database_monitoring.v1.ListSnapshotSummariesRequest.end:type_name -> google.protobuf.Timestamp
+	62,  // 2: database_monitoring.v1.SnapshotSummary.timestamp:type_name -> google.protobuf.Timestamp
+	63,  // 3: database_monitoring.v1.SnapshotSummary.server:type_name -> database_monitoring.v1.ServerMetadata
+	55,  // 4: database_monitoring.v1.SnapshotSummary.connections_by_wait_event:type_name -> database_monitoring.v1.SnapshotSummary.ConnectionsByWaitEventEntry
+	56,  // 5: database_monitoring.v1.SnapshotSummary.time_ms_by_wait_event:type_name -> database_monitoring.v1.SnapshotSummary.TimeMsByWaitEventEntry
+	1,   // 6: database_monitoring.v1.ListSnapshotSummariesResponse.snap_summaries:type_name -> database_monitoring.v1.SnapshotSummary
+	62,  // 7: database_monitoring.v1.ListQueryMetricsRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 8: database_monitoring.v1.ListQueryMetricsRequest.end:type_name -> google.protobuf.Timestamp
+	64,  // 9: database_monitoring.v1.ListQueryMetricsResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
+	62,  // 10: database_monitoring.v1.GetQueryMetricsRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 11: database_monitoring.v1.GetQueryMetricsRequest.end:type_name -> google.protobuf.Timestamp
+	64,  // 12: database_monitoring.v1.GetQueryMetricsResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
+	62,  // 13: database_monitoring.v1.GetQueryMetricsTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 14: database_monitoring.v1.GetQueryMetricsTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	64,  // 15: database_monitoring.v1.GetQueryMetricsTimeSeriesResponse.metrics:type_name -> database_monitoring.v1.QueryMetric
+	65,  // 16: database_monitoring.v1.GetSnapshotResponse.snapshot:type_name -> database_monitoring.v1.DBSnapshot
+	62,  // 17: database_monitoring.v1.ListSnapshotsRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 18: database_monitoring.v1.ListSnapshotsRequest.end:type_name -> google.protobuf.Timestamp
+	65,  // 19: database_monitoring.v1.ListSnapshotsResponse.snapshots:type_name -> database_monitoring.v1.DBSnapshot
+	62,  // 20: database_monitoring.v1.ListServerSummaryRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 21: database_monitoring.v1.ListServerSummaryRequest.end:type_name -> google.protobuf.Timestamp
+	17,  // 22: database_monitoring.v1.ListServerSummaryResponse.servers:type_name -> database_monitoring.v1.ServerSummary
+	62,  // 23: database_monitoring.v1.ListServersRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 24: database_monitoring.v1.ListServersRequest.end:type_name -> google.protobuf.Timestamp
+	63,  // 25: database_monitoring.v1.ListServersResponse.servers:type_name -> database_monitoring.v1.ServerMetadata
+	57,  // 26: database_monitoring.v1.ServerSummary.connections_by_wait_group:type_name -> database_monitoring.v1.ServerSummary.ConnectionsByWaitGroupEntry
+	58,  // 27: database_monitoring.v1.BlockChain.roots:type_name -> database_monitoring.v1.BlockChain.BlockingNode
+	66,  // 28: database_monitoring.v1.GetSampleDetailsResponse.query_sample:type_name -> database_monitoring.v1.QuerySample
+	67,  // 29: database_monitoring.v1.GetSampleDetailsResponse.parsed_plan:type_name -> database_monitoring.v1.ParsedExecutionPlan
+	19,  // 30: database_monitoring.v1.GetSampleDetailsResponse.block_chain:type_name -> database_monitoring.v1.BlockChain
+	68,  // 31: database_monitoring.v1.GetSampleDetailsResponse.lock_conflict:type_name -> database_monitoring.v1.LockConflict
+	62,  // 32: database_monitoring.v1.GetNormalizedQueryDetailsRequest.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 33: database_monitoring.v1.GetNormalizedQueryDetailsRequest.end_time:type_name -> google.protobuf.Timestamp
+	62,  // 34: database_monitoring.v1.GetNormalizedQueryRequest.start_time:type_name -> google.protobuf.Timestamp
+	62,  // 35: database_monitoring.v1.GetNormalizedQueryRequest.end_time:type_name -> google.protobuf.Timestamp
+	59,  // 36: database_monitoring.v1.GetNormalizedQueryResponse.connections_over_time:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint
+	60,  // 37: database_monitoring.v1.GetNormalizedQueryResponse.execution_plans:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage
+	64,  // 38: database_monitoring.v1.GetNormalizedQueryResponse.query_metrics:type_name -> database_monitoring.v1.QueryMetric
+	19,  // 39: database_monitoring.v1.GetNormalizedQueryResponse.blocking_activity:type_name -> database_monitoring.v1.BlockChain
+	62,  // 40: database_monitoring.v1.ListDeadlocksRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 41: database_monitoring.v1.ListDeadlocksRequest.end:type_name -> google.protobuf.Timestamp
+	69,  // 42: database_monitoring.v1.ListDeadlocksResponse.deadlocks:type_name -> database_monitoring.v1.Deadlock
+	69,  // 43: database_monitoring.v1.GetDeadlockResponse.deadlock:type_name -> database_monitoring.v1.Deadlock
+	70,  // 44: database_monitoring.v1.ListAgentsResponse.agents:type_name -> database_monitoring.v1.Agent
+	70,  // 45: database_monitoring.v1.GetAgentResponse.agent:type_name -> database_monitoring.v1.Agent
+	71,  // 46: database_monitoring.v1.GetTargetCollectionConfigResponse.config:type_name -> database_monitoring.v1.TargetCollectionConfig
+	71,  // 47: database_monitoring.v1.SetTargetCollectionConfigRequest.config:type_name -> database_monitoring.v1.TargetCollectionConfig
+	71,  // 48: database_monitoring.v1.SetTargetCollectionConfigResponse.config:type_name -> database_monitoring.v1.TargetCollectionConfig
+	62,  // 49: database_monitoring.v1.GetWaitStatsTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 50: database_monitoring.v1.GetWaitStatsTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	72,  // 51: database_monitoring.v1.GetWaitStatsTimeSeriesResponse.series:type_name -> database_monitoring.v1.WaitStatSeries
+	62,  // 52: database_monitoring.v1.GetPerformanceCountersTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 53: database_monitoring.v1.GetPerformanceCountersTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	73,  // 54: database_monitoring.v1.GetPerformanceCountersTimeSeriesResponse.series:type_name -> database_monitoring.v1.PerformanceCounterSeries
+	62,  // 55: database_monitoring.v1.GetFileIOTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 56: database_monitoring.v1.GetFileIOTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	74,  // 57: database_monitoring.v1.GetFileIOTimeSeriesResponse.series:type_name -> database_monitoring.v1.FileIOSeries
+	62,  // 58: database_monitoring.v1.GetIndexReviewRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 59: database_monitoring.v1.GetIndexReviewRequest.end:type_name -> google.protobuf.Timestamp
+	75,  // 60: database_monitoring.v1.GetIndexReviewResponse.unused:type_name -> database_monitoring.v1.IndexUsage
+	75,  // 61: database_monitoring.v1.GetIndexReviewResponse.write_heavy:type_name -> database_monitoring.v1.IndexUsage
+	76,  // 62: database_monitoring.v1.GetIndexReviewResponse.missing_indexes:type_name -> database_monitoring.v1.MissingIndex
+	62,  // 63: database_monitoring.v1.GetQueryPlanHistoryRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 64: database_monitoring.v1.GetQueryPlanHistoryRequest.end:type_name -> google.protobuf.Timestamp
+	77,  // 65: database_monitoring.v1.GetQueryPlanHistoryResponse.plans:type_name -> database_monitoring.v1.QueryPlanHistory
+	62,  // 66: database_monitoring.v1.ListJobRunsRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 67: database_monitoring.v1.ListJobRunsRequest.end:type_name -> google.protobuf.Timestamp
+	78,  // 68: database_monitoring.v1.ListJobRunsResponse.runs:type_name -> database_monitoring.v1.JobRunReview
+	79,  // 69: database_monitoring.v1.GetAvailabilityGroupTopologyResponse.availability_groups:type_name -> database_monitoring.v1.AvailabilityGroupTopology
+	62,  // 70: database_monitoring.v1.GetReplicaStatesTimeSeriesRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 71: database_monitoring.v1.GetReplicaStatesTimeSeriesRequest.end:type_name -> google.protobuf.Timestamp
+	80,  // 72: database_monitoring.v1.GetReplicaStatesTimeSeriesResponse.series:type_name -> database_monitoring.v1.ReplicaStateSeries
+	62,  // 73: database_monitoring.v1.GetFileGrowthTrendRequest.start:type_name -> google.protobuf.Timestamp
+	62,  // 74: database_monitoring.v1.GetFileGrowthTrendRequest.end:type_name -> google.protobuf.Timestamp
+	81,  // 75: database_monitoring.v1.GetFileGrowthTrendResponse.trends:type_name -> database_monitoring.v1.FileGrowthTrend
+	66,  // 76: database_monitoring.v1.BlockChain.BlockingNode.query_sample:type_name -> database_monitoring.v1.QuerySample
+	58,  // 77: database_monitoring.v1.BlockChain.BlockingNode.child_nodes:type_name -> database_monitoring.v1.BlockChain.BlockingNode
+	68,  // 78: database_monitoring.v1.BlockChain.BlockingNode.lock_conflict:type_name -> database_monitoring.v1.LockConflict
+	61,  // 79: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.connections_by_wait_type:type_name -> database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.ConnectionsByWaitTypeEntry
+	62,  // 80: database_monitoring.v1.GetNormalizedQueryResponse.ConnectionsDataPoint.timestamp:type_name -> google.protobuf.Timestamp
+	82,  // 81: database_monitoring.v1.GetNormalizedQueryResponse.ExecutionPlanUsage.exec_plan:type_name -> database_monitoring.v1.ExecutionPlan
+	11,  // 82: database_monitoring.v1.DBMApi.ListSnapshots:input_type -> database_monitoring.v1.ListSnapshotsRequest
+	0,   // 83: database_monitoring.v1.DBMApi.ListSnapshotSummaries:input_type -> database_monitoring.v1.ListSnapshotSummariesRequest
+	9,   // 84: database_monitoring.v1.DBMApi.GetSnapshot:input_type -> database_monitoring.v1.GetSnapshotRequest
+	13,  // 85: database_monitoring.v1.DBMApi.ListServerSummary:input_type -> database_monitoring.v1.ListServerSummaryRequest
+	15,  // 86: database_monitoring.v1.DBMApi.ListServers:input_type -> database_monitoring.v1.ListServersRequest
+	3,   // 87: database_monitoring.v1.DBMApi.ListQueryMetrics:input_type -> database_monitoring.v1.ListQueryMetricsRequest
+	5,   // 88: database_monitoring.v1.DBMApi.GetQueryMetrics:input_type -> database_monitoring.v1.GetQueryMetricsRequest
+	7,   // 89: database_monitoring.v1.DBMApi.GetQueryMetricsTimeSeries:input_type -> database_monitoring.v1.GetQueryMetricsTimeSeriesRequest
+	18,  // 90: database_monitoring.v1.DBMApi.GetSampleDetails:input_type -> database_monitoring.v1.GetSampleDetailsRequest
+	23,  // 91: database_monitoring.v1.DBMApi.GetNormalizedQuery:input_type -> database_monitoring.v1.GetNormalizedQueryRequest
+	25,  // 92: database_monitoring.v1.DBMApi.ListDeadlocks:input_type -> database_monitoring.v1.ListDeadlocksRequest
+	27,  // 93: database_monitoring.v1.DBMApi.GetDeadlock:input_type -> database_monitoring.v1.GetDeadlockRequest
+	29,  // 94: database_monitoring.v1.DBMApi.ListAgents:input_type -> database_monitoring.v1.ListAgentsRequest
+	31,  // 95: database_monitoring.v1.DBMApi.GetAgent:input_type -> database_monitoring.v1.GetAgentRequest
+	33,  // 96: database_monitoring.v1.DBMApi.GetTargetCollectionConfig:input_type -> database_monitoring.v1.GetTargetCollectionConfigRequest
+	35,  // 97: database_monitoring.v1.DBMApi.SetTargetCollectionConfig:input_type -> database_monitoring.v1.SetTargetCollectionConfigRequest
+	37,  // 98: database_monitoring.v1.DBMApi.GetWaitStatsTimeSeries:input_type -> database_monitoring.v1.GetWaitStatsTimeSeriesRequest
+	39,  // 99: database_monitoring.v1.DBMApi.GetPerformanceCountersTimeSeries:input_type -> database_monitoring.v1.GetPerformanceCountersTimeSeriesRequest
+	41,  // 100: database_monitoring.v1.DBMApi.GetFileIOTimeSeries:input_type -> database_monitoring.v1.GetFileIOTimeSeriesRequest
+	43,  // 101: database_monitoring.v1.DBMApi.GetIndexReview:input_type -> database_monitoring.v1.GetIndexReviewRequest
+	45,  // 102: database_monitoring.v1.DBMApi.GetQueryPlanHistory:input_type -> database_monitoring.v1.GetQueryPlanHistoryRequest
+	47,  // 103: database_monitoring.v1.DBMApi.ListJobRuns:input_type -> database_monitoring.v1.ListJobRunsRequest
+	49,  // 104: database_monitoring.v1.DBMApi.GetAvailabilityGroupTopology:input_type -> database_monitoring.v1.GetAvailabilityGroupTopologyRequest
+	51,  // 105: database_monitoring.v1.DBMApi.GetReplicaStatesTimeSeries:input_type -> database_monitoring.v1.GetReplicaStatesTimeSeriesRequest
+	53,  // 106: database_monitoring.v1.DBMApi.GetFileGrowthTrend:input_type -> database_monitoring.v1.GetFileGrowthTrendRequest
+	12,  // 107: database_monitoring.v1.DBMApi.ListSnapshots:output_type -> database_monitoring.v1.ListSnapshotsResponse
+	2,   // 108: database_monitoring.v1.DBMApi.ListSnapshotSummaries:output_type -> database_monitoring.v1.ListSnapshotSummariesResponse
+	10,  // 109: database_monitoring.v1.DBMApi.GetSnapshot:output_type -> database_monitoring.v1.GetSnapshotResponse
+	14,  // 110: database_monitoring.v1.DBMApi.ListServerSummary:output_type -> database_monitoring.v1.ListServerSummaryResponse
+	16,  // 111: database_monitoring.v1.DBMApi.ListServers:output_type -> database_monitoring.v1.ListServersResponse
+	4,   // 112: database_monitoring.v1.DBMApi.ListQueryMetrics:output_type -> database_monitoring.v1.ListQueryMetricsResponse
+	6,   // 113: database_monitoring.v1.DBMApi.GetQueryMetrics:output_type -> database_monitoring.v1.GetQueryMetricsResponse
+	8,   // 114: database_monitoring.v1.DBMApi.GetQueryMetricsTimeSeries:output_type -> database_monitoring.v1.GetQueryMetricsTimeSeriesResponse
+	20,  // 115: database_monitoring.v1.DBMApi.GetSampleDetails:output_type -> database_monitoring.v1.GetSampleDetailsResponse
+	24,  // 116: database_monitoring.v1.DBMApi.GetNormalizedQuery:output_type -> database_monitoring.v1.GetNormalizedQueryResponse
+	26,  // 117: database_monitoring.v1.DBMApi.ListDeadlocks:output_type -> database_monitoring.v1.ListDeadlocksResponse
+	28,  // 118: database_monitoring.v1.DBMApi.GetDeadlock:output_type -> database_monitoring.v1.GetDeadlockResponse
+	30,  // 119: database_monitoring.v1.DBMApi.ListAgents:output_type -> database_monitoring.v1.ListAgentsResponse
+	32,  // 120: database_monitoring.v1.DBMApi.GetAgent:output_type -> database_monitoring.v1.GetAgentResponse
+	34,  // 121: database_monitoring.v1.DBMApi.GetTargetCollectionConfig:output_type -> database_monitoring.v1.GetTargetCollectionConfigResponse
+	36,  // 122: database_monitoring.v1.DBMApi.SetTargetCollectionConfig:output_type -> database_monitoring.v1.SetTargetCollectionConfigResponse
+	38,  // 123: database_monitoring.v1.DBMApi.GetWaitStatsTimeSeries:output_type -> database_monitoring.v1.GetWaitStatsTimeSeriesResponse
+	40,  // 124: database_monitoring.v1.DBMApi.GetPerformanceCountersTimeSeries:output_type -> database_monitoring.v1.GetPerformanceCountersTimeSeriesResponse
+	42,  // 125: database_monitoring.v1.DBMApi.GetFileIOTimeSeries:output_type -> database_monitoring.v1.GetFileIOTimeSeriesResponse
+	44,  // 126: database_monitoring.v1.DBMApi.GetIndexReview:output_type -> database_monitoring.v1.GetIndexReviewResponse
+	46,  // 127: database_monitoring.v1.DBMApi.GetQueryPlanHistory:output_type -> database_monitoring.v1.GetQueryPlanHistoryResponse
+	48,  // 128: database_monitoring.v1.DBMApi.ListJobRuns:output_type -> database_monitoring.v1.ListJobRunsResponse
+	50,  // 129: database_monitoring.v1.DBMApi.GetAvailabilityGroupTopology:output_type -> database_monitoring.v1.GetAvailabilityGroupTopologyResponse
+	52,  // 130: database_monitoring.v1.DBMApi.GetReplicaStatesTimeSeries:output_type -> database_monitoring.v1.GetReplicaStatesTimeSeriesResponse
+	54,  // 131: database_monitoring.v1.DBMApi.GetFileGrowthTrend:output_type -> database_monitoring.v1.GetFileGrowthTrendResponse
+	107, // [107:132] is the sub-list for method output_type
+	82,  // [82:107] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_dbm_api_proto_init() }
@@ -1929,13 +3811,21 @@ func file_database_monitoring_v1_dbm_api_proto_init() {
 	file_database_monitoring_v1_snapshot_proto_init()
 	file_database_monitoring_v1_sample_proto_init()
 	file_database_monitoring_v1_execution_plan_proto_init()
+	file_database_monitoring_v1_deadlock_proto_init()
+	file_database_monitoring_v1_agent_proto_init()
+	file_database_monitoring_v1_system_metrics_proto_init()
+	file_database_monitoring_v1_index_stats_proto_init()
+	file_database_monitoring_v1_query_store_proto_init()
+	file_database_monitoring_v1_job_proto_init()
+	file_database_monitoring_v1_availability_group_proto_init()
+	file_database_monitoring_v1_file_size_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_dbm_api_proto_rawDesc), len(file_database_monitoring_v1_dbm_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DBMApi_ListSnapshots_FullMethodName             = "/database_monitoring.v1.DBMApi/ListSnapshots"
-	DBMApi_ListSnapshotSummaries_FullMethodName     = "/database_monitoring.v1.DBMApi/ListSnapshotSummaries"
-	DBMApi_GetSnapshot_FullMethodName               = "/database_monitoring.v1.DBMApi/GetSnapshot"
-	DBMApi_ListServerSummary_FullMethodName         = "/database_monitoring.v1.DBMApi/ListServerSummary"
-	DBMApi_ListServers_FullMethodName               = "/database_monitoring.v1.DBMApi/ListServers"
-	DBMApi_ListQueryMetrics_FullMethodName          = "/database_monitoring.v1.DBMApi/ListQueryMetrics"
-	DBMApi_GetQueryMetrics_FullMethodName           = "/database_monitoring.v1.DBMApi/GetQueryMetrics"
-	DBMApi_GetQueryMetricsTimeSeries_FullMethodName = "/database_monitoring.v1.DBMApi/GetQueryMetricsTimeSeries"
-	DBMApi_GetSampleDetails_FullMethodName          = "/database_monitoring.v1.DBMApi/GetSampleDetails"
-	DBMApi_GetNormalizedQuery_FullMethodName        = "/database_monitoring.v1.DBMApi/GetNormalizedQuery"
+	DBMApi_ListSnapshots_FullMethodName                    = "/database_monitoring.v1.DBMApi/ListSnapshots"
+	DBMApi_ListSnapshotSummaries_FullMethodName            = "/database_monitoring.v1.DBMApi/ListSnapshotSummaries"
+	DBMApi_GetSnapshot_FullMethodName                      = "/database_monitoring.v1.DBMApi/GetSnapshot"
+	DBMApi_ListServerSummary_FullMethodName                = "/database_monitoring.v1.DBMApi/ListServerSummary"
+	DBMApi_ListServers_FullMethodName                      = "/database_monitoring.v1.DBMApi/ListServers"
+	DBMApi_ListQueryMetrics_FullMethodName                 = "/database_monitoring.v1.DBMApi/ListQueryMetrics"
+	DBMApi_GetQueryMetrics_FullMethodName                  = "/database_monitoring.v1.DBMApi/GetQueryMetrics"
+	DBMApi_GetQueryMetricsTimeSeries_FullMethodName        = "/database_monitoring.v1.DBMApi/GetQueryMetricsTimeSeries"
+	DBMApi_GetSampleDetails_FullMethodName                 = "/database_monitoring.v1.DBMApi/GetSampleDetails"
+	DBMApi_GetNormalizedQuery_FullMethodName               = "/database_monitoring.v1.DBMApi/GetNormalizedQuery"
+	DBMApi_ListDeadlocks_FullMethodName                    = "/database_monitoring.v1.DBMApi/ListDeadlocks"
+	DBMApi_GetDeadlock_FullMethodName                      = "/database_monitoring.v1.DBMApi/GetDeadlock"
+	DBMApi_ListAgents_FullMethodName                       = "/database_monitoring.v1.DBMApi/ListAgents"
+	DBMApi_GetAgent_FullMethodName                         = "/database_monitoring.v1.DBMApi/GetAgent"
+	DBMApi_GetTargetCollectionConfig_FullMethodName        = "/database_monitoring.v1.DBMApi/GetTargetCollectionConfig"
+	DBMApi_SetTargetCollectionConfig_FullMethodName        = "/database_monitoring.v1.DBMApi/SetTargetCollectionConfig"
+	DBMApi_GetWaitStatsTimeSeries_FullMethodName           = "/database_monitoring.v1.DBMApi/GetWaitStatsTimeSeries"
+	DBMApi_GetPerformanceCountersTimeSeries_FullMethodName = "/database_monitoring.v1.DBMApi/GetPerformanceCountersTimeSeries"
+	DBMApi_GetFileIOTimeSeries_FullMethodName              = "/database_monitoring.v1.DBMApi/GetFileIOTimeSeries"
+	DBMApi_GetIndexReview_FullMethodName                   = "/database_monitoring.v1.DBMApi/GetIndexReview"
+	DBMApi_GetQueryPlanHistory_FullMethodName              = "/database_monitoring.v1.DBMApi/GetQueryPlanHistory"
+	DBMApi_ListJobRuns_FullMethodName                      = "/database_monitoring.v1.DBMApi/ListJobRuns"
+	DBMApi_GetAvailabilityGroupTopology_FullMethodName     = "/database_monitoring.v1.DBMApi/GetAvailabilityGroupTopology"
+	DBMApi_GetReplicaStatesTimeSeries_FullMethodName       = "/database_monitoring.v1.DBMApi/GetReplicaStatesTimeSeries"
+	DBMApi_GetFileGrowthTrend_FullMethodName               = "/database_monitoring.v1.DBMApi/GetFileGrowthTrend"
 )
 
 // DBMApiClient is the client API for DBMApi service.
@@ -45,6 +60,21 @@ type DBMApiClient interface {
 	GetQueryMetricsTimeSeries(ctx context.Context, in *GetQueryMetricsTimeSeriesRequest, opts ...grpc.CallOption) (*GetQueryMetricsTimeSeriesResponse, error)
 	GetSampleDetails(ctx context.Context, in *GetSampleDetailsRequest, opts ...grpc.CallOption) (*GetSampleDetailsResponse, error)
 	GetNormalizedQuery(ctx context.Context, in *GetNormalizedQueryRequest, opts ...grpc.CallOption) (*GetNormalizedQueryResponse, error)
+	ListDeadlocks(ctx context.Context, in *ListDeadlocksRequest, opts ...grpc.CallOption) (*ListDeadlocksResponse, error)
+	GetDeadlock(ctx context.Context, in *GetDeadlockRequest, opts ...grpc.CallOption) (*GetDeadlockResponse, error)
+	ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error)
+	GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error)
+	GetTargetCollectionConfig(ctx context.Context, in *GetTargetCollectionConfigRequest, opts ...grpc.CallOption) (*GetTargetCollectionConfigResponse, error)
+	SetTargetCollectionConfig(ctx context.Context, in *SetTargetCollectionConfigRequest, opts ...grpc.CallOption) (*SetTargetCollectionConfigResponse, error)
+	GetWaitStatsTimeSeries(ctx context.Context, in *GetWaitStatsTimeSeriesRequest, opts ...grpc.CallOption) (*GetWaitStatsTimeSeriesResponse, error)
+	GetPerformanceCountersTimeSeries(ctx context.Context, in *GetPerformanceCountersTimeSeriesRequest, opts ...grpc.CallOption) (*GetPerformanceCountersTimeSeriesResponse, error)
+	GetFileIOTimeSeries(ctx context.Context, in *GetFileIOTimeSeriesRequest, opts ...grpc.CallOption) (*GetFileIOTimeSeriesResponse, error)
+	GetIndexReview(ctx context.Context, in *GetIndexReviewRequest, opts ...grpc.CallOption) (*GetIndexReviewResponse, error)
+	GetQueryPlanHistory(ctx context.Context, in *GetQueryPlanHistoryRequest, opts ...grpc.CallOption) (*GetQueryPlanHistoryResponse, error)
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error)
+	GetAvailabilityGroupTopology(ctx context.Context, in *GetAvailabilityGroupTopologyRequest, opts ...grpc.CallOption) (*GetAvailabilityGroupTopologyResponse, error)
+	GetReplicaStatesTimeSeries(ctx context.Context, in *GetReplicaStatesTimeSeriesRequest, opts ...grpc.CallOption) (*GetReplicaStatesTimeSeriesResponse, error)
+	GetFileGrowthTrend(ctx context.Context, in *GetFileGrowthTrendRequest, opts ...grpc.CallOption) (*GetFileGrowthTrendResponse, error)
 }
 
 type dBMApiClient struct {
@@ -155,6 +185,156 @@ func (c *dBMApiClient) GetNormalizedQuery(ctx context.Context, in *GetNormalized
 	return out, nil
 }
 
+func (c *dBMApiClient) ListDeadlocks(ctx context.Context, in *ListDeadlocksRequest, opts ...grpc.CallOption) (*ListDeadlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadlocksResponse)
+	err := c.cc.Invoke(ctx, DBMApi_ListDeadlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetDeadlock(ctx context.Context, in *GetDeadlockRequest, opts ...grpc.CallOption) (*GetDeadlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeadlockResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetDeadlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) ListAgents(ctx context.Context, in *ListAgentsRequest, opts ...grpc.CallOption) (*ListAgentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentsResponse)
+	err := c.cc.Invoke(ctx, DBMApi_ListAgents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetAgent(ctx context.Context, in *GetAgentRequest, opts ...grpc.CallOption) (*GetAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgentResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetTargetCollectionConfig(ctx context.Context, in *GetTargetCollectionConfigRequest, opts ...grpc.CallOption) (*GetTargetCollectionConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTargetCollectionConfigResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetTargetCollectionConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) SetTargetCollectionConfig(ctx context.Context, in *SetTargetCollectionConfigRequest, opts ...grpc.CallOption) (*SetTargetCollectionConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTargetCollectionConfigResponse)
+	err := c.cc.Invoke(ctx, DBMApi_SetTargetCollectionConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetWaitStatsTimeSeries(ctx context.Context, in *GetWaitStatsTimeSeriesRequest, opts ...grpc.CallOption) (*GetWaitStatsTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitStatsTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetWaitStatsTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetPerformanceCountersTimeSeries(ctx context.Context, in *GetPerformanceCountersTimeSeriesRequest, opts ...grpc.CallOption) (*GetPerformanceCountersTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPerformanceCountersTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetPerformanceCountersTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetFileIOTimeSeries(ctx context.Context, in *GetFileIOTimeSeriesRequest, opts ...grpc.CallOption) (*GetFileIOTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileIOTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetFileIOTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetIndexReview(ctx context.Context, in *GetIndexReviewRequest, opts ...grpc.CallOption) (*GetIndexReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIndexReviewResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetIndexReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetQueryPlanHistory(ctx context.Context, in *GetQueryPlanHistoryRequest, opts ...grpc.CallOption) (*GetQueryPlanHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueryPlanHistoryResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetQueryPlanHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobRunsResponse)
+	err := c.cc.Invoke(ctx, DBMApi_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetAvailabilityGroupTopology(ctx context.Context, in *GetAvailabilityGroupTopologyRequest, opts ...grpc.CallOption) (*GetAvailabilityGroupTopologyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityGroupTopologyResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetAvailabilityGroupTopology_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetReplicaStatesTimeSeries(ctx context.Context, in *GetReplicaStatesTimeSeriesRequest, opts ...grpc.CallOption) (*GetReplicaStatesTimeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReplicaStatesTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetReplicaStatesTimeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dBMApiClient) GetFileGrowthTrend(ctx context.Context, in *GetFileGrowthTrendRequest, opts ...grpc.CallOption) (*GetFileGrowthTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileGrowthTrendResponse)
+	err := c.cc.Invoke(ctx, DBMApi_GetFileGrowthTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DBMApiServer is the server API for DBMApi service.
 // All implementations must embed UnimplementedDBMApiServer
 // for forward compatibility.
@@ -169,6 +349,21 @@ type DBMApiServer interface {
 	GetQueryMetricsTimeSeries(context.Context, *GetQueryMetricsTimeSeriesRequest) (*GetQueryMetricsTimeSeriesResponse, error)
 	GetSampleDetails(context.Context, *GetSampleDetailsRequest) (*GetSampleDetailsResponse, error)
 	GetNormalizedQuery(context.Context, *GetNormalizedQueryRequest) (*GetNormalizedQueryResponse, error)
+	ListDeadlocks(context.Context, *ListDeadlocksRequest) (*ListDeadlocksResponse, error)
+	GetDeadlock(context.Context, *GetDeadlockRequest) (*GetDeadlockResponse, error)
+	ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error)
+	GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error)
+	GetTargetCollectionConfig(context.Context, *GetTargetCollectionConfigRequest) (*GetTargetCollectionConfigResponse, error)
+	SetTargetCollectionConfig(context.Context, *SetTargetCollectionConfigRequest) (*SetTargetCollectionConfigResponse, error)
+	GetWaitStatsTimeSeries(context.Context, *GetWaitStatsTimeSeriesRequest) (*GetWaitStatsTimeSeriesResponse, error)
+	GetPerformanceCountersTimeSeries(context.Context, *GetPerformanceCountersTimeSeriesRequest) (*GetPerformanceCountersTimeSeriesResponse, error)
+	GetFileIOTimeSeries(context.Context, *GetFileIOTimeSeriesRequest) (*GetFileIOTimeSeriesResponse, error)
+	GetIndexReview(context.Context, *GetIndexReviewRequest) (*GetIndexReviewResponse, error)
+	GetQueryPlanHistory(context.Context, *GetQueryPlanHistoryRequest) (*GetQueryPlanHistoryResponse, error)
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error)
+	GetAvailabilityGroupTopology(context.Context, *GetAvailabilityGroupTopologyRequest) (*GetAvailabilityGroupTopologyResponse, error)
+	GetReplicaStatesTimeSeries(context.Context, *GetReplicaStatesTimeSeriesRequest) (*GetReplicaStatesTimeSeriesResponse, error)
+	GetFileGrowthTrend(context.Context, *GetFileGrowthTrendRequest) (*GetFileGrowthTrendResponse, error)
 	mustEmbedUnimplementedDBMApiServer()
 }
 
@@ -209,6 +404,51 @@ func (UnimplementedDBMApiServer) GetSampleDetails(context.Context, *GetSampleDet
 func (UnimplementedDBMApiServer) GetNormalizedQuery(context.Context, *GetNormalizedQueryRequest) (*GetNormalizedQueryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetNormalizedQuery not implemented")
 }
+func (UnimplementedDBMApiServer) ListDeadlocks(context.Context, *ListDeadlocksRequest) (*ListDeadlocksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeadlocks not implemented")
+}
+func (UnimplementedDBMApiServer) GetDeadlock(context.Context, *GetDeadlockRequest) (*GetDeadlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDeadlock not implemented")
+}
+func (UnimplementedDBMApiServer) ListAgents(context.Context, *ListAgentsRequest) (*ListAgentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAgents not implemented")
+}
+func (UnimplementedDBMApiServer) GetAgent(context.Context, *GetAgentRequest) (*GetAgentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAgent not implemented")
+}
+func (UnimplementedDBMApiServer) GetTargetCollectionConfig(context.Context, *GetTargetCollectionConfigRequest) (*GetTargetCollectionConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTargetCollectionConfig not implemented")
+}
+func (UnimplementedDBMApiServer) SetTargetCollectionConfig(context.Context, *SetTargetCollectionConfigRequest) (*SetTargetCollectionConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTargetCollectionConfig not implemented")
+}
+func (UnimplementedDBMApiServer) GetWaitStatsTimeSeries(context.Context, *GetWaitStatsTimeSeriesRequest) (*GetWaitStatsTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWaitStatsTimeSeries not implemented")
+}
+func (UnimplementedDBMApiServer) GetPerformanceCountersTimeSeries(context.Context, *GetPerformanceCountersTimeSeriesRequest) (*GetPerformanceCountersTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPerformanceCountersTimeSeries not implemented")
+}
+func (UnimplementedDBMApiServer) GetFileIOTimeSeries(context.Context, *GetFileIOTimeSeriesRequest) (*GetFileIOTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileIOTimeSeries not implemented")
+}
+func (UnimplementedDBMApiServer) GetIndexReview(context.Context, *GetIndexReviewRequest) (*GetIndexReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIndexReview not implemented")
+}
+func (UnimplementedDBMApiServer) GetQueryPlanHistory(context.Context, *GetQueryPlanHistoryRequest) (*GetQueryPlanHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueryPlanHistory not implemented")
+}
+func (UnimplementedDBMApiServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobRuns not implemented")
+}
+func (UnimplementedDBMApiServer) GetAvailabilityGroupTopology(context.Context, *GetAvailabilityGroupTopologyRequest) (*GetAvailabilityGroupTopologyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailabilityGroupTopology not implemented")
+}
+func (UnimplementedDBMApiServer) GetReplicaStatesTimeSeries(context.Context, *GetReplicaStatesTimeSeriesRequest) (*GetReplicaStatesTimeSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReplicaStatesTimeSeries not implemented")
+}
+func (UnimplementedDBMApiServer) GetFileGrowthTrend(context.Context, *GetFileGrowthTrendRequest) (*GetFileGrowthTrendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileGrowthTrend not implemented")
+}
 func (UnimplementedDBMApiServer) mustEmbedUnimplementedDBMApiServer() {}
 func (UnimplementedDBMApiServer) testEmbeddedByValue()                {}
 
@@ -410,6 +650,276 @@ func _DBMApi_GetNormalizedQuery_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DBMApi_ListDeadlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).ListDeadlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_ListDeadlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).ListDeadlocks(ctx, req.(*ListDeadlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetDeadlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetDeadlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetDeadlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetDeadlock(ctx, req.(*GetDeadlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_ListAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).ListAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_ListAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).ListAgents(ctx, req.(*ListAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetAgent(ctx, req.(*GetAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetTargetCollectionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetCollectionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetTargetCollectionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetTargetCollectionConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetTargetCollectionConfig(ctx, req.(*GetTargetCollectionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_SetTargetCollectionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetCollectionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).SetTargetCollectionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_SetTargetCollectionConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).SetTargetCollectionConfig(ctx, req.(*SetTargetCollectionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetWaitStatsTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitStatsTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetWaitStatsTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetWaitStatsTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetWaitStatsTimeSeries(ctx, req.(*GetWaitStatsTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetPerformanceCountersTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPerformanceCountersTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetPerformanceCountersTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetPerformanceCountersTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetPerformanceCountersTimeSeries(ctx, req.(*GetPerformanceCountersTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetFileIOTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileIOTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetFileIOTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetFileIOTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetFileIOTimeSeries(ctx, req.(*GetFileIOTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetIndexReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetIndexReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetIndexReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetIndexReview(ctx, req.(*GetIndexReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetQueryPlanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryPlanHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetQueryPlanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetQueryPlanHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetQueryPlanHistory(ctx, req.(*GetQueryPlanHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetAvailabilityGroupTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityGroupTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetAvailabilityGroupTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetAvailabilityGroupTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetAvailabilityGroupTopology(ctx, req.(*GetAvailabilityGroupTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetReplicaStatesTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicaStatesTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetReplicaStatesTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetReplicaStatesTimeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetReplicaStatesTimeSeries(ctx, req.(*GetReplicaStatesTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DBMApi_GetFileGrowthTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileGrowthTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DBMApiServer).GetFileGrowthTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DBMApi_GetFileGrowthTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DBMApiServer).GetFileGrowthTrend(ctx, req.(*GetFileGrowthTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DBMApi_ServiceDesc is the grpc.ServiceDesc for DBMApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +967,66 @@ var DBMApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNormalizedQuery",
 			Handler:    _DBMApi_GetNormalizedQuery_Handler,
 		},
+		{
+			MethodName: "ListDeadlocks",
+			Handler:    _DBMApi_ListDeadlocks_Handler,
+		},
+		{
+			MethodName: "GetDeadlock",
+			Handler:    _DBMApi_GetDeadlock_Handler,
+		},
+		{
+			MethodName: "ListAgents",
+			Handler:    _DBMApi_ListAgents_Handler,
+		},
+		{
+			MethodName: "GetAgent",
+			Handler:    _DBMApi_GetAgent_Handler,
+		},
+		{
+			MethodName: "GetTargetCollectionConfig",
+			Handler:    _DBMApi_GetTargetCollectionConfig_Handler,
+		},
+		{
+			MethodName: "SetTargetCollectionConfig",
+			Handler:    _DBMApi_SetTargetCollectionConfig_Handler,
+		},
+		{
+			MethodName: "GetWaitStatsTimeSeries",
+			Handler:    _DBMApi_GetWaitStatsTimeSeries_Handler,
+		},
+		{
+			MethodName: "GetPerformanceCountersTimeSeries",
+			Handler:    _DBMApi_GetPerformanceCountersTimeSeries_Handler,
+		},
+		{
+			MethodName: "GetFileIOTimeSeries",
+			Handler:    _DBMApi_GetFileIOTimeSeries_Handler,
+		},
+		{
+			MethodName: "GetIndexReview",
+			Handler:    _DBMApi_GetIndexReview_Handler,
+		},
+		{
+			MethodName: "GetQueryPlanHistory",
+			Handler:    _DBMApi_GetQueryPlanHistory_Handler,
+		},
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _DBMApi_ListJobRuns_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityGroupTopology",
+			Handler:    _DBMApi_GetAvailabilityGroupTopology_Handler,
+		},
+		{
+			MethodName: "GetReplicaStatesTimeSeries",
+			Handler:    _DBMApi_GetReplicaStatesTimeSeries_Handler,
+		},
+		{
+			MethodName: "GetFileGrowthTrend",
+			Handler:    _DBMApi_GetFileGrowthTrend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "database_monitoring/v1/dbm_api.proto",
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/deadlock.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Deadlock struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Server           *ServerMetadata        `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Timestamp        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VictimProcessIds []string               `protobuf:"bytes,4,rep,name=victim_process_ids,json=victimProcessIds,proto3" json:"victim_process_ids,omitempty"`
+	Processes        []*DeadlockProcess     `protobuf:"bytes,5,rep,name=processes,proto3" json:"processes,omitempty"`
+	Resources        []*DeadlockResource    `protobuf:"bytes,6,rep,name=resources,proto3" json:"resources,omitempty"`
+	XmlData          string                 `protobuf:"bytes,7,opt,name=xml_data,json=xmlData,proto3" json:"xml_data,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Deadlock) Reset() {
+	*x = Deadlock{}
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deadlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deadlock) ProtoMessage() {}
+
+func (x *Deadlock) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deadlock.ProtoReflect.Descriptor instead.
+func (*Deadlock) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_deadlock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Deadlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Deadlock) GetServer() *ServerMetadata {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Deadlock) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Deadlock) GetVictimProcessIds() []string {
+	if x != nil {
+		return x.VictimProcessIds
+	}
+	return nil
+}
+
+func (x *Deadlock) GetProcesses() []*DeadlockProcess {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *Deadlock) GetResources() []*DeadlockResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Deadlock) GetXmlData() string {
+	if x != nil {
+		return x.XmlData
+	}
+	return ""
+}
+
+type DeadlockProcess struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionId              string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Victim                 bool                   `protobuf:"varint,3,opt,name=victim,proto3" json:"victim,omitempty"`
+	DatabaseId             string                 `protobuf:"bytes,4,opt,name=database_id,json=databaseId,proto3" json:"database_id,omitempty"`
+	DatabaseName           string                 `protobuf:"bytes,5,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	LoginName              string                 `protobuf:"bytes,6,opt,name=login_name,json=loginName,proto3" json:"login_name,omitempty"`
+	HostName               string                 `protobuf:"bytes,7,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	ProgramName            string                 `protobuf:"bytes,8,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
+	IsolationLevel         string                 `protobuf:"bytes,9,opt,name=isolation_level,json=isolationLevel,proto3" json:"isolation_level,omitempty"`
+	Status                 string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	LockMode               string                 `protobuf:"bytes,11,opt,name=lock_mode,json=lockMode,proto3" json:"lock_mode,omitempty"`
+	WaitResource           string                 `protobuf:"bytes,12,opt,name=wait_resource,json=waitResource,proto3" json:"wait_resource,omitempty"`
+	WaitTimeMs             int64                  `protobuf:"varint,13,opt,name=wait_time_ms,json=waitTimeMs,proto3" json:"wait_time_ms,omitempty"`
+	TransactionName        string                 `protobuf:"bytes,14,opt,name=transaction_name,json=transactionName,proto3" json:"transaction_name,omitempty"`
+	LastTransactionStarted *timestamp.Timestamp   `protobuf:"bytes,15,opt,name=last_transaction_started,json=lastTransactionStarted,proto3" json:"last_transaction_started,omitempty"`
+	TransactionCount       int32                  `protobuf:"varint,16,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	InputBuffer            string                 `protobuf:"bytes,17,opt,name=input_buffer,json=inputBuffer,proto3" json:"input_buffer,omitempty"`
+	Frames                 []*DeadlockFrame       `protobuf:"bytes,18,rep,name=frames,proto3" json:"frames,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeadlockProcess) Reset() {
+	*x = DeadlockProcess{}
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadlockProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlockProcess) ProtoMessage() {}
+
+func (x *DeadlockProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlockProcess.ProtoReflect.Descriptor instead.
+func (*DeadlockProcess) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_deadlock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadlockProcess) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetVictim() bool {
+	if x != nil {
+		return x.Victim
+	}
+	return false
+}
+
+func (x *DeadlockProcess) GetDatabaseId() string {
+	if x != nil {
+		return x.DatabaseId
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetLoginName() string {
+	if x != nil {
+		return x.LoginName
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetProgramName() string {
+	if x != nil {
+		return x.ProgramName
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetIsolationLevel() string {
+	if x != nil {
+		return x.IsolationLevel
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetLockMode() string {
+	if x != nil {
+		return x.LockMode
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetWaitResource() string {
+	if x != nil {
+		return x.WaitResource
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetWaitTimeMs() int64 {
+	if x != nil {
+		return x.WaitTimeMs
+	}
+	return 0
+}
+
+func (x *DeadlockProcess) GetTransactionName() string {
+	if x != nil {
+		return x.TransactionName
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetLastTransactionStarted() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTransactionStarted
+	}
+	return nil
+}
+
+func (x *DeadlockProcess) GetTransactionCount() int32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *DeadlockProcess) GetInputBuffer() string {
+	if x != nil {
+		return x.InputBuffer
+	}
+	return ""
+}
+
+func (x *DeadlockProcess) GetFrames() []*DeadlockFrame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+type DeadlockFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcName      string                 `protobuf:"bytes,1,opt,name=proc_name,json=procName,proto3" json:"proc_name,omitempty"`
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	SqlHandle     string                 `protobuf:"bytes,3,opt,name=sql_handle,json=sqlHandle,proto3" json:"sql_handle,omitempty"`
+	QueryHash     string                 `protobuf:"bytes,4,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	QueryPlanHash string                 `protobuf:"bytes,5,opt,name=query_plan_hash,json=queryPlanHash,proto3" json:"query_plan_hash,omitempty"`
+	PlanHandle    string                 `protobuf:"bytes,6,opt,name=plan_handle,json=planHandle,proto3" json:"plan_handle,omitempty"`
+	Text          string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadlockFrame) Reset() {
+	*x = DeadlockFrame{}
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadlockFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlockFrame) ProtoMessage() {}
+
+func (x *DeadlockFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlockFrame.ProtoReflect.Descriptor instead.
+func (*DeadlockFrame) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_deadlock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeadlockFrame) GetProcName() string {
+	if x != nil {
+		return x.ProcName
+	}
+	return ""
+}
+
+func (x *DeadlockFrame) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *DeadlockFrame) GetSqlHandle() string {
+	if x != nil {
+		return x.SqlHandle
+	}
+	return ""
+}
+
+func (x *DeadlockFrame) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
+func (x *DeadlockFrame) GetQueryPlanHash() string {
+	if x != nil {
+		return x.QueryPlanHash
+	}
+	return ""
+}
+
+func (x *DeadlockFrame) GetPlanHandle() string {
+	if x != nil {
+		return x.PlanHandle
+	}
+	return ""
+}
+
+func (x *DeadlockFrame) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeadlockResource struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ResourceType  string                   `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	DatabaseId    string                   `protobuf:"bytes,2,opt,name=database_id,json=databaseId,proto3" json:"database_id,omitempty"`
+	ObjectName    string                   `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	IndexName     string                   `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	HobtId        string                   `protobuf:"bytes,5,opt,name=hobt_id,json=hobtId,proto3" json:"hobt_id,omitempty"`
+	Mode          string                   `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Owners        []*DeadlockResource_Lock `protobuf:"bytes,7,rep,name=owners,proto3" json:"owners,omitempty"`
+	Waiters       []*DeadlockResource_Lock `protobuf:"bytes,8,rep,name=waiters,proto3" json:"waiters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadlockResource) Reset() {
+	*x = DeadlockResource{}
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadlockResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlockResource) ProtoMessage() {}
+
+func (x *DeadlockResource) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlockResource.ProtoReflect.Descriptor instead.
+func (*DeadlockResource) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_deadlock_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeadlockResource) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetDatabaseId() string {
+	if x != nil {
+		return x.DatabaseId
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetHobtId() string {
+	if x != nil {
+		return x.HobtId
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DeadlockResource) GetOwners() []*DeadlockResource_Lock {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *DeadlockResource) GetWaiters() []*DeadlockResource_Lock {
+	if x != nil {
+		return x.Waiters
+	}
+	return nil
+}
+
+type DeadlockResource_Lock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessId     string                 `protobuf:"bytes,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	RequestType   string                 `protobuf:"bytes,3,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadlockResource_Lock) Reset() {
+	*x = DeadlockResource_Lock{}
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadlockResource_Lock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlockResource_Lock) ProtoMessage() {}
+
+func (x *DeadlockResource_Lock) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_deadlock_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlockResource_Lock.ProtoReflect.Descriptor instead.
+func (*DeadlockResource_Lock) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_deadlock_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *DeadlockResource_Lock) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *DeadlockResource_Lock) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DeadlockResource_Lock) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+var File_database_monitoring_v1_deadlock_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_deadlock_proto_rawDesc = "" +
+	"\n" +
+	"%database_monitoring/v1/deadlock.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%database_monitoring/v1/snapshot.proto\"\xec\x02\n" +
+	"\bDeadlock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
+	"\x06server\x18\x02 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12,\n" +
+	"\x12victim_process_ids\x18\x04 \x03(\tR\x10victimProcessIds\x12E\n" +
+	"\tprocesses\x18\x05 \x03(\v2'.database_monitoring.v1.DeadlockProcessR\tprocesses\x12F\n" +
+	"\tresources\x18\x06 \x03(\v2(.database_monitoring.v1.DeadlockResourceR\tresources\x12\x19\n" +
+	"\bxml_data\x18\a \x01(\tR\axmlData\"\xb2\x05\n" +
+	"\x0fDeadlockProcess\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06victim\x18\x03 \x01(\bR\x06victim\x12\x1f\n" +
+	"\vdatabase_id\x18\x04 \x01(\tR\n" +
+	"databaseId\x12#\n" +
+	"\rdatabase_name\x18\x05 \x01(\tR\fdatabaseName\x12\x1d\n" +
+	"\n" +
+	"login_name\x18\x06 \x01(\tR\tloginName\x12\x1b\n" +
+	"\thost_name\x18\a \x01(\tR\bhostName\x12!\n" +
+	"\fprogram_name\x18\b \x01(\tR\vprogramName\x12'\n" +
+	"\x0fisolation_level\x18\t \x01(\tR\x0eisolationLevel\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1b\n" +
+	"\tlock_mode\x18\v \x01(\tR\blockMode\x12#\n" +
+	"\rwait_resource\x18\f \x01(\tR\fwaitResource\x12 \n" +
+	"\fwait_time_ms\x18\r \x01(\x03R\n" +
+	"waitTimeMs\x12)\n" +
+	"\x10transaction_name\x18\x0e \x01(\tR\x0ftransactionName\x12T\n" +
+	"\x18last_transaction_started\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x16lastTransactionStarted\x12+\n" +
+	"\x11transaction_count\x18\x10 \x01(\x05R\x10transactionCount\x12!\n" +
+	"\finput_buffer\x18\x11 \x01(\tR\vinputBuffer\x12=\n" +
+	"\x06frames\x18\x12 \x03(\v2%.database_monitoring.v1.DeadlockFrameR\x06frames\"\xdb\x01\n" +
+	"\rDeadlockFrame\x12\x1b\n" +
+	"\tproc_name\x18\x01 \x01(\tR\bprocName\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x1d\n" +
+	"\n" +
+	"sql_handle\x18\x03 \x01(\tR\tsqlHandle\x12\x1d\n" +
+	"\n" +
+	"query_hash\x18\x04 \x01(\tR\tqueryHash\x12&\n" +
+	"\x0fquery_plan_hash\x18\x05 \x01(\tR\rqueryPlanHash\x12\x1f\n" +
+	"\vplan_handle\x18\x06 \x01(\tR\n" +
+	"planHandle\x12\x12\n" +
+	"\x04text\x18\a \x01(\tR\x04text\"\xb3\x03\n" +
+	"\x10DeadlockResource\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vdatabase_id\x18\x02 \x01(\tR\n" +
+	"databaseId\x12\x1f\n" +
+	"\vobject_name\x18\x03 \x01(\tR\n" +
+	"objectName\x12\x1d\n" +
+	"\n" +
+	"index_name\x18\x04 \x01(\tR\tindexName\x12\x17\n" +
+	"\ahobt_id\x18\x05 \x01(\tR\x06hobtId\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12E\n" +
+	"\x06owners\x18\a \x03(\v2-.database_monitoring.v1.DeadlockResource.LockR\x06owners\x12G\n" +
+	"\awaiters\x18\b \x03(\v2-.database_monitoring.v1.DeadlockResource.LockR\awaiters\x1a\\\n" +
+	"\x04Lock\x12\x1d\n" +
+	"\n" +
+	"process_id\x18\x01 \x01(\tR\tprocessId\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12!\n" +
+	"\frequest_type\x18\x03 \x01(\tR\vrequestTypeBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_deadlock_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_deadlock_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_deadlock_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_deadlock_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_deadlock_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_deadlock_proto_rawDesc), len(file_database_monitoring_v1_deadlock_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_deadlock_proto_rawDescData
+}
+
+var file_database_monitoring_v1_deadlock_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_database_monitoring_v1_deadlock_proto_goTypes = []any{
+	(*Deadlock)(nil),              // 0: database_monitoring.v1.Deadlock
+	(*DeadlockProcess)(nil),       // 1: database_monitoring.v1.DeadlockProcess
+	(*DeadlockFrame)(nil),         // 2: database_monitoring.v1.DeadlockFrame
+	(*DeadlockResource)(nil),      // 3: database_monitoring.v1.DeadlockResource
+	(*DeadlockResource_Lock)(nil), // 4: database_monitoring.v1.DeadlockResource.Lock
+	(*ServerMetadata)(nil),        // 5: database_monitoring.v1.ServerMetadata
+	(*timestamp.Timestamp)(nil),   // 6: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_deadlock_proto_depIdxs = []int32{
+	5, // 0: database_monitoring.v1.Deadlock.server:type_name -> database_monitoring.v1.ServerMetadata
+	6, // 1: database_monitoring.v1.Deadlock.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 2: database_monitoring.v1.Deadlock.processes:type_name -> database_monitoring.v1.DeadlockProcess
+	3, // 3: database_monitoring.v1.Deadlock.resources:type_name -> database_monitoring.v1.DeadlockResource
+	6, // 4: database_monitoring.v1.DeadlockProcess.last_transaction_started:type_name -> google.protobuf.Timestamp
+	2, // 5: database_monitoring.v1.DeadlockProcess.frames:type_name -> database_monitoring.v1.DeadlockFrame
+	4, // 6: database_monitoring.v1.DeadlockResource.owners:type_name -> database_monitoring.v1.DeadlockResource.Lock
+	4, // 7: database_monitoring.v1.DeadlockResource.waiters:type_name -> database_monitoring.v1.DeadlockResource.Lock
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_deadlock_proto_init() }
+func file_database_monitoring_v1_deadlock_proto_init() {
+	if File_database_monitoring_v1_deadlock_proto != nil {
+		return
+	}
+	file_database_monitoring_v1_snapshot_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_deadlock_proto_rawDesc), len(file_database_monitoring_v1_deadlock_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_deadlock_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_deadlock_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_deadlock_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_deadlock_proto = out.File
+	file_database_monitoring_v1_deadlock_proto_goTypes = nil
+	file_database_monitoring_v1_deadlock_proto_depIdxs = nil
+}
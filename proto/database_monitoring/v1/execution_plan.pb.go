@@ -157,6 +157,10 @@ type PlanNode struct {
 	NodeCost      float64                `protobuf:"fixed64,4,opt,name=node_cost,json=nodeCost,proto3" json:"node_cost,omitempty"`
 	Header        *PlanNode_Header       `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"`
 	Nodes         []*PlanNode            `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// node_id is the plan NodeId of the operator, -1 on statement nodes
+	NodeId int64 `protobuf:"varint,8,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// live_progress is set on the operators of the statement a sample is running, see GetSampleDetails
+	LiveProgress  *OperatorProgress `protobuf:"bytes,9,opt,name=live_progress,json=liveProgress,proto3" json:"live_progress,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +237,20 @@ func (x *PlanNode) GetNodes() []*PlanNode {
 	return nil
 }
 
+func (x *PlanNode) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *PlanNode) GetLiveProgress() *OperatorProgress {
+	if x != nil {
+		return x.LiveProgress
+	}
+	return nil
+}
+
 type StatisticsInfo struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	LastUpdate        string                 `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
@@ -631,7 +649,7 @@ var File_database_monitoring_v1_execution_plan_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_execution_plan_proto_rawDesc = "" +
 	"\n" +
-	"+database_monitoring/v1/execution_plan.proto\x12\x16database_monitoring.v1\x1a%database_monitoring/v1/snapshot.proto\"\x8b\x01\n" +
+	"+database_monitoring/v1/execution_plan.proto\x12\x16database_monitoring.v1\x1a%database_monitoring/v1/snapshot.proto\x1a#database_monitoring/v1/sample.proto\"\x8b\x01\n" +
 	"\rExecutionPlan\x12\x1f\n" +
 	"\vplan_handle\x18\x01 \x01(\tR\n" +
 	"planHandle\x12>\n" +
@@ -642,14 +660,16 @@ const file_database_monitoring_v1_execution_plan_proto_rawDesc = "" +
 	"\vstats_usage\x18\x02 \x03(\v2&.database_monitoring.v1.StatisticsInfoR\n" +
 	"statsUsage\x12?\n" +
 	"\bwarnings\x18\x03 \x03(\v2#.database_monitoring.v1.PlanWarningR\bwarnings\x126\n" +
-	"\x05nodes\x18\x04 \x03(\v2 .database_monitoring.v1.PlanNodeR\x05nodes\"\xf5\x03\n" +
+	"\x05nodes\x18\x04 \x03(\v2 .database_monitoring.v1.PlanNodeR\x05nodes\"\xdd\x04\n" +
 	"\bPlanNode\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\x0eestimated_rows\x18\x02 \x01(\x01R\restimatedRows\x12!\n" +
 	"\fsubtree_cost\x18\x03 \x01(\x01R\vsubtreeCost\x12\x1b\n" +
 	"\tnode_cost\x18\x04 \x01(\x01R\bnodeCost\x12?\n" +
 	"\x06header\x18\x05 \x01(\v2'.database_monitoring.v1.PlanNode.HeaderR\x06header\x126\n" +
-	"\x05nodes\x18\a \x03(\v2 .database_monitoring.v1.PlanNodeR\x05nodes\x1a\xf4\x01\n" +
+	"\x05nodes\x18\a \x03(\v2 .database_monitoring.v1.PlanNodeR\x05nodes\x12\x17\n" +
+	"\anode_id\x18\b \x01(\x03R\x06nodeId\x12M\n" +
+	"\rlive_progress\x18\t \x01(\v2(.database_monitoring.v1.OperatorProgressR\fliveProgress\x1a\xf4\x01\n" +
 	"\x06Header\x12\x1f\n" +
 	"\vphysical_op\x18\x01 \x01(\tR\n" +
 	"physicalOp\x12\x1d\n" +
@@ -711,22 +731,24 @@ var file_database_monitoring_v1_execution_plan_proto_goTypes = []any{
 	(*PlanWarning_PlanAffectingConvert)(nil), // 6: database_monitoring.v1.PlanWarning.PlanAffectingConvert
 	(*PlanWarning_MissingIndexWarning)(nil),  // 7: database_monitoring.v1.PlanWarning.MissingIndexWarning
 	(*ServerMetadata)(nil),                   // 8: database_monitoring.v1.ServerMetadata
+	(*OperatorProgress)(nil),                 // 9: database_monitoring.v1.OperatorProgress
 }
 var file_database_monitoring_v1_execution_plan_proto_depIdxs = []int32{
-	8, // 0: database_monitoring.v1.ExecutionPlan.server:type_name -> database_monitoring.v1.ServerMetadata
-	0, // 1: database_monitoring.v1.ParsedExecutionPlan.plan:type_name -> database_monitoring.v1.ExecutionPlan
-	3, // 2: database_monitoring.v1.ParsedExecutionPlan.stats_usage:type_name -> database_monitoring.v1.StatisticsInfo
-	4, // 3: database_monitoring.v1.ParsedExecutionPlan.warnings:type_name -> database_monitoring.v1.PlanWarning
-	2, // 4: database_monitoring.v1.ParsedExecutionPlan.nodes:type_name -> database_monitoring.v1.PlanNode
-	5, // 5: database_monitoring.v1.PlanNode.header:type_name -> database_monitoring.v1.PlanNode.Header
-	2, // 6: database_monitoring.v1.PlanNode.nodes:type_name -> database_monitoring.v1.PlanNode
-	6, // 7: database_monitoring.v1.PlanWarning.convert:type_name -> database_monitoring.v1.PlanWarning.PlanAffectingConvert
-	7, // 8: database_monitoring.v1.PlanWarning.missing_index:type_name -> database_monitoring.v1.PlanWarning.MissingIndexWarning
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	8,  // 0: database_monitoring.v1.ExecutionPlan.server:type_name -> database_monitoring.v1.ServerMetadata
+	0,  // 1: database_monitoring.v1.ParsedExecutionPlan.plan:type_name -> database_monitoring.v1.ExecutionPlan
+	3,  // 2: database_monitoring.v1.ParsedExecutionPlan.stats_usage:type_name -> database_monitoring.v1.StatisticsInfo
+	4,  // 3: database_monitoring.v1.ParsedExecutionPlan.warnings:type_name -> database_monitoring.v1.PlanWarning
+	2,  // 4: database_monitoring.v1.ParsedExecutionPlan.nodes:type_name -> database_monitoring.v1.PlanNode
+	5,  // 5: database_monitoring.v1.PlanNode.header:type_name -> database_monitoring.v1.PlanNode.Header
+	2,  // 6: database_monitoring.v1.PlanNode.nodes:type_name -> database_monitoring.v1.PlanNode
+	9,  // 7: database_monitoring.v1.PlanNode.live_progress:type_name -> database_monitoring.v1.OperatorProgress
+	6,  // 8: database_monitoring.v1.PlanWarning.convert:type_name -> database_monitoring.v1.PlanWarning.PlanAffectingConvert
+	7,  // 9: database_monitoring.v1.PlanWarning.missing_index:type_name -> database_monitoring.v1.PlanWarning.MissingIndexWarning
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_execution_plan_proto_init() }
@@ -735,6 +757,7 @@ func file_database_monitoring_v1_execution_plan_proto_init() {
 		return
 	}
 	file_database_monitoring_v1_snapshot_proto_init()
+	file_database_monitoring_v1_sample_proto_init()
 	file_database_monitoring_v1_execution_plan_proto_msgTypes[4].OneofWrappers = []any{
 		(*PlanWarning_Convert)(nil),
 		(*PlanWarning_MissingIndex)(nil),
@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/file_size.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DatabaseFileSize is the size and used space of a database file
+type DatabaseFileSize struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	FileId       int64                  `protobuf:"varint,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	LogicalName  string                 `protobuf:"bytes,3,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	PhysicalName string                 `protobuf:"bytes,4,opt,name=physical_name,json=physicalName,proto3" json:"physical_name,omitempty"`
+	// file_type is ROWS or LOG
+	FileType string `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	SizeKb   int64  `protobuf:"varint,6,opt,name=size_kb,json=sizeKb,proto3" json:"size_kb,omitempty"`
+	UsedKb   int64  `protobuf:"varint,7,opt,name=used_kb,json=usedKb,proto3" json:"used_kb,omitempty"`
+	// max_size_kb is zero when the file grows until the volume is full
+	MaxSizeKb int64 `protobuf:"varint,8,opt,name=max_size_kb,json=maxSizeKb,proto3" json:"max_size_kb,omitempty"`
+	// growth is a percentage when is_percent_growth is set and KB otherwise, zero when autogrowth is off
+	Growth          int64 `protobuf:"varint,9,opt,name=growth,proto3" json:"growth,omitempty"`
+	IsPercentGrowth bool  `protobuf:"varint,10,opt,name=is_percent_growth,json=isPercentGrowth,proto3" json:"is_percent_growth,omitempty"`
+	VolumeFreeKb    int64 `protobuf:"varint,11,opt,name=volume_free_kb,json=volumeFreeKb,proto3" json:"volume_free_kb,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DatabaseFileSize) Reset() {
+	*x = DatabaseFileSize{}
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseFileSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseFileSize) ProtoMessage() {}
+
+func (x *DatabaseFileSize) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseFileSize.ProtoReflect.Descriptor instead.
+func (*DatabaseFileSize) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_file_size_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DatabaseFileSize) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *DatabaseFileSize) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *DatabaseFileSize) GetLogicalName() string {
+	if x != nil {
+		return x.LogicalName
+	}
+	return ""
+}
+
+func (x *DatabaseFileSize) GetPhysicalName() string {
+	if x != nil {
+		return x.PhysicalName
+	}
+	return ""
+}
+
+func (x *DatabaseFileSize) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *DatabaseFileSize) GetSizeKb() int64 {
+	if x != nil {
+		return x.SizeKb
+	}
+	return 0
+}
+
+func (x *DatabaseFileSize) GetUsedKb() int64 {
+	if x != nil {
+		return x.UsedKb
+	}
+	return 0
+}
+
+func (x *DatabaseFileSize) GetMaxSizeKb() int64 {
+	if x != nil {
+		return x.MaxSizeKb
+	}
+	return 0
+}
+
+func (x *DatabaseFileSize) GetGrowth() int64 {
+	if x != nil {
+		return x.Growth
+	}
+	return 0
+}
+
+func (x *DatabaseFileSize) GetIsPercentGrowth() bool {
+	if x != nil {
+		return x.IsPercentGrowth
+	}
+	return false
+}
+
+func (x *DatabaseFileSize) GetVolumeFreeKb() int64 {
+	if x != nil {
+		return x.VolumeFreeKb
+	}
+	return 0
+}
+
+// AutogrowthEvent is a file growth logged in the default trace
+type AutogrowthEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName  string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	LogicalName   string                 `protobuf:"bytes,2,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	FileType      string                 `protobuf:"bytes,3,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	GrowthKb      int64                  `protobuf:"varint,6,opt,name=growth_kb,json=growthKb,proto3" json:"growth_kb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutogrowthEvent) Reset() {
+	*x = AutogrowthEvent{}
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutogrowthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutogrowthEvent) ProtoMessage() {}
+
+func (x *AutogrowthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutogrowthEvent.ProtoReflect.Descriptor instead.
+func (*AutogrowthEvent) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_file_size_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AutogrowthEvent) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *AutogrowthEvent) GetLogicalName() string {
+	if x != nil {
+		return x.LogicalName
+	}
+	return ""
+}
+
+func (x *AutogrowthEvent) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *AutogrowthEvent) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AutogrowthEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AutogrowthEvent) GetGrowthKb() int64 {
+	if x != nil {
+		return x.GrowthKb
+	}
+	return 0
+}
+
+type FileSizePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SizeKb        int64                  `protobuf:"varint,2,opt,name=size_kb,json=sizeKb,proto3" json:"size_kb,omitempty"`
+	UsedKb        int64                  `protobuf:"varint,3,opt,name=used_kb,json=usedKb,proto3" json:"used_kb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileSizePoint) Reset() {
+	*x = FileSizePoint{}
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileSizePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSizePoint) ProtoMessage() {}
+
+func (x *FileSizePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSizePoint.ProtoReflect.Descriptor instead.
+func (*FileSizePoint) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_file_size_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FileSizePoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FileSizePoint) GetSizeKb() int64 {
+	if x != nil {
+		return x.SizeKb
+	}
+	return 0
+}
+
+func (x *FileSizePoint) GetUsedKb() int64 {
+	if x != nil {
+		return x.UsedKb
+	}
+	return 0
+}
+
+// FileGrowthTrend holds the size history of a database file with a linear forecast of its used space
+type FileGrowthTrend struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	FileId       int64                  `protobuf:"varint,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	LogicalName  string                 `protobuf:"bytes,3,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	PhysicalName string                 `protobuf:"bytes,4,opt,name=physical_name,json=physicalName,proto3" json:"physical_name,omitempty"`
+	FileType     string                 `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	// capacity_kb is the size the file can reach, bounded by its max size and the free space of its volume
+	CapacityKb     int64            `protobuf:"varint,6,opt,name=capacity_kb,json=capacityKb,proto3" json:"capacity_kb,omitempty"`
+	Points         []*FileSizePoint `protobuf:"bytes,7,rep,name=points,proto3" json:"points,omitempty"`
+	GrowthKbPerDay float64          `protobuf:"fixed64,8,opt,name=growth_kb_per_day,json=growthKbPerDay,proto3" json:"growth_kb_per_day,omitempty"`
+	// days_until_full is -1 when the used space is not growing
+	DaysUntilFull float64            `protobuf:"fixed64,9,opt,name=days_until_full,json=daysUntilFull,proto3" json:"days_until_full,omitempty"`
+	GrowthEvents  []*AutogrowthEvent `protobuf:"bytes,10,rep,name=growth_events,json=growthEvents,proto3" json:"growth_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileGrowthTrend) Reset() {
+	*x = FileGrowthTrend{}
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileGrowthTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileGrowthTrend) ProtoMessage() {}
+
+func (x *FileGrowthTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_file_size_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileGrowthTrend.ProtoReflect.Descriptor instead.
+func (*FileGrowthTrend) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_file_size_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FileGrowthTrend) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *FileGrowthTrend) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *FileGrowthTrend) GetLogicalName() string {
+	if x != nil {
+		return x.LogicalName
+	}
+	return ""
+}
+
+func (x *FileGrowthTrend) GetPhysicalName() string {
+	if x != nil {
+		return x.PhysicalName
+	}
+	return ""
+}
+
+func (x *FileGrowthTrend) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *FileGrowthTrend) GetCapacityKb() int64 {
+	if x != nil {
+		return x.CapacityKb
+	}
+	return 0
+}
+
+func (x *FileGrowthTrend) GetPoints() []*FileSizePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *FileGrowthTrend) GetGrowthKbPerDay() float64 {
+	if x != nil {
+		return x.GrowthKbPerDay
+	}
+	return 0
+}
+
+func (x *FileGrowthTrend) GetDaysUntilFull() float64 {
+	if x != nil {
+		return x.DaysUntilFull
+	}
+	return 0
+}
+
+func (x *FileGrowthTrend) GetGrowthEvents() []*AutogrowthEvent {
+	if x != nil {
+		return x.GrowthEvents
+	}
+	return nil
+}
+
+var File_database_monitoring_v1_file_size_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_file_size_proto_rawDesc = "" +
+	"\n" +
+	"&database_monitoring/v1/file_size.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf1\x02\n" +
+	"\x10DatabaseFileSize\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\x03R\x06fileId\x12!\n" +
+	"\flogical_name\x18\x03 \x01(\tR\vlogicalName\x12#\n" +
+	"\rphysical_name\x18\x04 \x01(\tR\fphysicalName\x12\x1b\n" +
+	"\tfile_type\x18\x05 \x01(\tR\bfileType\x12\x17\n" +
+	"\asize_kb\x18\x06 \x01(\x03R\x06sizeKb\x12\x17\n" +
+	"\aused_kb\x18\a \x01(\x03R\x06usedKb\x12\x1e\n" +
+	"\vmax_size_kb\x18\b \x01(\x03R\tmaxSizeKb\x12\x16\n" +
+	"\x06growth\x18\t \x01(\x03R\x06growth\x12*\n" +
+	"\x11is_percent_growth\x18\n" +
+	" \x01(\bR\x0fisPercentGrowth\x12$\n" +
+	"\x0evolume_free_kb\x18\v \x01(\x03R\fvolumeFreeKb\"\xef\x01\n" +
+	"\x0fAutogrowthEvent\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12!\n" +
+	"\flogical_name\x18\x02 \x01(\tR\vlogicalName\x12\x1b\n" +
+	"\tfile_type\x18\x03 \x01(\tR\bfileType\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12\x1b\n" +
+	"\tgrowth_kb\x18\x06 \x01(\x03R\bgrowthKb\"{\n" +
+	"\rFileSizePoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x17\n" +
+	"\asize_kb\x18\x02 \x01(\x03R\x06sizeKb\x12\x17\n" +
+	"\aused_kb\x18\x03 \x01(\x03R\x06usedKb\"\xb5\x03\n" +
+	"\x0fFileGrowthTrend\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\x03R\x06fileId\x12!\n" +
+	"\flogical_name\x18\x03 \x01(\tR\vlogicalName\x12#\n" +
+	"\rphysical_name\x18\x04 \x01(\tR\fphysicalName\x12\x1b\n" +
+	"\tfile_type\x18\x05 \x01(\tR\bfileType\x12\x1f\n" +
+	"\vcapacity_kb\x18\x06 \x01(\x03R\n" +
+	"capacityKb\x12=\n" +
+	"\x06points\x18\a \x03(\v2%.database_monitoring.v1.FileSizePointR\x06points\x12)\n" +
+	"\x11growth_kb_per_day\x18\b \x01(\x01R\x0egrowthKbPerDay\x12&\n" +
+	"\x0fdays_until_full\x18\t \x01(\x01R\rdaysUntilFull\x12L\n" +
+	"\rgrowth_events\x18\n" +
+	" \x03(\v2'.database_monitoring.v1.AutogrowthEventR\fgrowthEventsBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_file_size_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_file_size_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_file_size_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_file_size_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_file_size_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_file_size_proto_rawDesc), len(file_database_monitoring_v1_file_size_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_file_size_proto_rawDescData
+}
+
+var file_database_monitoring_v1_file_size_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_database_monitoring_v1_file_size_proto_goTypes = []any{
+	(*DatabaseFileSize)(nil),    // 0: database_monitoring.v1.DatabaseFileSize
+	(*AutogrowthEvent)(nil),     // 1: database_monitoring.v1.AutogrowthEvent
+	(*FileSizePoint)(nil),       // 2: database_monitoring.v1.FileSizePoint
+	(*FileGrowthTrend)(nil),     // 3: database_monitoring.v1.FileGrowthTrend
+	(*timestamp.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_file_size_proto_depIdxs = []int32{
+	4, // 0: database_monitoring.v1.AutogrowthEvent.start_time:type_name -> google.protobuf.Timestamp
+	4, // 1: database_monitoring.v1.FileSizePoint.timestamp:type_name -> google.protobuf.Timestamp
+	2, // 2: database_monitoring.v1.FileGrowthTrend.points:type_name -> database_monitoring.v1.FileSizePoint
+	1, // 3: database_monitoring.v1.FileGrowthTrend.growth_events:type_name -> database_monitoring.v1.AutogrowthEvent
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_file_size_proto_init() }
+func file_database_monitoring_v1_file_size_proto_init() {
+	if File_database_monitoring_v1_file_size_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_file_size_proto_rawDesc), len(file_database_monitoring_v1_file_size_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_file_size_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_file_size_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_file_size_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_file_size_proto = out.File
+	file_database_monitoring_v1_file_size_proto_goTypes = nil
+	file_database_monitoring_v1_file_size_proto_depIdxs = nil
+}
@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/index_stats.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IndexUsage is a dm_db_index_usage_stats reading of an index with its size. The counters are cumulative since the
+// server started when ingested and the activity in the requested range when served.
+type IndexUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName   string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	SchemaName     string                 `protobuf:"bytes,2,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName      string                 `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IndexName      string                 `protobuf:"bytes,4,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexId        int64                  `protobuf:"varint,5,opt,name=index_id,json=indexId,proto3" json:"index_id,omitempty"`
+	IndexType      string                 `protobuf:"bytes,6,opt,name=index_type,json=indexType,proto3" json:"index_type,omitempty"`
+	IsUnique       bool                   `protobuf:"varint,7,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	IsPrimaryKey   bool                   `protobuf:"varint,8,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	UserSeeks      int64                  `protobuf:"varint,9,opt,name=user_seeks,json=userSeeks,proto3" json:"user_seeks,omitempty"`
+	UserScans      int64                  `protobuf:"varint,10,opt,name=user_scans,json=userScans,proto3" json:"user_scans,omitempty"`
+	UserLookups    int64                  `protobuf:"varint,11,opt,name=user_lookups,json=userLookups,proto3" json:"user_lookups,omitempty"`
+	UserUpdates    int64                  `protobuf:"varint,12,opt,name=user_updates,json=userUpdates,proto3" json:"user_updates,omitempty"`
+	LastUserRead   *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=last_user_read,json=lastUserRead,proto3" json:"last_user_read,omitempty"`
+	LastUserUpdate *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=last_user_update,json=lastUserUpdate,proto3" json:"last_user_update,omitempty"`
+	SizeKb         int64                  `protobuf:"varint,15,opt,name=size_kb,json=sizeKb,proto3" json:"size_kb,omitempty"`
+	RowCount       int64                  `protobuf:"varint,16,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IndexUsage) Reset() {
+	*x = IndexUsage{}
+	mi := &file_database_monitoring_v1_index_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexUsage) ProtoMessage() {}
+
+func (x *IndexUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_index_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexUsage.ProtoReflect.Descriptor instead.
+func (*IndexUsage) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_index_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexUsage) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *IndexUsage) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *IndexUsage) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *IndexUsage) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
+func (x *IndexUsage) GetIndexId() int64 {
+	if x != nil {
+		return x.IndexId
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetIndexType() string {
+	if x != nil {
+		return x.IndexType
+	}
+	return ""
+}
+
+func (x *IndexUsage) GetIsUnique() bool {
+	if x != nil {
+		return x.IsUnique
+	}
+	return false
+}
+
+func (x *IndexUsage) GetIsPrimaryKey() bool {
+	if x != nil {
+		return x.IsPrimaryKey
+	}
+	return false
+}
+
+func (x *IndexUsage) GetUserSeeks() int64 {
+	if x != nil {
+		return x.UserSeeks
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetUserScans() int64 {
+	if x != nil {
+		return x.UserScans
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetUserLookups() int64 {
+	if x != nil {
+		return x.UserLookups
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetUserUpdates() int64 {
+	if x != nil {
+		return x.UserUpdates
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetLastUserRead() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastUserRead
+	}
+	return nil
+}
+
+func (x *IndexUsage) GetLastUserUpdate() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastUserUpdate
+	}
+	return nil
+}
+
+func (x *IndexUsage) GetSizeKb() int64 {
+	if x != nil {
+		return x.SizeKb
+	}
+	return 0
+}
+
+func (x *IndexUsage) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+// MissingIndex is a dm_db_missing_index_details suggestion with its group stats
+type MissingIndex struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	// table_name is the three part name of the table
+	TableName         string               `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	EqualityColumns   string               `protobuf:"bytes,3,opt,name=equality_columns,json=equalityColumns,proto3" json:"equality_columns,omitempty"`
+	InequalityColumns string               `protobuf:"bytes,4,opt,name=inequality_columns,json=inequalityColumns,proto3" json:"inequality_columns,omitempty"`
+	IncludedColumns   string               `protobuf:"bytes,5,opt,name=included_columns,json=includedColumns,proto3" json:"included_columns,omitempty"`
+	UniqueCompiles    int64                `protobuf:"varint,6,opt,name=unique_compiles,json=uniqueCompiles,proto3" json:"unique_compiles,omitempty"`
+	UserSeeks         int64                `protobuf:"varint,7,opt,name=user_seeks,json=userSeeks,proto3" json:"user_seeks,omitempty"`
+	UserScans         int64                `protobuf:"varint,8,opt,name=user_scans,json=userScans,proto3" json:"user_scans,omitempty"`
+	AvgTotalUserCost  float64              `protobuf:"fixed64,9,opt,name=avg_total_user_cost,json=avgTotalUserCost,proto3" json:"avg_total_user_cost,omitempty"`
+	AvgUserImpact     float64              `protobuf:"fixed64,10,opt,name=avg_user_impact,json=avgUserImpact,proto3" json:"avg_user_impact,omitempty"`
+	LastUserSeek      *timestamp.Timestamp `protobuf:"bytes,11,opt,name=last_user_seek,json=lastUserSeek,proto3" json:"last_user_seek,omitempty"`
+	// improvement_measure ranks the candidates, avg_total_user_cost * avg_user_impact * (user_seeks + user_scans)
+	ImprovementMeasure float64 `protobuf:"fixed64,12,opt,name=improvement_measure,json=improvementMeasure,proto3" json:"improvement_measure,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MissingIndex) Reset() {
+	*x = MissingIndex{}
+	mi := &file_database_monitoring_v1_index_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingIndex) ProtoMessage() {}
+
+func (x *MissingIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_index_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingIndex.ProtoReflect.Descriptor instead.
+func (*MissingIndex) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_index_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissingIndex) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *MissingIndex) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *MissingIndex) GetEqualityColumns() string {
+	if x != nil {
+		return x.EqualityColumns
+	}
+	return ""
+}
+
+func (x *MissingIndex) GetInequalityColumns() string {
+	if x != nil {
+		return x.InequalityColumns
+	}
+	return ""
+}
+
+func (x *MissingIndex) GetIncludedColumns() string {
+	if x != nil {
+		return x.IncludedColumns
+	}
+	return ""
+}
+
+func (x *MissingIndex) GetUniqueCompiles() int64 {
+	if x != nil {
+		return x.UniqueCompiles
+	}
+	return 0
+}
+
+func (x *MissingIndex) GetUserSeeks() int64 {
+	if x != nil {
+		return x.UserSeeks
+	}
+	return 0
+}
+
+func (x *MissingIndex) GetUserScans() int64 {
+	if x != nil {
+		return x.UserScans
+	}
+	return 0
+}
+
+func (x *MissingIndex) GetAvgTotalUserCost() float64 {
+	if x != nil {
+		return x.AvgTotalUserCost
+	}
+	return 0
+}
+
+func (x *MissingIndex) GetAvgUserImpact() float64 {
+	if x != nil {
+		return x.AvgUserImpact
+	}
+	return 0
+}
+
+func (x *MissingIndex) GetLastUserSeek() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastUserSeek
+	}
+	return nil
+}
+
+func (x *MissingIndex) GetImprovementMeasure() float64 {
+	if x != nil {
+		return x.ImprovementMeasure
+	}
+	return 0
+}
+
+var File_database_monitoring_v1_index_stats_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_index_stats_proto_rawDesc = "" +
+	"\n" +
+	"(database_monitoring/v1/index_stats.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x04\n" +
+	"\n" +
+	"IndexUsage\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x1f\n" +
+	"\vschema_name\x18\x02 \x01(\tR\n" +
+	"schemaName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x03 \x01(\tR\ttableName\x12\x1d\n" +
+	"\n" +
+	"index_name\x18\x04 \x01(\tR\tindexName\x12\x19\n" +
+	"\bindex_id\x18\x05 \x01(\x03R\aindexId\x12\x1d\n" +
+	"\n" +
+	"index_type\x18\x06 \x01(\tR\tindexType\x12\x1b\n" +
+	"\tis_unique\x18\a \x01(\bR\bisUnique\x12$\n" +
+	"\x0eis_primary_key\x18\b \x01(\bR\fisPrimaryKey\x12\x1d\n" +
+	"\n" +
+	"user_seeks\x18\t \x01(\x03R\tuserSeeks\x12\x1d\n" +
+	"\n" +
+	"user_scans\x18\n" +
+	" \x01(\x03R\tuserScans\x12!\n" +
+	"\fuser_lookups\x18\v \x01(\x03R\vuserLookups\x12!\n" +
+	"\fuser_updates\x18\f \x01(\x03R\vuserUpdates\x12@\n" +
+	"\x0elast_user_read\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\flastUserRead\x12D\n" +
+	"\x10last_user_update\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0elastUserUpdate\x12\x17\n" +
+	"\asize_kb\x18\x0f \x01(\x03R\x06sizeKb\x12\x1b\n" +
+	"\trow_count\x18\x10 \x01(\x03R\browCount\"\x88\x04\n" +
+	"\fMissingIndex\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12)\n" +
+	"\x10equality_columns\x18\x03 \x01(\tR\x0fequalityColumns\x12-\n" +
+	"\x12inequality_columns\x18\x04 \x01(\tR\x11inequalityColumns\x12)\n" +
+	"\x10included_columns\x18\x05 \x01(\tR\x0fincludedColumns\x12'\n" +
+	"\x0funique_compiles\x18\x06 \x01(\x03R\x0euniqueCompiles\x12\x1d\n" +
+	"\n" +
+	"user_seeks\x18\a \x01(\x03R\tuserSeeks\x12\x1d\n" +
+	"\n" +
+	"user_scans\x18\b \x01(\x03R\tuserScans\x12-\n" +
+	"\x13avg_total_user_cost\x18\t \x01(\x01R\x10avgTotalUserCost\x12&\n" +
+	"\x0favg_user_impact\x18\n" +
+	" \x01(\x01R\ravgUserImpact\x12@\n" +
+	"\x0elast_user_seek\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\flastUserSeek\x12/\n" +
+	"\x13improvement_measure\x18\f \x01(\x01R\x12improvementMeasureBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_index_stats_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_index_stats_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_index_stats_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_index_stats_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_index_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_index_stats_proto_rawDesc), len(file_database_monitoring_v1_index_stats_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_index_stats_proto_rawDescData
+}
+
+var file_database_monitoring_v1_index_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_database_monitoring_v1_index_stats_proto_goTypes = []any{
+	(*IndexUsage)(nil),          // 0: database_monitoring.v1.IndexUsage
+	(*MissingIndex)(nil),        // 1: database_monitoring.v1.MissingIndex
+	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_index_stats_proto_depIdxs = []int32{
+	2, // 0: database_monitoring.v1.IndexUsage.last_user_read:type_name -> google.protobuf.Timestamp
+	2, // 1: database_monitoring.v1.IndexUsage.last_user_update:type_name -> google.protobuf.Timestamp
+	2, // 2: database_monitoring.v1.MissingIndex.last_user_seek:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_index_stats_proto_init() }
+func file_database_monitoring_v1_index_stats_proto_init() {
+	if File_database_monitoring_v1_index_stats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_index_stats_proto_rawDesc), len(file_database_monitoring_v1_index_stats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_index_stats_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_index_stats_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_index_stats_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_index_stats_proto = out.File
+	file_database_monitoring_v1_index_stats_proto_goTypes = nil
+	file_database_monitoring_v1_index_stats_proto_depIdxs = nil
+}
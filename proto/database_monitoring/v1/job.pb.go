@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/job.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// JobRun is a run of a SQL Agent job, a running job is read again until its outcome is in the job history
+type JobRun struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	JobId   string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	JobName string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// status is succeeded, failed, retry, canceled or running
+	Status    string               `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// duration_ms is the time elapsed so far for a running job
+	DurationMs int64  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Message    string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// current_step is the step a running job is executing
+	CurrentStep   string `protobuf:"bytes,7,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_database_monitoring_v1_job_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_job_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobRun) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobRun) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobRun) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *JobRun) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *JobRun) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobRun) GetCurrentStep() string {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return ""
+}
+
+// JobRunReview is a run with the median duration of the job over the runs that succeeded before it
+type JobRunReview struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Run              *JobRun                `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	MedianDurationMs int64                  `protobuf:"varint,2,opt,name=median_duration_ms,json=medianDurationMs,proto3" json:"median_duration_ms,omitempty"`
+	// long_running is set when the run took at least three times the median duration of the job
+	LongRunning   bool `protobuf:"varint,3,opt,name=long_running,json=longRunning,proto3" json:"long_running,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRunReview) Reset() {
+	*x = JobRunReview{}
+	mi := &file_database_monitoring_v1_job_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRunReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRunReview) ProtoMessage() {}
+
+func (x *JobRunReview) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_job_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRunReview.ProtoReflect.Descriptor instead.
+func (*JobRunReview) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobRunReview) GetRun() *JobRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *JobRunReview) GetMedianDurationMs() int64 {
+	if x != nil {
+		return x.MedianDurationMs
+	}
+	return 0
+}
+
+func (x *JobRunReview) GetLongRunning() bool {
+	if x != nil {
+		return x.LongRunning
+	}
+	return false
+}
+
+var File_database_monitoring_v1_job_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_job_proto_rawDesc = "" +
+	"\n" +
+	" database_monitoring/v1/job.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\x06JobRun\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x19\n" +
+	"\bjob_name\x18\x02 \x01(\tR\ajobName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12!\n" +
+	"\fcurrent_step\x18\a \x01(\tR\vcurrentStep\"\x91\x01\n" +
+	"\fJobRunReview\x120\n" +
+	"\x03run\x18\x01 \x01(\v2\x1e.database_monitoring.v1.JobRunR\x03run\x12,\n" +
+	"\x12median_duration_ms\x18\x02 \x01(\x03R\x10medianDurationMs\x12!\n" +
+	"\flong_running\x18\x03 \x01(\bR\vlongRunningBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_job_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_job_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_job_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_job_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_job_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_job_proto_rawDesc), len(file_database_monitoring_v1_job_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_job_proto_rawDescData
+}
+
+var file_database_monitoring_v1_job_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_database_monitoring_v1_job_proto_goTypes = []any{
+	(*JobRun)(nil),              // 0: database_monitoring.v1.JobRun
+	(*JobRunReview)(nil),        // 1: database_monitoring.v1.JobRunReview
+	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_job_proto_depIdxs = []int32{
+	2, // 0: database_monitoring.v1.JobRun.start_time:type_name -> google.protobuf.Timestamp
+	0, // 1: database_monitoring.v1.JobRunReview.run:type_name -> database_monitoring.v1.JobRun
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_job_proto_init() }
+func file_database_monitoring_v1_job_proto_init() {
+	if File_database_monitoring_v1_job_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_job_proto_rawDesc), len(file_database_monitoring_v1_job_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_job_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_job_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_job_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_job_proto = out.File
+	file_database_monitoring_v1_job_proto_goTypes = nil
+	file_database_monitoring_v1_job_proto_depIdxs = nil
+}
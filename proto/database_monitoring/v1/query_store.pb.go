@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/query_store.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryStorePlan is a plan a query used, read from the query store of a database
+type QueryStorePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName  string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	QueryId       int64                  `protobuf:"varint,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	PlanId        int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	QueryHash     string                 `protobuf:"bytes,4,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	QueryPlanHash string                 `protobuf:"bytes,5,opt,name=query_plan_hash,json=queryPlanHash,proto3" json:"query_plan_hash,omitempty"`
+	QueryText     string                 `protobuf:"bytes,6,opt,name=query_text,json=queryText,proto3" json:"query_text,omitempty"`
+	// plan_xml is left empty when the plan did not compile since the previous read
+	PlanXml           string               `protobuf:"bytes,7,opt,name=plan_xml,json=planXml,proto3" json:"plan_xml,omitempty"`
+	IsForcedPlan      bool                 `protobuf:"varint,8,opt,name=is_forced_plan,json=isForcedPlan,proto3" json:"is_forced_plan,omitempty"`
+	FirstCompileTime  *timestamp.Timestamp `protobuf:"bytes,9,opt,name=first_compile_time,json=firstCompileTime,proto3" json:"first_compile_time,omitempty"`
+	LastExecutionTime *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_execution_time,json=lastExecutionTime,proto3" json:"last_execution_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QueryStorePlan) Reset() {
+	*x = QueryStorePlan{}
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryStorePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorePlan) ProtoMessage() {}
+
+func (x *QueryStorePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryStorePlan.ProtoReflect.Descriptor instead.
+func (*QueryStorePlan) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_query_store_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryStorePlan) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *QueryStorePlan) GetQueryId() int64 {
+	if x != nil {
+		return x.QueryId
+	}
+	return 0
+}
+
+func (x *QueryStorePlan) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *QueryStorePlan) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
+func (x *QueryStorePlan) GetQueryPlanHash() string {
+	if x != nil {
+		return x.QueryPlanHash
+	}
+	return ""
+}
+
+func (x *QueryStorePlan) GetQueryText() string {
+	if x != nil {
+		return x.QueryText
+	}
+	return ""
+}
+
+func (x *QueryStorePlan) GetPlanXml() string {
+	if x != nil {
+		return x.PlanXml
+	}
+	return ""
+}
+
+func (x *QueryStorePlan) GetIsForcedPlan() bool {
+	if x != nil {
+		return x.IsForcedPlan
+	}
+	return false
+}
+
+func (x *QueryStorePlan) GetFirstCompileTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.FirstCompileTime
+	}
+	return nil
+}
+
+func (x *QueryStorePlan) GetLastExecutionTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastExecutionTime
+	}
+	return nil
+}
+
+// QueryStoreRuntimeStats are the executions of a plan in a query store interval, summed over the execution types
+type QueryStoreRuntimeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName  string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	IntervalStart *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=interval_start,json=intervalStart,proto3" json:"interval_start,omitempty"`
+	IntervalEnd   *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=interval_end,json=intervalEnd,proto3" json:"interval_end,omitempty"`
+	Executions    int64                  `protobuf:"varint,5,opt,name=executions,proto3" json:"executions,omitempty"`
+	// aborted_executions are the executions cancelled by the client or ended by an error
+	AbortedExecutions int64                `protobuf:"varint,6,opt,name=aborted_executions,json=abortedExecutions,proto3" json:"aborted_executions,omitempty"`
+	AvgDurationUs     float64              `protobuf:"fixed64,7,opt,name=avg_duration_us,json=avgDurationUs,proto3" json:"avg_duration_us,omitempty"`
+	MaxDurationUs     float64              `protobuf:"fixed64,8,opt,name=max_duration_us,json=maxDurationUs,proto3" json:"max_duration_us,omitempty"`
+	AvgCpuTimeUs      float64              `protobuf:"fixed64,9,opt,name=avg_cpu_time_us,json=avgCpuTimeUs,proto3" json:"avg_cpu_time_us,omitempty"`
+	AvgLogicalReads   float64              `protobuf:"fixed64,10,opt,name=avg_logical_reads,json=avgLogicalReads,proto3" json:"avg_logical_reads,omitempty"`
+	AvgPhysicalReads  float64              `protobuf:"fixed64,11,opt,name=avg_physical_reads,json=avgPhysicalReads,proto3" json:"avg_physical_reads,omitempty"`
+	AvgRowcount       float64              `protobuf:"fixed64,12,opt,name=avg_rowcount,json=avgRowcount,proto3" json:"avg_rowcount,omitempty"`
+	LastExecutionTime *timestamp.Timestamp `protobuf:"bytes,13,opt,name=last_execution_time,json=lastExecutionTime,proto3" json:"last_execution_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QueryStoreRuntimeStats) Reset() {
+	*x = QueryStoreRuntimeStats{}
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryStoreRuntimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStoreRuntimeStats) ProtoMessage() {}
+
+func (x *QueryStoreRuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryStoreRuntimeStats.ProtoReflect.Descriptor instead.
+func (*QueryStoreRuntimeStats) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_query_store_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryStoreRuntimeStats) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *QueryStoreRuntimeStats) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetIntervalStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.IntervalStart
+	}
+	return nil
+}
+
+func (x *QueryStoreRuntimeStats) GetIntervalEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.IntervalEnd
+	}
+	return nil
+}
+
+func (x *QueryStoreRuntimeStats) GetExecutions() int64 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAbortedExecutions() int64 {
+	if x != nil {
+		return x.AbortedExecutions
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAvgDurationUs() float64 {
+	if x != nil {
+		return x.AvgDurationUs
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetMaxDurationUs() float64 {
+	if x != nil {
+		return x.MaxDurationUs
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAvgCpuTimeUs() float64 {
+	if x != nil {
+		return x.AvgCpuTimeUs
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAvgLogicalReads() float64 {
+	if x != nil {
+		return x.AvgLogicalReads
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAvgPhysicalReads() float64 {
+	if x != nil {
+		return x.AvgPhysicalReads
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetAvgRowcount() float64 {
+	if x != nil {
+		return x.AvgRowcount
+	}
+	return 0
+}
+
+func (x *QueryStoreRuntimeStats) GetLastExecutionTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastExecutionTime
+	}
+	return nil
+}
+
+// QueryPlanHistory is a plan of a query with how it performed in each query store interval of the range
+type QueryPlanHistory struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Plan            *QueryStorePlan           `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	RuntimeStats    []*QueryStoreRuntimeStats `protobuf:"bytes,2,rep,name=runtime_stats,json=runtimeStats,proto3" json:"runtime_stats,omitempty"`
+	Executions      int64                     `protobuf:"varint,3,opt,name=executions,proto3" json:"executions,omitempty"`
+	AvgDurationUs   float64                   `protobuf:"fixed64,4,opt,name=avg_duration_us,json=avgDurationUs,proto3" json:"avg_duration_us,omitempty"`
+	AvgCpuTimeUs    float64                   `protobuf:"fixed64,5,opt,name=avg_cpu_time_us,json=avgCpuTimeUs,proto3" json:"avg_cpu_time_us,omitempty"`
+	AvgLogicalReads float64                   `protobuf:"fixed64,6,opt,name=avg_logical_reads,json=avgLogicalReads,proto3" json:"avg_logical_reads,omitempty"`
+	// regressed is set when the plan averages at least twice the duration of the fastest plan of the query
+	Regressed     bool `protobuf:"varint,7,opt,name=regressed,proto3" json:"regressed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlanHistory) Reset() {
+	*x = QueryPlanHistory{}
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlanHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanHistory) ProtoMessage() {}
+
+func (x *QueryPlanHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_query_store_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanHistory.ProtoReflect.Descriptor instead.
+func (*QueryPlanHistory) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_query_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryPlanHistory) GetPlan() *QueryStorePlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *QueryPlanHistory) GetRuntimeStats() []*QueryStoreRuntimeStats {
+	if x != nil {
+		return x.RuntimeStats
+	}
+	return nil
+}
+
+func (x *QueryPlanHistory) GetExecutions() int64 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *QueryPlanHistory) GetAvgDurationUs() float64 {
+	if x != nil {
+		return x.AvgDurationUs
+	}
+	return 0
+}
+
+func (x *QueryPlanHistory) GetAvgCpuTimeUs() float64 {
+	if x != nil {
+		return x.AvgCpuTimeUs
+	}
+	return 0
+}
+
+func (x *QueryPlanHistory) GetAvgLogicalReads() float64 {
+	if x != nil {
+		return x.AvgLogicalReads
+	}
+	return 0
+}
+
+func (x *QueryPlanHistory) GetRegressed() bool {
+	if x != nil {
+		return x.Regressed
+	}
+	return false
+}
+
+var File_database_monitoring_v1_query_store_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_query_store_proto_rawDesc = "" +
+	"\n" +
+	"(database_monitoring/v1/query_store.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x03\n" +
+	"\x0eQueryStorePlan\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x19\n" +
+	"\bquery_id\x18\x02 \x01(\x03R\aqueryId\x12\x17\n" +
+	"\aplan_id\x18\x03 \x01(\x03R\x06planId\x12\x1d\n" +
+	"\n" +
+	"query_hash\x18\x04 \x01(\tR\tqueryHash\x12&\n" +
+	"\x0fquery_plan_hash\x18\x05 \x01(\tR\rqueryPlanHash\x12\x1d\n" +
+	"\n" +
+	"query_text\x18\x06 \x01(\tR\tqueryText\x12\x19\n" +
+	"\bplan_xml\x18\a \x01(\tR\aplanXml\x12$\n" +
+	"\x0eis_forced_plan\x18\b \x01(\bR\fisForcedPlan\x12H\n" +
+	"\x12first_compile_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10firstCompileTime\x12J\n" +
+	"\x13last_execution_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11lastExecutionTime\"\xe7\x04\n" +
+	"\x16QueryStoreRuntimeStats\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x03R\x06planId\x12A\n" +
+	"\x0einterval_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rintervalStart\x12=\n" +
+	"\finterval_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vintervalEnd\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x05 \x01(\x03R\n" +
+	"executions\x12-\n" +
+	"\x12aborted_executions\x18\x06 \x01(\x03R\x11abortedExecutions\x12&\n" +
+	"\x0favg_duration_us\x18\a \x01(\x01R\ravgDurationUs\x12&\n" +
+	"\x0fmax_duration_us\x18\b \x01(\x01R\rmaxDurationUs\x12%\n" +
+	"\x0favg_cpu_time_us\x18\t \x01(\x01R\favgCpuTimeUs\x12*\n" +
+	"\x11avg_logical_reads\x18\n" +
+	" \x01(\x01R\x0favgLogicalReads\x12,\n" +
+	"\x12avg_physical_reads\x18\v \x01(\x01R\x10avgPhysicalReads\x12!\n" +
+	"\favg_rowcount\x18\f \x01(\x01R\vavgRowcount\x12J\n" +
+	"\x13last_execution_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x11lastExecutionTime\"\xdc\x02\n" +
+	"\x10QueryPlanHistory\x12:\n" +
+	"\x04plan\x18\x01 \x01(\v2&.database_monitoring.v1.QueryStorePlanR\x04plan\x12S\n" +
+	"\rruntime_stats\x18\x02 \x03(\v2..database_monitoring.v1.QueryStoreRuntimeStatsR\fruntimeStats\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x03 \x01(\x03R\n" +
+	"executions\x12&\n" +
+	"\x0favg_duration_us\x18\x04 \x01(\x01R\ravgDurationUs\x12%\n" +
+	"\x0favg_cpu_time_us\x18\x05 \x01(\x01R\favgCpuTimeUs\x12*\n" +
+	"\x11avg_logical_reads\x18\x06 \x01(\x01R\x0favgLogicalReads\x12\x1c\n" +
+	"\tregressed\x18\a \x01(\bR\tregressedBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_query_store_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_query_store_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_query_store_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_query_store_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_query_store_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_query_store_proto_rawDesc), len(file_database_monitoring_v1_query_store_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_query_store_proto_rawDescData
+}
+
+var file_database_monitoring_v1_query_store_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_database_monitoring_v1_query_store_proto_goTypes = []any{
+	(*QueryStorePlan)(nil),         // 0: database_monitoring.v1.QueryStorePlan
+	(*QueryStoreRuntimeStats)(nil), // 1: database_monitoring.v1.QueryStoreRuntimeStats
+	(*QueryPlanHistory)(nil),       // 2: database_monitoring.v1.QueryPlanHistory
+	(*timestamp.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_query_store_proto_depIdxs = []int32{
+	3, // 0: database_monitoring.v1.QueryStorePlan.first_compile_time:type_name -> google.protobuf.Timestamp
+	3, // 1: database_monitoring.v1.QueryStorePlan.last_execution_time:type_name -> google.protobuf.Timestamp
+	3, // 2: database_monitoring.v1.QueryStoreRuntimeStats.interval_start:type_name -> google.protobuf.Timestamp
+	3, // 3: database_monitoring.v1.QueryStoreRuntimeStats.interval_end:type_name -> google.protobuf.Timestamp
+	3, // 4: database_monitoring.v1.QueryStoreRuntimeStats.last_execution_time:type_name -> google.protobuf.Timestamp
+	0, // 5: database_monitoring.v1.QueryPlanHistory.plan:type_name -> database_monitoring.v1.QueryStorePlan
+	1, // 6: database_monitoring.v1.QueryPlanHistory.runtime_stats:type_name -> database_monitoring.v1.QueryStoreRuntimeStats
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_query_store_proto_init() }
+func file_database_monitoring_v1_query_store_proto_init() {
+	if File_database_monitoring_v1_query_store_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_query_store_proto_rawDesc), len(file_database_monitoring_v1_query_store_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_query_store_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_query_store_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_query_store_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_query_store_proto = out.File
+	file_database_monitoring_v1_query_store_proto_goTypes = nil
+	file_database_monitoring_v1_query_store_proto_depIdxs = nil
+}
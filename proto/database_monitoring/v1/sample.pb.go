@@ -39,8 +39,16 @@ type QuerySample struct {
 	Id                string                 `protobuf:"bytes,13,opt,name=id,proto3" json:"id,omitempty"`
 	Command           *CommandMetadata       `protobuf:"bytes,14,opt,name=command,proto3" json:"command,omitempty"`
 	QueryHash         string                 `protobuf:"bytes,15,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// locks are set for the sessions of a blocking chain
+	Locks []*LockDetail `protobuf:"bytes,16,rep,name=locks,proto3" json:"locks,omitempty"`
+	// idle_session is set for sleeping sessions blocking others
+	IdleSession *IdleSessionMetadata `protobuf:"bytes,17,opt,name=idle_session,json=idleSession,proto3" json:"idle_session,omitempty"`
+	// statement is the statement of text being run, unset when text is not the batch of a running request
+	Statement *StatementMetadata `protobuf:"bytes,18,opt,name=statement,proto3" json:"statement,omitempty"`
+	// live_progress is the progress of the plan operators of a long running request, from sys.dm_exec_query_profiles
+	LiveProgress  []*OperatorProgress `protobuf:"bytes,19,rep,name=live_progress,json=liveProgress,proto3" json:"live_progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QuerySample) Reset() {
@@ -178,6 +186,447 @@ func (x *QuerySample) GetQueryHash() string {
 	return ""
 }
 
+func (x *QuerySample) GetLocks() []*LockDetail {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *QuerySample) GetIdleSession() *IdleSessionMetadata {
+	if x != nil {
+		return x.IdleSession
+	}
+	return nil
+}
+
+func (x *QuerySample) GetStatement() *StatementMetadata {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *QuerySample) GetLiveProgress() []*OperatorProgress {
+	if x != nil {
+		return x.LiveProgress
+	}
+	return nil
+}
+
+// OperatorProgress sums the threads of a plan operator
+type OperatorProgress struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NodeId            int64                  `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PhysicalOperator  string                 `protobuf:"bytes,2,opt,name=physical_operator,json=physicalOperator,proto3" json:"physical_operator,omitempty"`
+	RowCount          int64                  `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	EstimatedRowCount int64                  `protobuf:"varint,4,opt,name=estimated_row_count,json=estimatedRowCount,proto3" json:"estimated_row_count,omitempty"`
+	ElapsedTimeMs     int64                  `protobuf:"varint,5,opt,name=elapsed_time_ms,json=elapsedTimeMs,proto3" json:"elapsed_time_ms,omitempty"`
+	CpuTimeMs         int64                  `protobuf:"varint,6,opt,name=cpu_time_ms,json=cpuTimeMs,proto3" json:"cpu_time_ms,omitempty"`
+	LogicalReads      int64                  `protobuf:"varint,7,opt,name=logical_reads,json=logicalReads,proto3" json:"logical_reads,omitempty"`
+	// active is set while the operator is open and not closed yet
+	Active        bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperatorProgress) Reset() {
+	*x = OperatorProgress{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperatorProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperatorProgress) ProtoMessage() {}
+
+func (x *OperatorProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperatorProgress.ProtoReflect.Descriptor instead.
+func (*OperatorProgress) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OperatorProgress) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetPhysicalOperator() string {
+	if x != nil {
+		return x.PhysicalOperator
+	}
+	return ""
+}
+
+func (x *OperatorProgress) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetEstimatedRowCount() int64 {
+	if x != nil {
+		return x.EstimatedRowCount
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetElapsedTimeMs() int64 {
+	if x != nil {
+		return x.ElapsedTimeMs
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetCpuTimeMs() int64 {
+	if x != nil {
+		return x.CpuTimeMs
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetLogicalReads() int64 {
+	if x != nil {
+		return x.LogicalReads
+	}
+	return 0
+}
+
+func (x *OperatorProgress) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type StatementMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// start_offset and end_offset locate the statement in the batch text, in UTF-16 code units
+	StartOffset int64 `protobuf:"varint,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	EndOffset   int64 `protobuf:"varint,3,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+	// object_name is the schema qualified procedure, function or trigger the batch belongs to, empty for ad hoc batches
+	ObjectName    string `protobuf:"bytes,4,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementMetadata) Reset() {
+	*x = StatementMetadata{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementMetadata) ProtoMessage() {}
+
+func (x *StatementMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementMetadata.ProtoReflect.Descriptor instead.
+func (*StatementMetadata) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatementMetadata) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *StatementMetadata) GetStartOffset() int64 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *StatementMetadata) GetEndOffset() int64 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+func (x *StatementMetadata) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+type IdleSessionMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// input_buffer is the last statement or RPC the session sent, from sys.dm_exec_input_buffer
+	InputBuffer          string `protobuf:"bytes,1,opt,name=input_buffer,json=inputBuffer,proto3" json:"input_buffer,omitempty"`
+	InputBufferEventType string `protobuf:"bytes,2,opt,name=input_buffer_event_type,json=inputBufferEventType,proto3" json:"input_buffer_event_type,omitempty"`
+	OpenTransactionCount int64  `protobuf:"varint,3,opt,name=open_transaction_count,json=openTransactionCount,proto3" json:"open_transaction_count,omitempty"`
+	// transaction_begin_time is unset when the session has no transaction open
+	TransactionBeginTime  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=transaction_begin_time,json=transactionBeginTime,proto3" json:"transaction_begin_time,omitempty"`
+	TransactionDurationMs int64                `protobuf:"varint,5,opt,name=transaction_duration_ms,json=transactionDurationMs,proto3" json:"transaction_duration_ms,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *IdleSessionMetadata) Reset() {
+	*x = IdleSessionMetadata{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdleSessionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdleSessionMetadata) ProtoMessage() {}
+
+func (x *IdleSessionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdleSessionMetadata.ProtoReflect.Descriptor instead.
+func (*IdleSessionMetadata) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IdleSessionMetadata) GetInputBuffer() string {
+	if x != nil {
+		return x.InputBuffer
+	}
+	return ""
+}
+
+func (x *IdleSessionMetadata) GetInputBufferEventType() string {
+	if x != nil {
+		return x.InputBufferEventType
+	}
+	return ""
+}
+
+func (x *IdleSessionMetadata) GetOpenTransactionCount() int64 {
+	if x != nil {
+		return x.OpenTransactionCount
+	}
+	return 0
+}
+
+func (x *IdleSessionMetadata) GetTransactionBeginTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.TransactionBeginTime
+	}
+	return nil
+}
+
+func (x *IdleSessionMetadata) GetTransactionDurationMs() int64 {
+	if x != nil {
+		return x.TransactionDurationMs
+	}
+	return 0
+}
+
+type LockDetail struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType        string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	DatabaseId          int64                  `protobuf:"varint,2,opt,name=database_id,json=databaseId,proto3" json:"database_id,omitempty"`
+	DatabaseName        string                 `protobuf:"bytes,3,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	ResourceDescription string                 `protobuf:"bytes,4,opt,name=resource_description,json=resourceDescription,proto3" json:"resource_description,omitempty"`
+	// associated_entity_id is the hobt id of KEY, PAGE, RID and HOBT locks and the object id of OBJECT locks
+	AssociatedEntityId int64  `protobuf:"varint,5,opt,name=associated_entity_id,json=associatedEntityId,proto3" json:"associated_entity_id,omitempty"`
+	RequestMode        string `protobuf:"bytes,6,opt,name=request_mode,json=requestMode,proto3" json:"request_mode,omitempty"`
+	// request_status is GRANT, WAIT or CONVERT
+	RequestStatus  string              `protobuf:"bytes,7,opt,name=request_status,json=requestStatus,proto3" json:"request_status,omitempty"`
+	ResourceObject *WaitResourceObject `protobuf:"bytes,8,opt,name=resource_object,json=resourceObject,proto3" json:"resource_object,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LockDetail) Reset() {
+	*x = LockDetail{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockDetail) ProtoMessage() {}
+
+func (x *LockDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockDetail.ProtoReflect.Descriptor instead.
+func (*LockDetail) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LockDetail) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *LockDetail) GetDatabaseId() int64 {
+	if x != nil {
+		return x.DatabaseId
+	}
+	return 0
+}
+
+func (x *LockDetail) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *LockDetail) GetResourceDescription() string {
+	if x != nil {
+		return x.ResourceDescription
+	}
+	return ""
+}
+
+func (x *LockDetail) GetAssociatedEntityId() int64 {
+	if x != nil {
+		return x.AssociatedEntityId
+	}
+	return 0
+}
+
+func (x *LockDetail) GetRequestMode() string {
+	if x != nil {
+		return x.RequestMode
+	}
+	return ""
+}
+
+func (x *LockDetail) GetRequestStatus() string {
+	if x != nil {
+		return x.RequestStatus
+	}
+	return ""
+}
+
+func (x *LockDetail) GetResourceObject() *WaitResourceObject {
+	if x != nil {
+		return x.ResourceObject
+	}
+	return nil
+}
+
+// LockConflict is the lock a blocked session waits for and the incompatible locks its blocker has on the resource
+type LockConflict struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WaitingLock       *LockDetail            `protobuf:"bytes,1,opt,name=waiting_lock,json=waitingLock,proto3" json:"waiting_lock,omitempty"`
+	BlockingSessionId string                 `protobuf:"bytes,2,opt,name=blocking_session_id,json=blockingSessionId,proto3" json:"blocking_session_id,omitempty"`
+	BlockingLocks     []*LockDetail          `protobuf:"bytes,3,rep,name=blocking_locks,json=blockingLocks,proto3" json:"blocking_locks,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LockConflict) Reset() {
+	*x = LockConflict{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockConflict) ProtoMessage() {}
+
+func (x *LockConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockConflict.ProtoReflect.Descriptor instead.
+func (*LockConflict) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LockConflict) GetWaitingLock() *LockDetail {
+	if x != nil {
+		return x.WaitingLock
+	}
+	return nil
+}
+
+func (x *LockConflict) GetBlockingSessionId() string {
+	if x != nil {
+		return x.BlockingSessionId
+	}
+	return ""
+}
+
+func (x *LockConflict) GetBlockingLocks() []*LockDetail {
+	if x != nil {
+		return x.BlockingLocks
+	}
+	return nil
+}
+
 type CommandMetadata struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	TransactionId           string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -190,7 +639,7 @@ type CommandMetadata struct {
 
 func (x *CommandMetadata) Reset() {
 	*x = CommandMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -202,7 +651,7 @@ func (x *CommandMetadata) String() string {
 func (*CommandMetadata) ProtoMessage() {}
 
 func (x *CommandMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +664,7 @@ func (x *CommandMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandMetadata.ProtoReflect.Descriptor instead.
 func (*CommandMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{1}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CommandMetadata) GetTransactionId() string {
@@ -256,7 +705,7 @@ type SnapMetadata struct {
 
 func (x *SnapMetadata) Reset() {
 	*x = SnapMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -268,7 +717,7 @@ func (x *SnapMetadata) String() string {
 func (*SnapMetadata) ProtoMessage() {}
 
 func (x *SnapMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[2]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +730,7 @@ func (x *SnapMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SnapMetadata.ProtoReflect.Descriptor instead.
 func (*SnapMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{2}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapMetadata) GetId() string {
@@ -316,7 +765,7 @@ type SessionMetadata struct {
 
 func (x *SessionMetadata) Reset() {
 	*x = SessionMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -328,7 +777,7 @@ func (x *SessionMetadata) String() string {
 func (*SessionMetadata) ProtoMessage() {}
 
 func (x *SessionMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[3]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,7 +790,7 @@ func (x *SessionMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionMetadata.ProtoReflect.Descriptor instead.
 func (*SessionMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{3}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SessionMetadata) GetSessionId() string {
@@ -424,7 +873,7 @@ type DBMetadata struct {
 
 func (x *DBMetadata) Reset() {
 	*x = DBMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +885,7 @@ func (x *DBMetadata) String() string {
 func (*DBMetadata) ProtoMessage() {}
 
 func (x *DBMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +898,7 @@ func (x *DBMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DBMetadata.ProtoReflect.Descriptor instead.
 func (*DBMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{4}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DBMetadata) GetDatabaseId() string {
@@ -476,7 +925,7 @@ type BlockMetadata struct {
 
 func (x *BlockMetadata) Reset() {
 	*x = BlockMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -488,7 +937,7 @@ func (x *BlockMetadata) String() string {
 func (*BlockMetadata) ProtoMessage() {}
 
 func (x *BlockMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +950,7 @@ func (x *BlockMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockMetadata.ProtoReflect.Descriptor instead.
 func (*BlockMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{5}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BlockMetadata) GetBlockedBy() string {
@@ -519,18 +968,20 @@ func (x *BlockMetadata) GetBlockedSessions() []string {
 }
 
 type WaitMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WaitType      string                 `protobuf:"bytes,1,opt,name=wait_type,json=waitType,proto3" json:"wait_type,omitempty"`
-	WaitTime      int64                  `protobuf:"varint,2,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
-	LastWaitType  string                 `protobuf:"bytes,3,opt,name=last_wait_type,json=lastWaitType,proto3" json:"last_wait_type,omitempty"`
-	WaitResource  string                 `protobuf:"bytes,4,opt,name=wait_resource,json=waitResource,proto3" json:"wait_resource,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	WaitType     string                 `protobuf:"bytes,1,opt,name=wait_type,json=waitType,proto3" json:"wait_type,omitempty"`
+	WaitTime     int64                  `protobuf:"varint,2,opt,name=wait_time,json=waitTime,proto3" json:"wait_time,omitempty"`
+	LastWaitType string                 `protobuf:"bytes,3,opt,name=last_wait_type,json=lastWaitType,proto3" json:"last_wait_type,omitempty"`
+	WaitResource string                 `protobuf:"bytes,4,opt,name=wait_resource,json=waitResource,proto3" json:"wait_resource,omitempty"`
+	// resource_object is the object wait_resource belongs to, unset when it could not be decoded
+	ResourceObject *WaitResourceObject `protobuf:"bytes,5,opt,name=resource_object,json=resourceObject,proto3" json:"resource_object,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *WaitMetadata) Reset() {
 	*x = WaitMetadata{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +993,7 @@ func (x *WaitMetadata) String() string {
 func (*WaitMetadata) ProtoMessage() {}
 
 func (x *WaitMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +1006,7 @@ func (x *WaitMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitMetadata.ProtoReflect.Descriptor instead.
 func (*WaitMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{6}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WaitMetadata) GetWaitType() string {
@@ -586,6 +1037,90 @@ func (x *WaitMetadata) GetWaitResource() string {
 	return ""
 }
 
+func (x *WaitMetadata) GetResourceObject() *WaitResourceObject {
+	if x != nil {
+		return x.ResourceObject
+	}
+	return nil
+}
+
+type WaitResourceObject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource_type is the wait_resource prefix: KEY, PAGE, RID, OBJECT, TAB or DATABASE
+	ResourceType  string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	DatabaseName  string `protobuf:"bytes,2,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	SchemaName    string `protobuf:"bytes,3,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName     string `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	IndexName     string `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitResourceObject) Reset() {
+	*x = WaitResourceObject{}
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitResourceObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitResourceObject) ProtoMessage() {}
+
+func (x *WaitResourceObject) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitResourceObject.ProtoReflect.Descriptor instead.
+func (*WaitResourceObject) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WaitResourceObject) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *WaitResourceObject) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *WaitResourceObject) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *WaitResourceObject) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *WaitResourceObject) GetIndexName() string {
+	if x != nil {
+		return x.IndexName
+	}
+	return ""
+}
+
 type QueryMetric struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	QueryHash             string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
@@ -602,7 +1137,7 @@ type QueryMetric struct {
 
 func (x *QueryMetric) Reset() {
 	*x = QueryMetric{}
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +1149,7 @@ func (x *QueryMetric) String() string {
 func (*QueryMetric) ProtoMessage() {}
 
 func (x *QueryMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_sample_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_sample_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +1162,7 @@ func (x *QueryMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMetric.ProtoReflect.Descriptor instead.
 func (*QueryMetric) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{7}
+	return file_database_monitoring_v1_sample_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryMetric) GetQueryHash() string {
@@ -690,7 +1225,7 @@ var File_database_monitoring_v1_sample_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_sample_proto_rawDesc = "" +
 	"\n" +
-	"#database_monitoring/v1/sample.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x05\n" +
+	"#database_monitoring/v1/sample.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\a\n" +
 	"\vQuerySample\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
@@ -711,7 +1246,48 @@ const file_database_monitoring_v1_sample_proto_rawDesc = "" +
 	"\x02id\x18\r \x01(\tR\x02id\x12A\n" +
 	"\acommand\x18\x0e \x01(\v2'.database_monitoring.v1.CommandMetadataR\acommand\x12\x1d\n" +
 	"\n" +
-	"query_hash\x18\x0f \x01(\tR\tqueryHash\"\xbe\x01\n" +
+	"query_hash\x18\x0f \x01(\tR\tqueryHash\x128\n" +
+	"\x05locks\x18\x10 \x03(\v2\".database_monitoring.v1.LockDetailR\x05locks\x12N\n" +
+	"\fidle_session\x18\x11 \x01(\v2+.database_monitoring.v1.IdleSessionMetadataR\vidleSession\x12G\n" +
+	"\tstatement\x18\x12 \x01(\v2).database_monitoring.v1.StatementMetadataR\tstatement\x12M\n" +
+	"\rlive_progress\x18\x13 \x03(\v2(.database_monitoring.v1.OperatorProgressR\fliveProgress\"\xaa\x02\n" +
+	"\x10OperatorProgress\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\x03R\x06nodeId\x12+\n" +
+	"\x11physical_operator\x18\x02 \x01(\tR\x10physicalOperator\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x03R\browCount\x12.\n" +
+	"\x13estimated_row_count\x18\x04 \x01(\x03R\x11estimatedRowCount\x12&\n" +
+	"\x0felapsed_time_ms\x18\x05 \x01(\x03R\relapsedTimeMs\x12\x1e\n" +
+	"\vcpu_time_ms\x18\x06 \x01(\x03R\tcpuTimeMs\x12#\n" +
+	"\rlogical_reads\x18\a \x01(\x03R\flogicalReads\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\"\x8a\x01\n" +
+	"\x11StatementMetadata\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12!\n" +
+	"\fstart_offset\x18\x02 \x01(\x03R\vstartOffset\x12\x1d\n" +
+	"\n" +
+	"end_offset\x18\x03 \x01(\x03R\tendOffset\x12\x1f\n" +
+	"\vobject_name\x18\x04 \x01(\tR\n" +
+	"objectName\"\xaf\x02\n" +
+	"\x13IdleSessionMetadata\x12!\n" +
+	"\finput_buffer\x18\x01 \x01(\tR\vinputBuffer\x125\n" +
+	"\x17input_buffer_event_type\x18\x02 \x01(\tR\x14inputBufferEventType\x124\n" +
+	"\x16open_transaction_count\x18\x03 \x01(\x03R\x14openTransactionCount\x12P\n" +
+	"\x16transaction_begin_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x14transactionBeginTime\x126\n" +
+	"\x17transaction_duration_ms\x18\x05 \x01(\x03R\x15transactionDurationMs\"\xfb\x02\n" +
+	"\n" +
+	"LockDetail\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vdatabase_id\x18\x02 \x01(\x03R\n" +
+	"databaseId\x12#\n" +
+	"\rdatabase_name\x18\x03 \x01(\tR\fdatabaseName\x121\n" +
+	"\x14resource_description\x18\x04 \x01(\tR\x13resourceDescription\x120\n" +
+	"\x14associated_entity_id\x18\x05 \x01(\x03R\x12associatedEntityId\x12!\n" +
+	"\frequest_mode\x18\x06 \x01(\tR\vrequestMode\x12%\n" +
+	"\x0erequest_status\x18\a \x01(\tR\rrequestStatus\x12S\n" +
+	"\x0fresource_object\x18\b \x01(\v2*.database_monitoring.v1.WaitResourceObjectR\x0eresourceObject\"\xd0\x01\n" +
+	"\fLockConflict\x12E\n" +
+	"\fwaiting_lock\x18\x01 \x01(\v2\".database_monitoring.v1.LockDetailR\vwaitingLock\x12.\n" +
+	"\x13blocking_session_id\x18\x02 \x01(\tR\x11blockingSessionId\x12I\n" +
+	"\x0eblocking_locks\x18\x03 \x03(\v2\".database_monitoring.v1.LockDetailR\rblockingLocks\"\xbe\x01\n" +
 	"\x0fCommandMetadata\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
 	"\n" +
@@ -744,12 +1320,22 @@ const file_database_monitoring_v1_sample_proto_rawDesc = "" +
 	"\rBlockMetadata\x12\x1d\n" +
 	"\n" +
 	"blocked_by\x18\x01 \x01(\tR\tblockedBy\x12)\n" +
-	"\x10blocked_sessions\x18\x02 \x03(\tR\x0fblockedSessions\"\x93\x01\n" +
+	"\x10blocked_sessions\x18\x02 \x03(\tR\x0fblockedSessions\"\xe8\x01\n" +
 	"\fWaitMetadata\x12\x1b\n" +
 	"\twait_type\x18\x01 \x01(\tR\bwaitType\x12\x1b\n" +
 	"\twait_time\x18\x02 \x01(\x03R\bwaitTime\x12$\n" +
 	"\x0elast_wait_type\x18\x03 \x01(\tR\flastWaitType\x12#\n" +
-	"\rwait_resource\x18\x04 \x01(\tR\fwaitResource\"\xc4\x04\n" +
+	"\rwait_resource\x18\x04 \x01(\tR\fwaitResource\x12S\n" +
+	"\x0fresource_object\x18\x05 \x01(\v2*.database_monitoring.v1.WaitResourceObjectR\x0eresourceObject\"\xbd\x01\n" +
+	"\x12WaitResourceObject\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12#\n" +
+	"\rdatabase_name\x18\x02 \x01(\tR\fdatabaseName\x12\x1f\n" +
+	"\vschema_name\x18\x03 \x01(\tR\n" +
+	"schemaName\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x04 \x01(\tR\ttableName\x12\x1d\n" +
+	"\n" +
+	"index_name\x18\x05 \x01(\tR\tindexName\"\xc4\x04\n" +
 	"\vQueryMetric\x12\x1d\n" +
 	"\n" +
 	"query_hash\x18\x01 \x01(\tR\tqueryHash\x12\x12\n" +
@@ -780,41 +1366,56 @@ func file_database_monitoring_v1_sample_proto_rawDescGZIP() []byte {
 	return file_database_monitoring_v1_sample_proto_rawDescData
 }
 
-var file_database_monitoring_v1_sample_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_database_monitoring_v1_sample_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_database_monitoring_v1_sample_proto_goTypes = []any{
 	(*QuerySample)(nil),         // 0: database_monitoring.v1.QuerySample
-	(*CommandMetadata)(nil),     // 1: database_monitoring.v1.CommandMetadata
-	(*SnapMetadata)(nil),        // 2: database_monitoring.v1.SnapMetadata
-	(*SessionMetadata)(nil),     // 3: database_monitoring.v1.SessionMetadata
-	(*DBMetadata)(nil),          // 4: database_monitoring.v1.DBMetadata
-	(*BlockMetadata)(nil),       // 5: database_monitoring.v1.BlockMetadata
-	(*WaitMetadata)(nil),        // 6: database_monitoring.v1.WaitMetadata
-	(*QueryMetric)(nil),         // 7: database_monitoring.v1.QueryMetric
-	nil,                         // 8: database_monitoring.v1.QueryMetric.CountersEntry
-	nil,                         // 9: database_monitoring.v1.QueryMetric.RatesEntry
-	(*timestamp.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*OperatorProgress)(nil),    // 1: database_monitoring.v1.OperatorProgress
+	(*StatementMetadata)(nil),   // 2: database_monitoring.v1.StatementMetadata
+	(*IdleSessionMetadata)(nil), // 3: database_monitoring.v1.IdleSessionMetadata
+	(*LockDetail)(nil),          // 4: database_monitoring.v1.LockDetail
+	(*LockConflict)(nil),        // 5: database_monitoring.v1.LockConflict
+	(*CommandMetadata)(nil),     // 6: database_monitoring.v1.CommandMetadata
+	(*SnapMetadata)(nil),        // 7: database_monitoring.v1.SnapMetadata
+	(*SessionMetadata)(nil),     // 8: database_monitoring.v1.SessionMetadata
+	(*DBMetadata)(nil),          // 9: database_monitoring.v1.DBMetadata
+	(*BlockMetadata)(nil),       // 10: database_monitoring.v1.BlockMetadata
+	(*WaitMetadata)(nil),        // 11: database_monitoring.v1.WaitMetadata
+	(*WaitResourceObject)(nil),  // 12: database_monitoring.v1.WaitResourceObject
+	(*QueryMetric)(nil),         // 13: database_monitoring.v1.QueryMetric
+	nil,                         // 14: database_monitoring.v1.QueryMetric.CountersEntry
+	nil,                         // 15: database_monitoring.v1.QueryMetric.RatesEntry
+	(*timestamp.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_database_monitoring_v1_sample_proto_depIdxs = []int32{
-	3,  // 0: database_monitoring.v1.QuerySample.session:type_name -> database_monitoring.v1.SessionMetadata
-	4,  // 1: database_monitoring.v1.QuerySample.db:type_name -> database_monitoring.v1.DBMetadata
-	5,  // 2: database_monitoring.v1.QuerySample.block_info:type_name -> database_monitoring.v1.BlockMetadata
-	6,  // 3: database_monitoring.v1.QuerySample.wait_info:type_name -> database_monitoring.v1.WaitMetadata
-	2,  // 4: database_monitoring.v1.QuerySample.snap_info:type_name -> database_monitoring.v1.SnapMetadata
-	1,  // 5: database_monitoring.v1.QuerySample.command:type_name -> database_monitoring.v1.CommandMetadata
-	10, // 6: database_monitoring.v1.SnapMetadata.timestamp:type_name -> google.protobuf.Timestamp
-	10, // 7: database_monitoring.v1.SessionMetadata.login_time:type_name -> google.protobuf.Timestamp
-	10, // 8: database_monitoring.v1.SessionMetadata.last_request_start:type_name -> google.protobuf.Timestamp
-	10, // 9: database_monitoring.v1.SessionMetadata.last_request_end:type_name -> google.protobuf.Timestamp
-	4,  // 10: database_monitoring.v1.QueryMetric.db:type_name -> database_monitoring.v1.DBMetadata
-	10, // 11: database_monitoring.v1.QueryMetric.last_execution_time:type_name -> google.protobuf.Timestamp
-	8,  // 12: database_monitoring.v1.QueryMetric.counters:type_name -> database_monitoring.v1.QueryMetric.CountersEntry
-	9,  // 13: database_monitoring.v1.QueryMetric.rates:type_name -> database_monitoring.v1.QueryMetric.RatesEntry
-	10, // 14: database_monitoring.v1.QueryMetric.collected_at:type_name -> google.protobuf.Timestamp
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	8,  // 0: database_monitoring.v1.QuerySample.session:type_name -> database_monitoring.v1.SessionMetadata
+	9,  // 1: database_monitoring.v1.QuerySample.db:type_name -> database_monitoring.v1.DBMetadata
+	10, // 2: database_monitoring.v1.QuerySample.block_info:type_name -> database_monitoring.v1.BlockMetadata
+	11, // 3: database_monitoring.v1.QuerySample.wait_info:type_name -> database_monitoring.v1.WaitMetadata
+	7,  // 4: database_monitoring.v1.QuerySample.snap_info:type_name -> database_monitoring.v1.SnapMetadata
+	6,  // 5: database_monitoring.v1.QuerySample.command:type_name -> database_monitoring.v1.CommandMetadata
+	4,  // 6: database_monitoring.v1.QuerySample.locks:type_name -> database_monitoring.v1.LockDetail
+	3,  // 7: database_monitoring.v1.QuerySample.idle_session:type_name -> database_monitoring.v1.IdleSessionMetadata
+	2,  // 8: database_monitoring.v1.QuerySample.statement:type_name -> database_monitoring.v1.StatementMetadata
+	1,  // 9: database_monitoring.v1.QuerySample.live_progress:type_name -> database_monitoring.v1.OperatorProgress
+	16, // 10: database_monitoring.v1.IdleSessionMetadata.transaction_begin_time:type_name -> google.protobuf.Timestamp
+	12, // 11: database_monitoring.v1.LockDetail.resource_object:type_name -> database_monitoring.v1.WaitResourceObject
+	4,  // 12: database_monitoring.v1.LockConflict.waiting_lock:type_name -> database_monitoring.v1.LockDetail
+	4,  // 13: database_monitoring.v1.LockConflict.blocking_locks:type_name -> database_monitoring.v1.LockDetail
+	16, // 14: database_monitoring.v1.SnapMetadata.timestamp:type_name -> google.protobuf.Timestamp
+	16, // 15: database_monitoring.v1.SessionMetadata.login_time:type_name -> google.protobuf.Timestamp
+	16, // 16: database_monitoring.v1.SessionMetadata.last_request_start:type_name -> google.protobuf.Timestamp
+	16, // 17: database_monitoring.v1.SessionMetadata.last_request_end:type_name -> google.protobuf.Timestamp
+	12, // 18: database_monitoring.v1.WaitMetadata.resource_object:type_name -> database_monitoring.v1.WaitResourceObject
+	9,  // 19: database_monitoring.v1.QueryMetric.db:type_name -> database_monitoring.v1.DBMetadata
+	16, // 20: database_monitoring.v1.QueryMetric.last_execution_time:type_name -> google.protobuf.Timestamp
+	14, // 21: database_monitoring.v1.QueryMetric.counters:type_name -> database_monitoring.v1.QueryMetric.CountersEntry
+	15, // 22: database_monitoring.v1.QueryMetric.rates:type_name -> database_monitoring.v1.QueryMetric.RatesEntry
+	16, // 23: database_monitoring.v1.QueryMetric.collected_at:type_name -> google.protobuf.Timestamp
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_sample_proto_init() }
@@ -828,7 +1429,7 @@ func file_database_monitoring_v1_sample_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_sample_proto_rawDesc), len(file_database_monitoring_v1_sample_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,13 +23,17 @@ const (
 )
 
 type DBSnapshot struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Server        *ServerMetadata        `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
-	Samples       []*QuerySample         `protobuf:"bytes,5,rep,name=samples,proto3" json:"samples,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Server    *ServerMetadata        `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	Samples   []*QuerySample         `protobuf:"bytes,5,rep,name=samples,proto3" json:"samples,omitempty"`
+	// transactions open for a while on the server, blocking or not
+	Transactions []*OpenTransaction `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// size of the version store in tempdb
+	VersionStoreKb int64 `protobuf:"varint,7,opt,name=version_store_kb,json=versionStoreKb,proto3" json:"version_store_kb,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DBSnapshot) Reset() {
@@ -90,6 +94,186 @@ func (x *DBSnapshot) GetSamples() []*QuerySample {
 	return nil
 }
 
+func (x *DBSnapshot) GetTransactions() []*OpenTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *DBSnapshot) GetVersionStoreKb() int64 {
+	if x != nil {
+		return x.VersionStoreKb
+	}
+	return 0
+}
+
+type OpenTransaction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId    int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SessionId        string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	HostName         string                 `protobuf:"bytes,4,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	ProgramName      string                 `protobuf:"bytes,5,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
+	LoginName        string                 `protobuf:"bytes,6,opt,name=login_name,json=loginName,proto3" json:"login_name,omitempty"`
+	SessionStatus    string                 `protobuf:"bytes,7,opt,name=session_status,json=sessionStatus,proto3" json:"session_status,omitempty"`
+	BeginTime        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"`
+	DurationMs       int64                  `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	TransactionType  string                 `protobuf:"bytes,10,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	TransactionState string                 `protobuf:"bytes,11,opt,name=transaction_state,json=transactionState,proto3" json:"transaction_state,omitempty"`
+	// database holding most of the log of the transaction
+	DatabaseName     string `protobuf:"bytes,12,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	LogBytesUsed     int64  `protobuf:"varint,13,opt,name=log_bytes_used,json=logBytesUsed,proto3" json:"log_bytes_used,omitempty"`
+	LogBytesReserved int64  `protobuf:"varint,14,opt,name=log_bytes_reserved,json=logBytesReserved,proto3" json:"log_bytes_reserved,omitempty"`
+	LogRecordCount   int64  `protobuf:"varint,15,opt,name=log_record_count,json=logRecordCount,proto3" json:"log_record_count,omitempty"`
+	// the transaction keeps row versions alive in the version store
+	HoldsVersionStore bool `protobuf:"varint,16,opt,name=holds_version_store,json=holdsVersionStore,proto3" json:"holds_version_store,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OpenTransaction) Reset() {
+	*x = OpenTransaction{}
+	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenTransaction) ProtoMessage() {}
+
+func (x *OpenTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenTransaction.ProtoReflect.Descriptor instead.
+func (*OpenTransaction) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenTransaction) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *OpenTransaction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetProgramName() string {
+	if x != nil {
+		return x.ProgramName
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetLoginName() string {
+	if x != nil {
+		return x.LoginName
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetSessionStatus() string {
+	if x != nil {
+		return x.SessionStatus
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetBeginTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.BeginTime
+	}
+	return nil
+}
+
+func (x *OpenTransaction) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *OpenTransaction) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetTransactionState() string {
+	if x != nil {
+		return x.TransactionState
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *OpenTransaction) GetLogBytesUsed() int64 {
+	if x != nil {
+		return x.LogBytesUsed
+	}
+	return 0
+}
+
+func (x *OpenTransaction) GetLogBytesReserved() int64 {
+	if x != nil {
+		return x.LogBytesReserved
+	}
+	return 0
+}
+
+func (x *OpenTransaction) GetLogRecordCount() int64 {
+	if x != nil {
+		return x.LogRecordCount
+	}
+	return 0
+}
+
+func (x *OpenTransaction) GetHoldsVersionStore() bool {
+	if x != nil {
+		return x.HoldsVersionStore
+	}
+	return false
+}
+
 type ServerMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -100,7 +284,7 @@ type ServerMetadata struct {
 
 func (x *ServerMetadata) Reset() {
 	*x = ServerMetadata{}
-	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -112,7 +296,7 @@ func (x *ServerMetadata) String() string {
 func (*ServerMetadata) ProtoMessage() {}
 
 func (x *ServerMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[1]
+	mi := &file_database_monitoring_v1_snapshot_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,7 +309,7 @@ func (x *ServerMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMetadata.ProtoReflect.Descriptor instead.
 func (*ServerMetadata) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_snapshot_proto_rawDescGZIP(), []int{1}
+	return file_database_monitoring_v1_snapshot_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ServerMetadata) GetHost() string {
@@ -146,13 +330,37 @@ var File_database_monitoring_v1_snapshot_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_snapshot_proto_rawDesc = "" +
 	"\n" +
-	"%database_monitoring/v1/snapshot.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#database_monitoring/v1/sample.proto\"\xd5\x01\n" +
+	"%database_monitoring/v1/snapshot.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#database_monitoring/v1/sample.proto\"\xcc\x02\n" +
 	"\n" +
 	"DBSnapshot\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12>\n" +
 	"\x06server\x18\x03 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12=\n" +
-	"\asamples\x18\x05 \x03(\v2#.database_monitoring.v1.QuerySampleR\asamples\"8\n" +
+	"\asamples\x18\x05 \x03(\v2#.database_monitoring.v1.QuerySampleR\asamples\x12K\n" +
+	"\ftransactions\x18\x06 \x03(\v2'.database_monitoring.v1.OpenTransactionR\ftransactions\x12(\n" +
+	"\x10version_store_kb\x18\a \x01(\x03R\x0eversionStoreKb\"\xf8\x04\n" +
+	"\x0fOpenTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\thost_name\x18\x04 \x01(\tR\bhostName\x12!\n" +
+	"\fprogram_name\x18\x05 \x01(\tR\vprogramName\x12\x1d\n" +
+	"\n" +
+	"login_name\x18\x06 \x01(\tR\tloginName\x12%\n" +
+	"\x0esession_status\x18\a \x01(\tR\rsessionStatus\x129\n" +
+	"\n" +
+	"begin_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tbeginTime\x12\x1f\n" +
+	"\vduration_ms\x18\t \x01(\x03R\n" +
+	"durationMs\x12)\n" +
+	"\x10transaction_type\x18\n" +
+	" \x01(\tR\x0ftransactionType\x12+\n" +
+	"\x11transaction_state\x18\v \x01(\tR\x10transactionState\x12#\n" +
+	"\rdatabase_name\x18\f \x01(\tR\fdatabaseName\x12$\n" +
+	"\x0elog_bytes_used\x18\r \x01(\x03R\flogBytesUsed\x12,\n" +
+	"\x12log_bytes_reserved\x18\x0e \x01(\x03R\x10logBytesReserved\x12(\n" +
+	"\x10log_record_count\x18\x0f \x01(\x03R\x0elogRecordCount\x12.\n" +
+	"\x13holds_version_store\x18\x10 \x01(\bR\x11holdsVersionStore\"8\n" +
 	"\x0eServerMetadata\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04typeBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
@@ -169,22 +377,25 @@ func file_database_monitoring_v1_snapshot_proto_rawDescGZIP() []byte {
 	return file_database_monitoring_v1_snapshot_proto_rawDescData
 }
 
-var file_database_monitoring_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_database_monitoring_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_database_monitoring_v1_snapshot_proto_goTypes = []any{
 	(*DBSnapshot)(nil),          // 0: database_monitoring.v1.DBSnapshot
-	(*ServerMetadata)(nil),      // 1: database_monitoring.v1.ServerMetadata
-	(*timestamp.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*QuerySample)(nil),         // 3: database_monitoring.v1.QuerySample
+	(*OpenTransaction)(nil),     // 1: database_monitoring.v1.OpenTransaction
+	(*ServerMetadata)(nil),      // 2: database_monitoring.v1.ServerMetadata
+	(*timestamp.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*QuerySample)(nil),         // 4: database_monitoring.v1.QuerySample
 }
 var file_database_monitoring_v1_snapshot_proto_depIdxs = []int32{
-	2, // 0: database_monitoring.v1.DBSnapshot.timestamp:type_name -> google.protobuf.Timestamp
-	1, // 1: database_monitoring.v1.DBSnapshot.server:type_name -> database_monitoring.v1.ServerMetadata
-	3, // 2: database_monitoring.v1.DBSnapshot.samples:type_name -> database_monitoring.v1.QuerySample
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 0: database_monitoring.v1.DBSnapshot.timestamp:type_name -> google.protobuf.Timestamp
+	2, // 1: database_monitoring.v1.DBSnapshot.server:type_name -> database_monitoring.v1.ServerMetadata
+	4, // 2: database_monitoring.v1.DBSnapshot.samples:type_name -> database_monitoring.v1.QuerySample
+	1, // 3: database_monitoring.v1.DBSnapshot.transactions:type_name -> database_monitoring.v1.OpenTransaction
+	3, // 4: database_monitoring.v1.OpenTransaction.begin_time:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_snapshot_proto_init() }
@@ -199,7 +410,7 @@ func file_database_monitoring_v1_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_snapshot_proto_rawDesc), len(file_database_monitoring_v1_snapshot_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
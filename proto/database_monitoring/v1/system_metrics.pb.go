@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.12.4
+// source: database_monitoring/v1/system_metrics.proto
+
+package dbmv1
+
+import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WaitStatSeries holds the wait stats deltas of one wait type, one point per metrics interval
+type WaitStatSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WaitType      string                 `protobuf:"bytes,1,opt,name=wait_type,json=waitType,proto3" json:"wait_type,omitempty"`
+	Points        []*WaitStatPoint       `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitStatSeries) Reset() {
+	*x = WaitStatSeries{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitStatSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitStatSeries) ProtoMessage() {}
+
+func (x *WaitStatSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitStatSeries.ProtoReflect.Descriptor instead.
+func (*WaitStatSeries) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WaitStatSeries) GetWaitType() string {
+	if x != nil {
+		return x.WaitType
+	}
+	return ""
+}
+
+func (x *WaitStatSeries) GetPoints() []*WaitStatPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type WaitStatPoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp        *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	WaitingTasks     int64                  `protobuf:"varint,2,opt,name=waiting_tasks,json=waitingTasks,proto3" json:"waiting_tasks,omitempty"`
+	WaitTimeMs       int64                  `protobuf:"varint,3,opt,name=wait_time_ms,json=waitTimeMs,proto3" json:"wait_time_ms,omitempty"`
+	SignalWaitTimeMs int64                  `protobuf:"varint,4,opt,name=signal_wait_time_ms,json=signalWaitTimeMs,proto3" json:"signal_wait_time_ms,omitempty"`
+	MaxWaitTimeMs    int64                  `protobuf:"varint,5,opt,name=max_wait_time_ms,json=maxWaitTimeMs,proto3" json:"max_wait_time_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WaitStatPoint) Reset() {
+	*x = WaitStatPoint{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitStatPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitStatPoint) ProtoMessage() {}
+
+func (x *WaitStatPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitStatPoint.ProtoReflect.Descriptor instead.
+func (*WaitStatPoint) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WaitStatPoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WaitStatPoint) GetWaitingTasks() int64 {
+	if x != nil {
+		return x.WaitingTasks
+	}
+	return 0
+}
+
+func (x *WaitStatPoint) GetWaitTimeMs() int64 {
+	if x != nil {
+		return x.WaitTimeMs
+	}
+	return 0
+}
+
+func (x *WaitStatPoint) GetSignalWaitTimeMs() int64 {
+	if x != nil {
+		return x.SignalWaitTimeMs
+	}
+	return 0
+}
+
+func (x *WaitStatPoint) GetMaxWaitTimeMs() int64 {
+	if x != nil {
+		return x.MaxWaitTimeMs
+	}
+	return 0
+}
+
+// PerformanceCounterSeries holds the readings of one performance counter, one point per metrics interval
+type PerformanceCounterSeries struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CounterName   string                     `protobuf:"bytes,1,opt,name=counter_name,json=counterName,proto3" json:"counter_name,omitempty"`
+	Points        []*PerformanceCounterPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformanceCounterSeries) Reset() {
+	*x = PerformanceCounterSeries{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceCounterSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceCounterSeries) ProtoMessage() {}
+
+func (x *PerformanceCounterSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceCounterSeries.ProtoReflect.Descriptor instead.
+func (*PerformanceCounterSeries) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PerformanceCounterSeries) GetCounterName() string {
+	if x != nil {
+		return x.CounterName
+	}
+	return ""
+}
+
+func (x *PerformanceCounterSeries) GetPoints() []*PerformanceCounterPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PerformanceCounterPoint struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// value is the reading of point-in-time counters and the increase in the interval of the per second ones
+	Value         int64   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Rate          float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerformanceCounterPoint) Reset() {
+	*x = PerformanceCounterPoint{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerformanceCounterPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerformanceCounterPoint) ProtoMessage() {}
+
+func (x *PerformanceCounterPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerformanceCounterPoint.ProtoReflect.Descriptor instead.
+func (*PerformanceCounterPoint) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PerformanceCounterPoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PerformanceCounterPoint) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PerformanceCounterPoint) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// FileIOSeries holds the I/O deltas of one database file, one point per metrics interval
+type FileIOSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DatabaseName  string                 `protobuf:"bytes,1,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	FileId        int64                  `protobuf:"varint,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	LogicalName   string                 `protobuf:"bytes,3,opt,name=logical_name,json=logicalName,proto3" json:"logical_name,omitempty"`
+	PhysicalName  string                 `protobuf:"bytes,4,opt,name=physical_name,json=physicalName,proto3" json:"physical_name,omitempty"`
+	FileType      string                 `protobuf:"bytes,5,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Points        []*FileIOPoint         `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileIOSeries) Reset() {
+	*x = FileIOSeries{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileIOSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIOSeries) ProtoMessage() {}
+
+func (x *FileIOSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIOSeries.ProtoReflect.Descriptor instead.
+func (*FileIOSeries) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FileIOSeries) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *FileIOSeries) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *FileIOSeries) GetLogicalName() string {
+	if x != nil {
+		return x.LogicalName
+	}
+	return ""
+}
+
+func (x *FileIOSeries) GetPhysicalName() string {
+	if x != nil {
+		return x.PhysicalName
+	}
+	return ""
+}
+
+func (x *FileIOSeries) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *FileIOSeries) GetPoints() []*FileIOPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type FileIOPoint struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp    *timestamp.Timestamp   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	IntervalMs   int64                  `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	Reads        int64                  `protobuf:"varint,3,opt,name=reads,proto3" json:"reads,omitempty"`
+	BytesRead    int64                  `protobuf:"varint,4,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	ReadStallMs  int64                  `protobuf:"varint,5,opt,name=read_stall_ms,json=readStallMs,proto3" json:"read_stall_ms,omitempty"`
+	Writes       int64                  `protobuf:"varint,6,opt,name=writes,proto3" json:"writes,omitempty"`
+	BytesWritten int64                  `protobuf:"varint,7,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	WriteStallMs int64                  `protobuf:"varint,8,opt,name=write_stall_ms,json=writeStallMs,proto3" json:"write_stall_ms,omitempty"`
+	// the latencies are the average per read and write in the interval
+	ReadLatencyMs  float64 `protobuf:"fixed64,9,opt,name=read_latency_ms,json=readLatencyMs,proto3" json:"read_latency_ms,omitempty"`
+	WriteLatencyMs float64 `protobuf:"fixed64,10,opt,name=write_latency_ms,json=writeLatencyMs,proto3" json:"write_latency_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FileIOPoint) Reset() {
+	*x = FileIOPoint{}
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileIOPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIOPoint) ProtoMessage() {}
+
+func (x *FileIOPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_system_metrics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIOPoint.ProtoReflect.Descriptor instead.
+func (*FileIOPoint) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_system_metrics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FileIOPoint) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *FileIOPoint) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetReads() int64 {
+	if x != nil {
+		return x.Reads
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetBytesRead() int64 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetReadStallMs() int64 {
+	if x != nil {
+		return x.ReadStallMs
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetWriteStallMs() int64 {
+	if x != nil {
+		return x.WriteStallMs
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetReadLatencyMs() float64 {
+	if x != nil {
+		return x.ReadLatencyMs
+	}
+	return 0
+}
+
+func (x *FileIOPoint) GetWriteLatencyMs() float64 {
+	if x != nil {
+		return x.WriteLatencyMs
+	}
+	return 0
+}
+
+var File_database_monitoring_v1_system_metrics_proto protoreflect.FileDescriptor
+
+const file_database_monitoring_v1_system_metrics_proto_rawDesc = "" +
+	"\n" +
+	"+database_monitoring/v1/system_metrics.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
+	"\x0eWaitStatSeries\x12\x1b\n" +
+	"\twait_type\x18\x01 \x01(\tR\bwaitType\x12=\n" +
+	"\x06points\x18\x02 \x03(\v2%.database_monitoring.v1.WaitStatPointR\x06points\"\xe8\x01\n" +
+	"\rWaitStatPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12#\n" +
+	"\rwaiting_tasks\x18\x02 \x01(\x03R\fwaitingTasks\x12 \n" +
+	"\fwait_time_ms\x18\x03 \x01(\x03R\n" +
+	"waitTimeMs\x12-\n" +
+	"\x13signal_wait_time_ms\x18\x04 \x01(\x03R\x10signalWaitTimeMs\x12'\n" +
+	"\x10max_wait_time_ms\x18\x05 \x01(\x03R\rmaxWaitTimeMs\"\x86\x01\n" +
+	"\x18PerformanceCounterSeries\x12!\n" +
+	"\fcounter_name\x18\x01 \x01(\tR\vcounterName\x12G\n" +
+	"\x06points\x18\x02 \x03(\v2/.database_monitoring.v1.PerformanceCounterPointR\x06points\"}\n" +
+	"\x17PerformanceCounterPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\"\xee\x01\n" +
+	"\fFileIOSeries\x12#\n" +
+	"\rdatabase_name\x18\x01 \x01(\tR\fdatabaseName\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\x03R\x06fileId\x12!\n" +
+	"\flogical_name\x18\x03 \x01(\tR\vlogicalName\x12#\n" +
+	"\rphysical_name\x18\x04 \x01(\tR\fphysicalName\x12\x1b\n" +
+	"\tfile_type\x18\x05 \x01(\tR\bfileType\x12;\n" +
+	"\x06points\x18\x06 \x03(\v2#.database_monitoring.v1.FileIOPointR\x06points\"\xf6\x02\n" +
+	"\vFileIOPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\x03R\n" +
+	"intervalMs\x12\x14\n" +
+	"\x05reads\x18\x03 \x01(\x03R\x05reads\x12\x1d\n" +
+	"\n" +
+	"bytes_read\x18\x04 \x01(\x03R\tbytesRead\x12\"\n" +
+	"\rread_stall_ms\x18\x05 \x01(\x03R\vreadStallMs\x12\x16\n" +
+	"\x06writes\x18\x06 \x01(\x03R\x06writes\x12#\n" +
+	"\rbytes_written\x18\a \x01(\x03R\fbytesWritten\x12$\n" +
+	"\x0ewrite_stall_ms\x18\b \x01(\x03R\fwriteStallMs\x12&\n" +
+	"\x0fread_latency_ms\x18\t \x01(\x01R\rreadLatencyMs\x12(\n" +
+	"\x10write_latency_ms\x18\n" +
+	" \x01(\x01R\x0ewriteLatencyMsBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+
+var (
+	file_database_monitoring_v1_system_metrics_proto_rawDescOnce sync.Once
+	file_database_monitoring_v1_system_metrics_proto_rawDescData []byte
+)
+
+func file_database_monitoring_v1_system_metrics_proto_rawDescGZIP() []byte {
+	file_database_monitoring_v1_system_metrics_proto_rawDescOnce.Do(func() {
+		file_database_monitoring_v1_system_metrics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_system_metrics_proto_rawDesc), len(file_database_monitoring_v1_system_metrics_proto_rawDesc)))
+	})
+	return file_database_monitoring_v1_system_metrics_proto_rawDescData
+}
+
+var file_database_monitoring_v1_system_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_database_monitoring_v1_system_metrics_proto_goTypes = []any{
+	(*WaitStatSeries)(nil),           // 0: database_monitoring.v1.WaitStatSeries
+	(*WaitStatPoint)(nil),            // 1: database_monitoring.v1.WaitStatPoint
+	(*PerformanceCounterSeries)(nil), // 2: database_monitoring.v1.PerformanceCounterSeries
+	(*PerformanceCounterPoint)(nil),  // 3: database_monitoring.v1.PerformanceCounterPoint
+	(*FileIOSeries)(nil),             // 4: database_monitoring.v1.FileIOSeries
+	(*FileIOPoint)(nil),              // 5: database_monitoring.v1.FileIOPoint
+	(*timestamp.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+}
+var file_database_monitoring_v1_system_metrics_proto_depIdxs = []int32{
+	1, // 0: database_monitoring.v1.WaitStatSeries.points:type_name -> database_monitoring.v1.WaitStatPoint
+	6, // 1: database_monitoring.v1.WaitStatPoint.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 2: database_monitoring.v1.PerformanceCounterSeries.points:type_name -> database_monitoring.v1.PerformanceCounterPoint
+	6, // 3: database_monitoring.v1.PerformanceCounterPoint.timestamp:type_name -> google.protobuf.Timestamp
+	5, // 4: database_monitoring.v1.FileIOSeries.points:type_name -> database_monitoring.v1.FileIOPoint
+	6, // 5: database_monitoring.v1.FileIOPoint.timestamp:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_database_monitoring_v1_system_metrics_proto_init() }
+func file_database_monitoring_v1_system_metrics_proto_init() {
+	if File_database_monitoring_v1_system_metrics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_system_metrics_proto_rawDesc), len(file_database_monitoring_v1_system_metrics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_database_monitoring_v1_system_metrics_proto_goTypes,
+		DependencyIndexes: file_database_monitoring_v1_system_metrics_proto_depIdxs,
+		MessageInfos:      file_database_monitoring_v1_system_metrics_proto_msgTypes,
+	}.Build()
+	File_database_monitoring_v1_system_metrics_proto = out.File
+	file_database_monitoring_v1_system_metrics_proto_goTypes = nil
+	file_database_monitoring_v1_system_metrics_proto_depIdxs = nil
+}
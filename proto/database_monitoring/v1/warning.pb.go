@@ -7,6 +7,7 @@
 package dbmv1
 
 import (
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -30,6 +31,7 @@ type Warning struct {
 	//	*Warning_Snapshot
 	//	*Warning_Plan
 	//	*Warning_Query
+	//	*Warning_AvailabilityGroup
 	Type          isWarning_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -113,6 +115,15 @@ func (x *Warning) GetQuery() *QueryStatWarning {
 	return nil
 }
 
+func (x *Warning) GetAvailabilityGroup() *AvailabilityGroupWarning {
+	if x != nil {
+		if x, ok := x.Type.(*Warning_AvailabilityGroup); ok {
+			return x.AvailabilityGroup
+		}
+	}
+	return nil
+}
+
 type isWarning_Type interface {
 	isWarning_Type()
 }
@@ -129,18 +140,25 @@ type Warning_Query struct {
 	Query *QueryStatWarning `protobuf:"bytes,5,opt,name=query,proto3,oneof"`
 }
 
+type Warning_AvailabilityGroup struct {
+	AvailabilityGroup *AvailabilityGroupWarning `protobuf:"bytes,6,opt,name=availability_group,json=availabilityGroup,proto3,oneof"`
+}
+
 func (*Warning_Snapshot) isWarning_Type() {}
 
 func (*Warning_Plan) isWarning_Type() {}
 
 func (*Warning_Query) isWarning_Type() {}
 
+func (*Warning_AvailabilityGroup) isWarning_Type() {}
+
 type SnapshotWarning struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Warning:
 	//
 	//	*SnapshotWarning_FrequentLock
 	//	*SnapshotWarning_LockingSleepingSession
+	//	*SnapshotWarning_LongOpenTransaction
 	Warning       isSnapshotWarning_Warning `protobuf_oneof:"warning"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -201,6 +219,15 @@ func (x *SnapshotWarning) GetLockingSleepingSession() *LockingSleepingSession {
 	return nil
 }
 
+func (x *SnapshotWarning) GetLongOpenTransaction() *LongOpenTransaction {
+	if x != nil {
+		if x, ok := x.Warning.(*SnapshotWarning_LongOpenTransaction); ok {
+			return x.LongOpenTransaction
+		}
+	}
+	return nil
+}
+
 type isSnapshotWarning_Warning interface {
 	isSnapshotWarning_Warning()
 }
@@ -213,10 +240,16 @@ type SnapshotWarning_LockingSleepingSession struct {
 	LockingSleepingSession *LockingSleepingSession `protobuf:"bytes,2,opt,name=locking_sleeping_session,json=lockingSleepingSession,proto3,oneof"`
 }
 
+type SnapshotWarning_LongOpenTransaction struct {
+	LongOpenTransaction *LongOpenTransaction `protobuf:"bytes,3,opt,name=long_open_transaction,json=longOpenTransaction,proto3,oneof"`
+}
+
 func (*SnapshotWarning_FrequentLock) isSnapshotWarning_Warning() {}
 
 func (*SnapshotWarning_LockingSleepingSession) isSnapshotWarning_Warning() {}
 
+func (*SnapshotWarning_LongOpenTransaction) isSnapshotWarning_Warning() {}
+
 type ExecutionPlanWarning struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Warning:
@@ -397,6 +430,72 @@ func (*QueryStatWarning_MemorySpill) isQueryStatWarning_Warning() {}
 
 func (*QueryStatWarning_LargeRead) isQueryStatWarning_Warning() {}
 
+type AvailabilityGroupWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Warning:
+	//
+	//	*AvailabilityGroupWarning_ReplicaLag
+	Warning       isAvailabilityGroupWarning_Warning `protobuf_oneof:"warning"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityGroupWarning) Reset() {
+	*x = AvailabilityGroupWarning{}
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityGroupWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityGroupWarning) ProtoMessage() {}
+
+func (x *AvailabilityGroupWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityGroupWarning.ProtoReflect.Descriptor instead.
+func (*AvailabilityGroupWarning) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AvailabilityGroupWarning) GetWarning() isAvailabilityGroupWarning_Warning {
+	if x != nil {
+		return x.Warning
+	}
+	return nil
+}
+
+func (x *AvailabilityGroupWarning) GetReplicaLag() *ReplicaLag {
+	if x != nil {
+		if x, ok := x.Warning.(*AvailabilityGroupWarning_ReplicaLag); ok {
+			return x.ReplicaLag
+		}
+	}
+	return nil
+}
+
+type isAvailabilityGroupWarning_Warning interface {
+	isAvailabilityGroupWarning_Warning()
+}
+
+type AvailabilityGroupWarning_ReplicaLag struct {
+	ReplicaLag *ReplicaLag `protobuf:"bytes,1,opt,name=replica_lag,json=replicaLag,proto3,oneof"`
+}
+
+func (*AvailabilityGroupWarning_ReplicaLag) isAvailabilityGroupWarning_Warning() {}
+
 type FrequentLock struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	ResourceType        string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
@@ -411,7 +510,7 @@ type FrequentLock struct {
 
 func (x *FrequentLock) Reset() {
 	*x = FrequentLock{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -423,7 +522,7 @@ func (x *FrequentLock) String() string {
 func (*FrequentLock) ProtoMessage() {}
 
 func (x *FrequentLock) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[4]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +535,7 @@ func (x *FrequentLock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrequentLock.ProtoReflect.Descriptor instead.
 func (*FrequentLock) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{4}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FrequentLock) GetResourceType() string {
@@ -492,7 +591,7 @@ type LockingSleepingSession struct {
 
 func (x *LockingSleepingSession) Reset() {
 	*x = LockingSleepingSession{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +603,7 @@ func (x *LockingSleepingSession) String() string {
 func (*LockingSleepingSession) ProtoMessage() {}
 
 func (x *LockingSleepingSession) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[5]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +616,7 @@ func (x *LockingSleepingSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LockingSleepingSession.ProtoReflect.Descriptor instead.
 func (*LockingSleepingSession) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{5}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LockingSleepingSession) GetBlockingQueryHash() string {
@@ -541,6 +640,122 @@ func (x *LockingSleepingSession) GetMaxBlockingDurationMs() int64 {
 	return 0
 }
 
+type LongOpenTransaction struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	HostName          string                 `protobuf:"bytes,2,opt,name=host_name,json=hostName,proto3" json:"host_name,omitempty"`
+	ProgramName       string                 `protobuf:"bytes,3,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
+	LoginName         string                 `protobuf:"bytes,4,opt,name=login_name,json=loginName,proto3" json:"login_name,omitempty"`
+	DatabaseName      string                 `protobuf:"bytes,5,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	TransactionName   string                 `protobuf:"bytes,6,opt,name=transaction_name,json=transactionName,proto3" json:"transaction_name,omitempty"`
+	BeginTime         *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=begin_time,json=beginTime,proto3" json:"begin_time,omitempty"`
+	DurationMs        int64                  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	LogBytesUsed      int64                  `protobuf:"varint,9,opt,name=log_bytes_used,json=logBytesUsed,proto3" json:"log_bytes_used,omitempty"`
+	HoldsVersionStore bool                   `protobuf:"varint,10,opt,name=holds_version_store,json=holdsVersionStore,proto3" json:"holds_version_store,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LongOpenTransaction) Reset() {
+	*x = LongOpenTransaction{}
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LongOpenTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LongOpenTransaction) ProtoMessage() {}
+
+func (x *LongOpenTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LongOpenTransaction.ProtoReflect.Descriptor instead.
+func (*LongOpenTransaction) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LongOpenTransaction) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetHostName() string {
+	if x != nil {
+		return x.HostName
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetProgramName() string {
+	if x != nil {
+		return x.ProgramName
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetLoginName() string {
+	if x != nil {
+		return x.LoginName
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetTransactionName() string {
+	if x != nil {
+		return x.TransactionName
+	}
+	return ""
+}
+
+func (x *LongOpenTransaction) GetBeginTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.BeginTime
+	}
+	return nil
+}
+
+func (x *LongOpenTransaction) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *LongOpenTransaction) GetLogBytesUsed() int64 {
+	if x != nil {
+		return x.LogBytesUsed
+	}
+	return 0
+}
+
+func (x *LongOpenTransaction) GetHoldsVersionStore() bool {
+	if x != nil {
+		return x.HoldsVersionStore
+	}
+	return false
+}
+
 type ImplicitConversion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QueryHash     string                 `protobuf:"bytes,1,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
@@ -556,7 +771,7 @@ type ImplicitConversion struct {
 
 func (x *ImplicitConversion) Reset() {
 	*x = ImplicitConversion{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +783,7 @@ func (x *ImplicitConversion) String() string {
 func (*ImplicitConversion) ProtoMessage() {}
 
 func (x *ImplicitConversion) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[6]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +796,7 @@ func (x *ImplicitConversion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplicitConversion.ProtoReflect.Descriptor instead.
 func (*ImplicitConversion) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{6}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ImplicitConversion) GetQueryHash() string {
@@ -644,7 +859,7 @@ type RecommendedIndex struct {
 
 func (x *RecommendedIndex) Reset() {
 	*x = RecommendedIndex{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -656,7 +871,7 @@ func (x *RecommendedIndex) String() string {
 func (*RecommendedIndex) ProtoMessage() {}
 
 func (x *RecommendedIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[7]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +884,7 @@ func (x *RecommendedIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecommendedIndex.ProtoReflect.Descriptor instead.
 func (*RecommendedIndex) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{7}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RecommendedIndex) GetQueryHash() string {
@@ -703,7 +918,7 @@ type LargeTableScan struct {
 
 func (x *LargeTableScan) Reset() {
 	*x = LargeTableScan{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[8]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +930,7 @@ func (x *LargeTableScan) String() string {
 func (*LargeTableScan) ProtoMessage() {}
 
 func (x *LargeTableScan) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[8]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +943,7 @@ func (x *LargeTableScan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LargeTableScan.ProtoReflect.Descriptor instead.
 func (*LargeTableScan) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{8}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LargeTableScan) GetQueryHash() string {
@@ -754,7 +969,7 @@ type MemorySpill struct {
 
 func (x *MemorySpill) Reset() {
 	*x = MemorySpill{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[9]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +981,7 @@ func (x *MemorySpill) String() string {
 func (*MemorySpill) ProtoMessage() {}
 
 func (x *MemorySpill) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[9]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +994,7 @@ func (x *MemorySpill) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemorySpill.ProtoReflect.Descriptor instead.
 func (*MemorySpill) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{9}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MemorySpill) GetQueryHash() string {
@@ -799,7 +1014,7 @@ type LargeRead struct {
 
 func (x *LargeRead) Reset() {
 	*x = LargeRead{}
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[10]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -811,7 +1026,7 @@ func (x *LargeRead) String() string {
 func (*LargeRead) ProtoMessage() {}
 
 func (x *LargeRead) ProtoReflect() protoreflect.Message {
-	mi := &file_database_monitoring_v1_warning_proto_msgTypes[10]
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1039,7 @@ func (x *LargeRead) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LargeRead.ProtoReflect.Descriptor instead.
 func (*LargeRead) Descriptor() ([]byte, []int) {
-	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{10}
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LargeRead) GetQueryText() string {
@@ -841,21 +1056,123 @@ func (x *LargeRead) GetQueryHash() string {
 	return ""
 }
 
+type ReplicaLag struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	AgName                 string                 `protobuf:"bytes,1,opt,name=ag_name,json=agName,proto3" json:"ag_name,omitempty"`
+	ReplicaServerName      string                 `protobuf:"bytes,2,opt,name=replica_server_name,json=replicaServerName,proto3" json:"replica_server_name,omitempty"`
+	DatabaseName           string                 `protobuf:"bytes,3,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	SynchronizationState   string                 `protobuf:"bytes,4,opt,name=synchronization_state,json=synchronizationState,proto3" json:"synchronization_state,omitempty"`
+	LogSendQueueKb         int64                  `protobuf:"varint,5,opt,name=log_send_queue_kb,json=logSendQueueKb,proto3" json:"log_send_queue_kb,omitempty"`
+	RedoQueueKb            int64                  `protobuf:"varint,6,opt,name=redo_queue_kb,json=redoQueueKb,proto3" json:"redo_queue_kb,omitempty"`
+	EstimatedDataLossS     int64                  `protobuf:"varint,7,opt,name=estimated_data_loss_s,json=estimatedDataLossS,proto3" json:"estimated_data_loss_s,omitempty"`
+	EstimatedRecoveryTimeS int64                  `protobuf:"varint,8,opt,name=estimated_recovery_time_s,json=estimatedRecoveryTimeS,proto3" json:"estimated_recovery_time_s,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReplicaLag) Reset() {
+	*x = ReplicaLag{}
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaLag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaLag) ProtoMessage() {}
+
+func (x *ReplicaLag) ProtoReflect() protoreflect.Message {
+	mi := &file_database_monitoring_v1_warning_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaLag.ProtoReflect.Descriptor instead.
+func (*ReplicaLag) Descriptor() ([]byte, []int) {
+	return file_database_monitoring_v1_warning_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReplicaLag) GetAgName() string {
+	if x != nil {
+		return x.AgName
+	}
+	return ""
+}
+
+func (x *ReplicaLag) GetReplicaServerName() string {
+	if x != nil {
+		return x.ReplicaServerName
+	}
+	return ""
+}
+
+func (x *ReplicaLag) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *ReplicaLag) GetSynchronizationState() string {
+	if x != nil {
+		return x.SynchronizationState
+	}
+	return ""
+}
+
+func (x *ReplicaLag) GetLogSendQueueKb() int64 {
+	if x != nil {
+		return x.LogSendQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaLag) GetRedoQueueKb() int64 {
+	if x != nil {
+		return x.RedoQueueKb
+	}
+	return 0
+}
+
+func (x *ReplicaLag) GetEstimatedDataLossS() int64 {
+	if x != nil {
+		return x.EstimatedDataLossS
+	}
+	return 0
+}
+
+func (x *ReplicaLag) GetEstimatedRecoveryTimeS() int64 {
+	if x != nil {
+		return x.EstimatedRecoveryTimeS
+	}
+	return 0
+}
+
 var File_database_monitoring_v1_warning_proto protoreflect.FileDescriptor
 
 const file_database_monitoring_v1_warning_proto_rawDesc = "" +
 	"\n" +
-	"$database_monitoring/v1/warning.proto\x12\x16database_monitoring.v1\x1a%database_monitoring/v1/snapshot.proto\"\xae\x02\n" +
+	"$database_monitoring/v1/warning.proto\x12\x16database_monitoring.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%database_monitoring/v1/snapshot.proto\"\x91\x03\n" +
 	"\aWarning\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12>\n" +
 	"\x06server\x18\x02 \x01(\v2&.database_monitoring.v1.ServerMetadataR\x06server\x12E\n" +
 	"\bsnapshot\x18\x03 \x01(\v2'.database_monitoring.v1.SnapshotWarningH\x00R\bsnapshot\x12B\n" +
 	"\x04plan\x18\x04 \x01(\v2,.database_monitoring.v1.ExecutionPlanWarningH\x00R\x04plan\x12@\n" +
-	"\x05query\x18\x05 \x01(\v2(.database_monitoring.v1.QueryStatWarningH\x00R\x05queryB\x06\n" +
-	"\x04type\"\xd5\x01\n" +
+	"\x05query\x18\x05 \x01(\v2(.database_monitoring.v1.QueryStatWarningH\x00R\x05query\x12a\n" +
+	"\x12availability_group\x18\x06 \x01(\v20.database_monitoring.v1.AvailabilityGroupWarningH\x00R\x11availabilityGroupB\x06\n" +
+	"\x04type\"\xb8\x02\n" +
 	"\x0fSnapshotWarning\x12K\n" +
 	"\rfrequent_lock\x18\x01 \x01(\v2$.database_monitoring.v1.FrequentLockH\x00R\ffrequentLock\x12j\n" +
-	"\x18locking_sleeping_session\x18\x02 \x01(\v2..database_monitoring.v1.LockingSleepingSessionH\x00R\x16lockingSleepingSessionB\t\n" +
+	"\x18locking_sleeping_session\x18\x02 \x01(\v2..database_monitoring.v1.LockingSleepingSessionH\x00R\x16lockingSleepingSession\x12a\n" +
+	"\x15long_open_transaction\x18\x03 \x01(\v2+.database_monitoring.v1.LongOpenTransactionH\x00R\x13longOpenTransactionB\t\n" +
 	"\awarning\"\xa5\x02\n" +
 	"\x14ExecutionPlanWarning\x12]\n" +
 	"\x13implicit_conversion\x18\x01 \x01(\v2*.database_monitoring.v1.ImplicitConversionH\x00R\x12implicitConversion\x12O\n" +
@@ -866,6 +1183,10 @@ const file_database_monitoring_v1_warning_proto_rawDesc = "" +
 	"\fmemory_spill\x18\x01 \x01(\v2#.database_monitoring.v1.MemorySpillH\x00R\vmemorySpill\x12B\n" +
 	"\n" +
 	"large_read\x18\x02 \x01(\v2!.database_monitoring.v1.LargeReadH\x00R\tlargeReadB\t\n" +
+	"\awarning\"l\n" +
+	"\x18AvailabilityGroupWarning\x12E\n" +
+	"\vreplica_lag\x18\x01 \x01(\v2\".database_monitoring.v1.ReplicaLagH\x00R\n" +
+	"replicaLagB\t\n" +
 	"\awarning\"\x8e\x02\n" +
 	"\fFrequentLock\x12#\n" +
 	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x121\n" +
@@ -878,7 +1199,23 @@ const file_database_monitoring_v1_warning_proto_rawDesc = "" +
 	"\x16LockingSleepingSession\x12.\n" +
 	"\x13blocking_query_hash\x18\x01 \x01(\tR\x11blockingQueryHash\x129\n" +
 	"\x19max_blocked_session_count\x18\x02 \x01(\x05R\x16maxBlockedSessionCount\x127\n" +
-	"\x18max_blocking_duration_ms\x18\x03 \x01(\x03R\x15maxBlockingDurationMs\"\xf8\x01\n" +
+	"\x18max_blocking_duration_ms\x18\x03 \x01(\x03R\x15maxBlockingDurationMs\"\x95\x03\n" +
+	"\x13LongOpenTransaction\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\thost_name\x18\x02 \x01(\tR\bhostName\x12!\n" +
+	"\fprogram_name\x18\x03 \x01(\tR\vprogramName\x12\x1d\n" +
+	"\n" +
+	"login_name\x18\x04 \x01(\tR\tloginName\x12#\n" +
+	"\rdatabase_name\x18\x05 \x01(\tR\fdatabaseName\x12)\n" +
+	"\x10transaction_name\x18\x06 \x01(\tR\x0ftransactionName\x129\n" +
+	"\n" +
+	"begin_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tbeginTime\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12$\n" +
+	"\x0elog_bytes_used\x18\t \x01(\x03R\flogBytesUsed\x12.\n" +
+	"\x13holds_version_store\x18\n" +
+	" \x01(\bR\x11holdsVersionStore\"\xf8\x01\n" +
 	"\x12ImplicitConversion\x12\x1d\n" +
 	"\n" +
 	"query_hash\x18\x01 \x01(\tR\tqueryHash\x12&\n" +
@@ -906,7 +1243,17 @@ const file_database_monitoring_v1_warning_proto_rawDesc = "" +
 	"\n" +
 	"query_text\x18\x01 \x01(\tR\tqueryText\x12\x1d\n" +
 	"\n" +
-	"query_hash\x18\x02 \x01(\tR\tqueryHashBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
+	"query_hash\x18\x02 \x01(\tR\tqueryHash\"\xec\x02\n" +
+	"\n" +
+	"ReplicaLag\x12\x17\n" +
+	"\aag_name\x18\x01 \x01(\tR\x06agName\x12.\n" +
+	"\x13replica_server_name\x18\x02 \x01(\tR\x11replicaServerName\x12#\n" +
+	"\rdatabase_name\x18\x03 \x01(\tR\fdatabaseName\x123\n" +
+	"\x15synchronization_state\x18\x04 \x01(\tR\x14synchronizationState\x12)\n" +
+	"\x11log_send_queue_kb\x18\x05 \x01(\x03R\x0elogSendQueueKb\x12\"\n" +
+	"\rredo_queue_kb\x18\x06 \x01(\x03R\vredoQueueKb\x121\n" +
+	"\x15estimated_data_loss_s\x18\a \x01(\x03R\x12estimatedDataLossS\x129\n" +
+	"\x19estimated_recovery_time_s\x18\b \x01(\x03R\x16estimatedRecoveryTimeSBUZSgithub.com/guilhermearpassos/database-monitoring/proto/database_monitoring/v1;dbmv1b\x06proto3"
 
 var (
 	file_database_monitoring_v1_warning_proto_rawDescOnce sync.Once
@@ -920,38 +1267,46 @@ func file_database_monitoring_v1_warning_proto_rawDescGZIP() []byte {
 	return file_database_monitoring_v1_warning_proto_rawDescData
 }
 
-var file_database_monitoring_v1_warning_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_database_monitoring_v1_warning_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_database_monitoring_v1_warning_proto_goTypes = []any{
-	(*Warning)(nil),                // 0: database_monitoring.v1.Warning
-	(*SnapshotWarning)(nil),        // 1: database_monitoring.v1.SnapshotWarning
-	(*ExecutionPlanWarning)(nil),   // 2: database_monitoring.v1.ExecutionPlanWarning
-	(*QueryStatWarning)(nil),       // 3: database_monitoring.v1.QueryStatWarning
-	(*FrequentLock)(nil),           // 4: database_monitoring.v1.FrequentLock
-	(*LockingSleepingSession)(nil), // 5: database_monitoring.v1.LockingSleepingSession
-	(*ImplicitConversion)(nil),     // 6: database_monitoring.v1.ImplicitConversion
-	(*RecommendedIndex)(nil),       // 7: database_monitoring.v1.RecommendedIndex
-	(*LargeTableScan)(nil),         // 8: database_monitoring.v1.LargeTableScan
-	(*MemorySpill)(nil),            // 9: database_monitoring.v1.MemorySpill
-	(*LargeRead)(nil),              // 10: database_monitoring.v1.LargeRead
-	(*ServerMetadata)(nil),         // 11: database_monitoring.v1.ServerMetadata
+	(*Warning)(nil),                  // 0: database_monitoring.v1.Warning
+	(*SnapshotWarning)(nil),          // 1: database_monitoring.v1.SnapshotWarning
+	(*ExecutionPlanWarning)(nil),     // 2: database_monitoring.v1.ExecutionPlanWarning
+	(*QueryStatWarning)(nil),         // 3: database_monitoring.v1.QueryStatWarning
+	(*AvailabilityGroupWarning)(nil), // 4: database_monitoring.v1.AvailabilityGroupWarning
+	(*FrequentLock)(nil),             // 5: database_monitoring.v1.FrequentLock
+	(*LockingSleepingSession)(nil),   // 6: database_monitoring.v1.LockingSleepingSession
+	(*LongOpenTransaction)(nil),      // 7: database_monitoring.v1.LongOpenTransaction
+	(*ImplicitConversion)(nil),       // 8: database_monitoring.v1.ImplicitConversion
+	(*RecommendedIndex)(nil),         // 9: database_monitoring.v1.RecommendedIndex
+	(*LargeTableScan)(nil),           // 10: database_monitoring.v1.LargeTableScan
+	(*MemorySpill)(nil),              // 11: database_monitoring.v1.MemorySpill
+	(*LargeRead)(nil),                // 12: database_monitoring.v1.LargeRead
+	(*ReplicaLag)(nil),               // 13: database_monitoring.v1.ReplicaLag
+	(*ServerMetadata)(nil),           // 14: database_monitoring.v1.ServerMetadata
+	(*timestamp.Timestamp)(nil),      // 15: google.protobuf.Timestamp
 }
 var file_database_monitoring_v1_warning_proto_depIdxs = []int32{
-	11, // 0: database_monitoring.v1.Warning.server:type_name -> database_monitoring.v1.ServerMetadata
+	14, // 0: database_monitoring.v1.Warning.server:type_name -> database_monitoring.v1.ServerMetadata
 	1,  // 1: database_monitoring.v1.Warning.snapshot:type_name -> database_monitoring.v1.SnapshotWarning
 	2,  // 2: database_monitoring.v1.Warning.plan:type_name -> database_monitoring.v1.ExecutionPlanWarning
 	3,  // 3: database_monitoring.v1.Warning.query:type_name -> database_monitoring.v1.QueryStatWarning
-	4,  // 4: database_monitoring.v1.SnapshotWarning.frequent_lock:type_name -> database_monitoring.v1.FrequentLock
-	5,  // 5: database_monitoring.v1.SnapshotWarning.locking_sleeping_session:type_name -> database_monitoring.v1.LockingSleepingSession
-	6,  // 6: database_monitoring.v1.ExecutionPlanWarning.implicit_conversion:type_name -> database_monitoring.v1.ImplicitConversion
-	7,  // 7: database_monitoring.v1.ExecutionPlanWarning.missing_index:type_name -> database_monitoring.v1.RecommendedIndex
-	8,  // 8: database_monitoring.v1.ExecutionPlanWarning.large_table_scan:type_name -> database_monitoring.v1.LargeTableScan
-	9,  // 9: database_monitoring.v1.QueryStatWarning.memory_spill:type_name -> database_monitoring.v1.MemorySpill
-	10, // 10: database_monitoring.v1.QueryStatWarning.large_read:type_name -> database_monitoring.v1.LargeRead
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 4: database_monitoring.v1.Warning.availability_group:type_name -> database_monitoring.v1.AvailabilityGroupWarning
+	5,  // 5: database_monitoring.v1.SnapshotWarning.frequent_lock:type_name -> database_monitoring.v1.FrequentLock
+	6,  // 6: database_monitoring.v1.SnapshotWarning.locking_sleeping_session:type_name -> database_monitoring.v1.LockingSleepingSession
+	7,  // 7: database_monitoring.v1.SnapshotWarning.long_open_transaction:type_name -> database_monitoring.v1.LongOpenTransaction
+	8,  // 8: database_monitoring.v1.ExecutionPlanWarning.implicit_conversion:type_name -> database_monitoring.v1.ImplicitConversion
+	9,  // 9: database_monitoring.v1.ExecutionPlanWarning.missing_index:type_name -> database_monitoring.v1.RecommendedIndex
+	10, // 10: database_monitoring.v1.ExecutionPlanWarning.large_table_scan:type_name -> database_monitoring.v1.LargeTableScan
+	11, // 11: database_monitoring.v1.QueryStatWarning.memory_spill:type_name -> database_monitoring.v1.MemorySpill
+	12, // 12: database_monitoring.v1.QueryStatWarning.large_read:type_name -> database_monitoring.v1.LargeRead
+	13, // 13: database_monitoring.v1.AvailabilityGroupWarning.replica_lag:type_name -> database_monitoring.v1.ReplicaLag
+	15, // 14: database_monitoring.v1.LongOpenTransaction.begin_time:type_name -> google.protobuf.Timestamp
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_database_monitoring_v1_warning_proto_init() }
@@ -964,10 +1319,12 @@ func file_database_monitoring_v1_warning_proto_init() {
 		(*Warning_Snapshot)(nil),
 		(*Warning_Plan)(nil),
 		(*Warning_Query)(nil),
+		(*Warning_AvailabilityGroup)(nil),
 	}
 	file_database_monitoring_v1_warning_proto_msgTypes[1].OneofWrappers = []any{
 		(*SnapshotWarning_FrequentLock)(nil),
 		(*SnapshotWarning_LockingSleepingSession)(nil),
+		(*SnapshotWarning_LongOpenTransaction)(nil),
 	}
 	file_database_monitoring_v1_warning_proto_msgTypes[2].OneofWrappers = []any{
 		(*ExecutionPlanWarning_ImplicitConversion)(nil),
@@ -978,13 +1335,16 @@ func file_database_monitoring_v1_warning_proto_init() {
 		(*QueryStatWarning_MemorySpill)(nil),
 		(*QueryStatWarning_LargeRead)(nil),
 	}
+	file_database_monitoring_v1_warning_proto_msgTypes[4].OneofWrappers = []any{
+		(*AvailabilityGroupWarning_ReplicaLag)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_monitoring_v1_warning_proto_rawDesc), len(file_database_monitoring_v1_warning_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,2 @@
+delete from target_type where id = 2;
+drop index if exists ix_target_type_dsc;
@@ -0,0 +1,2 @@
+create unique index if not exists ix_target_type_dsc on target_type (dsc_type);
+insert into target_type (id, dsc_type) values (2, 'postgres') on conflict do nothing;
@@ -0,0 +1 @@
+delete from target_type where id = 3;
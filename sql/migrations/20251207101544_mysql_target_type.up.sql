@@ -0,0 +1 @@
+insert into target_type (id, dsc_type) values (3, 'mysql') on conflict do nothing;
@@ -0,0 +1,2 @@
+drop index if exists query_samples_sql_handle_index;
+drop table if exists deadlocks;
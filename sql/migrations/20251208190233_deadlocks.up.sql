@@ -0,0 +1,11 @@
+create table if not exists deadlocks
+(
+    id         bigint generated always as identity primary key,
+    f_id       varchar(100) not null,
+    target_id  int          not null references target (id) on delete cascade,
+    event_time timestamp    not null,
+    data       bytea
+);
+create unique index if not exists ix_deadlocks_f_id on deadlocks (f_id);
+create index if not exists ix_deadlocks_target_time on deadlocks (target_id, event_time);
+create index if not exists query_samples_sql_handle_index on query_samples (sql_handle);
@@ -0,0 +1,2 @@
+drop table if exists agent_targets;
+drop table if exists agents;
@@ -0,0 +1,20 @@
+create table if not exists agents
+(
+    id            varchar(200) primary key,
+    version       varchar(50) not null,
+    registered_at timestamp   not null,
+    last_seen     timestamp   not null
+);
+create table if not exists agent_targets
+(
+    agent_id        varchar(200) not null references agents (id) on delete cascade,
+    target_id       int          not null references target (id) on delete cascade,
+    tags            text[]       not null default '{}',
+    registered_at   timestamp    not null,
+    last_seen       timestamp    not null,
+    last_snapshot   timestamp,
+    last_metrics    timestamp,
+    last_error      text         not null default '',
+    last_error_time timestamp,
+    primary key (agent_id, target_id)
+);
@@ -0,0 +1 @@
+drop table if exists target_collection_config;
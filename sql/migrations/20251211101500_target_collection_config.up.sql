@@ -0,0 +1,11 @@
+create table if not exists target_collection_config
+(
+    target_id            int       primary key references target (id) on delete cascade,
+    snapshot_interval_ms bigint    not null default 0,
+    metrics_interval_ms  bigint    not null default 0,
+    deadlock_interval_ms bigint    not null default 0,
+    databases            text[]    not null default '{}',
+    collect_metrics      boolean   not null default true,
+    collect_deadlocks    boolean   not null default true,
+    updated_at           timestamp not null
+);
@@ -0,0 +1,4 @@
+alter table target_collection_config
+    drop column if exists exclude_databases,
+    drop column if exists fetch_plans,
+    drop column if exists collect_lock_metrics;
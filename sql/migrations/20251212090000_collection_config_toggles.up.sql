@@ -0,0 +1,4 @@
+alter table target_collection_config
+    add column if not exists exclude_databases    text[]  not null default '{}',
+    add column if not exists fetch_plans          boolean not null default true,
+    add column if not exists collect_lock_metrics boolean not null default true;
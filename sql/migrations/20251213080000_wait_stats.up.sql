@@ -0,0 +1,11 @@
+create table if not exists wait_stats
+(
+    target_id           int          not null references target (id) on delete cascade,
+    collected_at        timestamp    not null,
+    wait_type           varchar(120) not null,
+    waiting_tasks       bigint       not null,
+    wait_time_ms        bigint       not null,
+    signal_wait_time_ms bigint       not null,
+    max_wait_time_ms    bigint       not null,
+    primary key (target_id, collected_at, wait_type)
+);
@@ -0,0 +1 @@
+drop table if exists performance_counters;
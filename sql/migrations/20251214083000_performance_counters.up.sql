@@ -0,0 +1,9 @@
+create table if not exists performance_counters
+(
+    target_id    int              not null references target (id) on delete cascade,
+    collected_at timestamp        not null,
+    counter_name varchar(128)     not null,
+    value        bigint           not null,
+    rate         double precision not null,
+    primary key (target_id, collected_at, counter_name)
+);
@@ -0,0 +1,18 @@
+create table if not exists file_io_stats
+(
+    target_id         int           not null references target (id) on delete cascade,
+    collected_at      timestamp     not null,
+    database_name     varchar(128)  not null,
+    file_id           int           not null,
+    logical_name      varchar(128)  not null,
+    physical_name     varchar(260)  not null,
+    file_type         varchar(60)   not null,
+    interval_ms       bigint        not null,
+    reads             bigint        not null,
+    bytes_read        bigint        not null,
+    read_stall_ms     bigint        not null,
+    writes            bigint        not null,
+    bytes_written     bigint        not null,
+    write_stall_ms    bigint        not null,
+    primary key (target_id, collected_at, database_name, file_id)
+);
@@ -0,0 +1,4 @@
+drop table if exists missing_index_stats;
+drop table if exists index_usage_stats;
+alter table target_collection_config
+    drop column if exists index_stats_interval_ms;
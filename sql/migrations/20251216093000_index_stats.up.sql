@@ -0,0 +1,43 @@
+alter table target_collection_config
+    add column if not exists index_stats_interval_ms bigint not null default 0;
+
+create table if not exists index_usage_stats
+(
+    target_id        int          not null references target (id) on delete cascade,
+    collected_at     timestamp    not null,
+    database_name    varchar(128) not null,
+    schema_name      varchar(128) not null,
+    table_name       varchar(128) not null,
+    index_name       varchar(128) not null,
+    index_id         int          not null,
+    index_type       varchar(60)  not null,
+    is_unique        boolean      not null,
+    is_primary_key   boolean      not null,
+    user_seeks       bigint       not null,
+    user_scans       bigint       not null,
+    user_lookups     bigint       not null,
+    user_updates     bigint       not null,
+    last_user_read   timestamp,
+    last_user_update timestamp,
+    size_kb          bigint       not null,
+    row_count        bigint       not null,
+    primary key (target_id, collected_at, database_name, schema_name, table_name, index_name)
+);
+
+create table if not exists missing_index_stats
+(
+    target_id           int              not null references target (id) on delete cascade,
+    collected_at        timestamp        not null,
+    database_name       varchar(128)     not null,
+    table_name          text             not null,
+    equality_columns    text             not null,
+    inequality_columns  text             not null,
+    included_columns    text             not null,
+    unique_compiles     bigint           not null,
+    user_seeks          bigint           not null,
+    user_scans          bigint           not null,
+    avg_total_user_cost double precision not null,
+    avg_user_impact     double precision not null,
+    last_user_seek      timestamp
+);
+create index if not exists missing_index_stats_target_collected_at on missing_index_stats (target_id, collected_at);
@@ -0,0 +1,4 @@
+drop table if exists query_store_runtime_stats;
+drop table if exists query_store_plan;
+alter table target_collection_config
+    drop column if exists query_store_interval_ms;
@@ -0,0 +1,38 @@
+alter table target_collection_config
+    add column if not exists query_store_interval_ms bigint not null default 0;
+
+create table if not exists query_store_plan
+(
+    target_id           int          not null references target (id) on delete cascade,
+    database_name       varchar(128) not null,
+    plan_id             bigint       not null,
+    query_id            bigint       not null,
+    query_hash          varchar(64)  not null,
+    query_plan_hash     varchar(64)  not null,
+    query_text          text         not null,
+    plan_xml            text         not null,
+    is_forced_plan      boolean      not null,
+    first_compile_time  timestamp,
+    last_execution_time timestamp,
+    primary key (target_id, database_name, plan_id)
+);
+create index if not exists query_store_plan_query_hash on query_store_plan (target_id, query_hash);
+
+create table if not exists query_store_runtime_stats
+(
+    target_id           int              not null references target (id) on delete cascade,
+    database_name       varchar(128)     not null,
+    plan_id             bigint           not null,
+    interval_start      timestamp        not null,
+    interval_end        timestamp        not null,
+    executions          bigint           not null,
+    aborted_executions  bigint           not null,
+    avg_duration_us     double precision not null,
+    max_duration_us     double precision not null,
+    avg_cpu_time_us     double precision not null,
+    avg_logical_reads   double precision not null,
+    avg_physical_reads  double precision not null,
+    avg_rowcount        double precision not null,
+    last_execution_time timestamp,
+    primary key (target_id, database_name, plan_id, interval_start)
+);
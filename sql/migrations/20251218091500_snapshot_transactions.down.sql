@@ -0,0 +1,3 @@
+drop table if exists snapshot_transaction;
+alter table snapshot
+    drop column if exists version_store_kb;
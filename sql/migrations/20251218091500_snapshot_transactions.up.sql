@@ -0,0 +1,24 @@
+alter table snapshot
+    add column if not exists version_store_kb bigint not null default 0;
+
+create table if not exists snapshot_transaction
+(
+    snap_id             bigint       not null references snapshot (id) on delete cascade,
+    transaction_id      bigint       not null,
+    name                varchar(64)  not null,
+    session_id          varchar(20)  not null,
+    host_name           varchar(128) not null,
+    program_name        varchar(128) not null,
+    login_name          varchar(128) not null,
+    session_status      varchar(30)  not null,
+    begin_time          timestamp    not null,
+    duration_ms         bigint       not null,
+    transaction_type    varchar(20)  not null,
+    transaction_state   varchar(20)  not null,
+    database_name       varchar(128) not null,
+    log_bytes_used      bigint       not null,
+    log_bytes_reserved  bigint       not null,
+    log_record_count    bigint       not null,
+    holds_version_store boolean      not null,
+    primary key (snap_id, transaction_id, session_id)
+);
@@ -0,0 +1,3 @@
+drop table if exists job_run;
+alter table target_collection_config
+    drop column if exists job_interval_ms;
@@ -0,0 +1,17 @@
+alter table target_collection_config
+    add column if not exists job_interval_ms bigint not null default 0;
+
+create table if not exists job_run
+(
+    target_id    int          not null references target (id) on delete cascade,
+    job_id       uuid         not null,
+    job_name     varchar(128) not null,
+    status       varchar(20)  not null,
+    start_time   timestamp    not null,
+    duration_ms  bigint       not null,
+    end_time     timestamp    not null,
+    message      text         not null,
+    current_step varchar(128) not null,
+    primary key (target_id, job_id, start_time)
+);
+create index if not exists job_run_end_time on job_run (target_id, end_time);
@@ -0,0 +1 @@
+drop table if exists ag_replica_states;
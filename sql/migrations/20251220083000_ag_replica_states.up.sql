@@ -0,0 +1,25 @@
+create table if not exists ag_replica_states
+(
+    target_id                 int          not null references target (id) on delete cascade,
+    collected_at              timestamp    not null,
+    ag_name                   varchar(128) not null,
+    replica_server_name       varchar(256) not null,
+    database_name             varchar(128) not null,
+    role                      varchar(60)  not null,
+    availability_mode         varchar(60)  not null,
+    failover_mode             varchar(60)  not null,
+    connected_state           varchar(60)  not null,
+    is_local                  boolean      not null,
+    synchronization_state     varchar(60)  not null,
+    synchronization_health    varchar(60)  not null,
+    is_suspended              boolean      not null,
+    suspend_reason            varchar(60)  not null,
+    log_send_queue_kb         bigint       not null,
+    log_send_rate_kbps        bigint       not null,
+    redo_queue_kb             bigint       not null,
+    redo_rate_kbps            bigint       not null,
+    last_commit_time          timestamp,
+    estimated_data_loss_s     bigint       not null,
+    estimated_recovery_time_s bigint       not null,
+    primary key (target_id, collected_at, ag_name, replica_server_name, database_name)
+);
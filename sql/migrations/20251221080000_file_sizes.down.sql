@@ -0,0 +1,4 @@
+drop table if exists autogrowth_event;
+drop table if exists database_file_size;
+alter table target_collection_config
+    drop column if exists file_size_interval_ms;
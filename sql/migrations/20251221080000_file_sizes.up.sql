@@ -0,0 +1,32 @@
+alter table target_collection_config
+    add column if not exists file_size_interval_ms bigint not null default 0;
+
+create table if not exists database_file_size
+(
+    target_id         int          not null references target (id) on delete cascade,
+    collected_at      timestamp    not null,
+    database_name     varchar(128) not null,
+    file_id           int          not null,
+    logical_name      varchar(128) not null,
+    physical_name     varchar(260) not null,
+    file_type         varchar(60)  not null,
+    size_kb           bigint       not null,
+    used_kb           bigint       not null,
+    max_size_kb       bigint       not null,
+    growth            bigint       not null,
+    is_percent_growth boolean      not null,
+    volume_free_kb    bigint       not null,
+    primary key (target_id, collected_at, database_name, file_id)
+);
+
+create table if not exists autogrowth_event
+(
+    target_id     int          not null references target (id) on delete cascade,
+    database_name varchar(128) not null,
+    logical_name  varchar(128) not null,
+    start_time    timestamp    not null,
+    file_type     varchar(60)  not null,
+    duration_ms   bigint       not null,
+    growth_kb     bigint       not null,
+    primary key (target_id, database_name, logical_name, start_time)
+);
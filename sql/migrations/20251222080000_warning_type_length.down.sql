@@ -0,0 +1,3 @@
+delete from public.warnings where length(warning_type) > 30;
+alter table public.warnings
+    alter column warning_type type varchar(30);
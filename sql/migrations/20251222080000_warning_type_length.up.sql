@@ -0,0 +1,3 @@
+-- warning_type holds the go type of the warning oneof, *dbmv1.Warning_AvailabilityGroup does not fit 30 characters
+alter table public.warnings
+    alter column warning_type type varchar(100);
@@ -39,7 +39,8 @@ export function QueryEditor({query, onChange, onRunQuery, datasource}: Props) {
                             {label: "snapshot-list", value: "snapshot-list"},
                             {label: "snapshot", value: "snapshot"},
                             {label: "metrics", value: "metrics"},
-                            {label: "metrics_series", value: "metrics_series"}
+                            {label: "metrics_series", value: "metrics_series"},
+                            {label: "file_io", value: "file_io"}
                         ]}
                         value={query.queryType}
                         onChange={onQueryTypeChange}
@@ -78,6 +79,17 @@ export function QueryEditor({query, onChange, onRunQuery, datasource}: Props) {
                             placeholder=""/>
                     </InlineField>
                 )}
+                {(query.queryType === "file_io") && (
+                    <InlineField label="Databases" tooltip="Comma separated, all databases when empty">
+                        <Input
+                            value={query.databases?.join(',')}
+                            onChange={event => {
+                                const databases = event.currentTarget.value.split(',').map(d => d.trim()).filter(d => d !== '');
+                                onChange({...query, databases: databases});
+                            }}
+                            placeholder="All databases"/>
+                    </InlineField>
+                )}
                 <InlineField label="Metrics">
                     <MultiCombobox
                         width={"auto"}
@@ -6,6 +6,7 @@ export interface MyQuery extends DataQuery {
     snapshotID?: string;
     queryHash?: string;
     metrics?: string[];
+    databases?: string[];
     // Add other query parameters as needed
 }
 